@@ -56,6 +56,8 @@ func NewAnteHandler(
 		ante.NewValidateSigCountDecorator(ak),
 		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		// The fee sponsor contract must only be called once the tx signatures are verified.
+		txfeeskeeper.NewFeeSponsorDecorator(*txFeesKeeper),
 		ante.NewIncrementSequenceDecorator(ak),
 		ibcante.NewAnteDecorator(channelKeeper),
 	)
@@ -64,6 +64,7 @@ import (
 	v8 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v8"
 	v9 "github.com/osmosis-labs/osmosis/v16/app/upgrades/v9"
 	_ "github.com/osmosis-labs/osmosis/v16/client/docs/statik"
+	protorevtypes "github.com/osmosis-labs/osmosis/v16/x/protorev/types"
 )
 
 const appName = "OsmosisApp"
@@ -81,7 +82,11 @@ var (
 	maccPerms = moduleAccountPermissions
 
 	// module accounts that are allowed to receive tokens.
-	allowedReceivingModAcc = map[string]bool{}
+	allowedReceivingModAcc = map[string]bool{
+		// cosmwasm pool contracts send the swap output to protorev when it trades through them. protorev only
+		// distributes the profits it tracks in state, so funds sent to it by others are not distributed.
+		protorevtypes.ModuleName: true,
+	}
 
 	// TODO: Refactor wasm items into a wasm.go file
 	// WasmProposalsEnabled enables all x/wasm proposals when it's value is "true"
@@ -326,7 +326,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.BankKeeper, appKeepers.DistrKeeper,
 		appKeepers.ConcentratedLiquidityKeeper,
 		appKeepers.PoolIncentivesKeeper,
-		appKeepers.IncentivesKeeper)
+		appKeepers.IncentivesKeeper,
+		appKeepers.LockupKeeper)
 	appKeepers.GAMMKeeper = &gammKeeper
 	appKeepers.ConcentratedLiquidityKeeper.SetGammKeeper(appKeepers.GAMMKeeper)
 
@@ -351,21 +352,24 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.GAMMKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
 		appKeepers.GetSubspace(protorevtypes.ModuleName),
-		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.GAMMKeeper, appKeepers.EpochsKeeper, appKeepers.PoolManagerKeeper)
+		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.GAMMKeeper, appKeepers.EpochsKeeper, appKeepers.PoolManagerKeeper, appKeepers.DistrKeeper)
 	appKeepers.ProtoRevKeeper = &protorevKeeper
 
 	txFeesKeeper := txfeeskeeper.NewKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
+		appKeepers.GetSubspace(txfeestypes.ModuleName),
 		appKeepers.PoolManagerKeeper,
 		appKeepers.GAMMKeeper,
+		appKeepers.TwapKeeper,
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
 
@@ -384,6 +388,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.ConcentratedLiquidityKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 	appKeepers.GAMMKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
+	appKeepers.ProtoRevKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 
 	appKeepers.SuperfluidKeeper = superfluidkeeper.NewKeeper(
 		appKeepers.keys[superfluidtypes.StoreKey], appKeepers.GetSubspace(superfluidtypes.ModuleName),
@@ -416,6 +421,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.IncentivesKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.GAMMKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
+	appKeepers.ProtoRevKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appKeepers.keys[tokenfactorytypes.StoreKey],
@@ -464,6 +470,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.WasmKeeper = &wasmKeeper
 	appKeepers.CosmwasmPoolKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+	appKeepers.GAMMKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
 
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
@@ -474,6 +481,10 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// set token factory contract keeper
 	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 
+	// set txfees contract and wasm keepers, calling fee sponsor contracts
+	appKeepers.TxFeesKeeper.SetContractKeeper(appKeepers.ContractKeeper)
+	appKeepers.TxFeesKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
 
@@ -659,6 +670,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
+	paramsKeeper.Subspace(txfeestypes.ModuleName)
 	paramsKeeper.Subspace(ibcratelimittypes.ModuleName)
 	paramsKeeper.Subspace(concentratedliquiditytypes.ModuleName)
 	paramsKeeper.Subspace(icqtypes.ModuleName)
@@ -706,6 +718,12 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		),
 	)
 
+	appKeepers.CosmwasmPoolKeeper.SetListeners(
+		cosmwasmpooltypes.NewCosmwasmPoolListeners(
+			appKeepers.ProtoRevKeeper.Hooks(),
+		),
+	)
+
 	appKeepers.LockupKeeper.SetHooks(
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
@@ -731,6 +749,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			// insert epoch hooks receivers here
 			appKeepers.TxFeesKeeper.Hooks(),
 			appKeepers.TwapKeeper.EpochHooks(),
+			appKeepers.GAMMKeeper.EpochHooks(),
 			appKeepers.SuperfluidKeeper.Hooks(),
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
@@ -219,8 +219,12 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.FirstElements(govtypes.ModuleName)
 	ord.LastElements(stakingtypes.ModuleName)
 
-	// only Osmosis modules with endblock code are: twap, crisis, govtypes, staking
-	// we don't care about the relative ordering between them.
+	// txfees must be before twap, as txfees swaps chunks of the fee tokens whose liquidation is pending,
+	// which twap must record in the same block.
+	ord.Before(txfeestypes.ModuleName, twaptypes.ModuleName)
+
+	// only Osmosis modules with endblock code are: twap, txfees, crisis, govtypes, staking
+	// we don't care about the relative ordering between the others.
 	return ord.TotalOrdering()
 }
 
@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+
+	twaptypes "github.com/osmosis-labs/osmosis/v16/x/twap/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 )
 
 func TestOrderEndBlockers_Determinism(t *testing.T) {
@@ -20,3 +23,21 @@ func TestOrderEndBlockers_Determinism(t *testing.T) {
 		require.True(t, reflect.DeepEqual(a, b))
 	}
 }
+
+func TestOrderEndBlockers_TxFeesBeforeTwap(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewOsmosisApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, simapp.EmptyAppOptions{}, EmptyWasmOpts)
+
+	order := OrderEndBlockers(app.mm.ModuleNames())
+	txfeesIndex, twapIndex := -1, -1
+	for i, moduleName := range order {
+		switch moduleName {
+		case txfeestypes.ModuleName:
+			txfeesIndex = i
+		case twaptypes.ModuleName:
+			twapIndex = i
+		}
+	}
+	require.NotEqual(t, -1, txfeesIndex)
+	require.Less(t, txfeesIndex, twapIndex)
+}
@@ -137,8 +137,13 @@ func init() {
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomSpotPrice", &txfeestypes.QueryDenomSpotPriceResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomConversionRate", &txfeestypes.QueryDenomConversionRateResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/DenomPoolId", &txfeestypes.QueryDenomPoolIdResponse{})
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/BaseDenom", &txfeestypes.QueryBaseDenomResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/BaseFee", &txfeestypes.QueryBaseFeeResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/MempoolFilters", &txfeestypes.QueryMempoolFiltersResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/RecommendedGasPrices", &txfeestypes.QueryRecommendedGasPricesResponse{})
+	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeSponsors", &txfeestypes.QueryFeeSponsorsResponse{})
 
 	// tokenfactory
 	setWhitelistedQuery("/osmosis.tokenfactory.v1beta1.Query/Params", &tokenfactorytypes.QueryParamsResponse{})
@@ -150,6 +155,12 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/RealizedVolatility", &twapquerytypes.RealizedVolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/AggregateTwap", &twapquerytypes.AggregateTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/RouteTwap", &twapquerytypes.RouteTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/TimeWeightedLiquidity", &twapquerytypes.TimeWeightedLiquidityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Vwap", &twapquerytypes.VwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/BatchTwap", &twapquerytypes.BatchTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
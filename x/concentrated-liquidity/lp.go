@@ -144,6 +144,13 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	return positionId, actualAmount0, actualAmount1, liquidityDelta, lowerTick, upperTick, nil
 }
 
+// CreatePosition creates a concentrated liquidity position on behalf of owner.
+// It is the entrypoint used by other modules, such as x/poolmanager when zapping into a pool.
+// See createPosition for details.
+func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tokensProvided sdk.Coins, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (positionId uint64, actualAmount0 sdk.Int, actualAmount1 sdk.Int, liquidityDelta sdk.Dec, lowerTickResult int64, upperTickResult int64, err error) {
+	return k.createPosition(ctx, poolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+}
+
 // WithdrawPosition attempts to withdraw liquidityAmount from a position with the given pool id in the given tick range.
 // On success, returns a positive amount of each token withdrawn.
 // If we are attempting to withdraw all liquidity available in the position, we also collect spread factors and incentives for the position.
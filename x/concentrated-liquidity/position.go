@@ -167,6 +167,16 @@ func (k Keeper) GetPositionLiquidity(ctx sdk.Context, positionId uint64) (sdk.De
 	return position.Liquidity, nil
 }
 
+// GetPositionPoolId checks if the provided positionId exists. Returns the id of the pool the position belongs to if found. Error otherwise.
+func (k Keeper) GetPositionPoolId(ctx sdk.Context, positionId uint64) (uint64, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, err
+	}
+
+	return position.PoolId, nil
+}
+
 // GetPosition checks if the given position id exists. Returns position if found.
 func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (model.Position, error) {
 	store := ctx.KVStore(k.storeKey)
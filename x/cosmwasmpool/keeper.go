@@ -23,6 +23,8 @@ type Keeper struct {
 	poolmanagerKeeper types.PoolManagerKeeper
 	contractKeeper    types.ContractKeeper
 	wasmKeeper        types.WasmKeeper
+
+	listeners types.CosmwasmPoolListeners
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
@@ -60,6 +62,17 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
 	k.wasmKeeper = wasmKeeper
 }
 
+// Set the cosmwasmpool listeners.
+func (k *Keeper) SetListeners(listeners types.CosmwasmPoolListeners) *Keeper {
+	if k.listeners != nil {
+		panic("cannot set cosmwasmpool listeners twice")
+	}
+
+	k.listeners = listeners
+
+	return k
+}
+
 // asCosmwasmPool converts a poolI to a CosmWasmExtension.
 func (k *Keeper) asCosmwasmPool(poolI poolmanagertypes.PoolI) (types.CosmWasmExtension, error) {
 	cosmwasmPool, ok := poolI.(types.CosmWasmExtension)
@@ -201,6 +201,8 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Int{}, err
 	}
 
+	k.listeners.AfterCosmwasmPoolSwap(ctx, sender, pool.GetId(), sdk.NewCoins(tokenIn), sdk.NewCoins(sdk.NewCoin(tokenOutDenom, response.TokenOutAmount)))
+
 	return response.TokenOutAmount, nil
 }
 
@@ -279,6 +281,8 @@ func (k Keeper) SwapExactAmountOut(
 		return sdk.Int{}, err
 	}
 
+	k.listeners.AfterCosmwasmPoolSwap(ctx, sender, pool.GetId(), sdk.NewCoins(sdk.NewCoin(tokenInDenom, response.TokenInAmount)), sdk.NewCoins(tokenOut))
+
 	return response.TokenInAmount, nil
 }
 
@@ -0,0 +1,23 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type CosmwasmPoolListener interface {
+	// AfterCosmwasmPoolSwap is called after a swap in a cosmwasm pool.
+	AfterCosmwasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+}
+
+type CosmwasmPoolListeners []CosmwasmPoolListener
+
+var _ CosmwasmPoolListener = &CosmwasmPoolListeners{}
+
+func (l CosmwasmPoolListeners) AfterCosmwasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterCosmwasmPoolSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+// Creates hooks for the x/cosmwasmpool module.
+func NewCosmwasmPoolListeners(listeners ...CosmwasmPoolListener) CosmwasmPoolListeners {
+	return listeners
+}
@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetConcentratedPoolIdLinkFromCFMMRequest)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdSharePrice)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAccountPoolPositionsValue)
 	cmd.AddCommand(
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
@@ -336,6 +338,30 @@ func GetConcentratedPoolIdLinkFromCFMMRequest() (*osmocli.QueryDescriptor, *type
 	}, &types.QueryConcentratedPoolIdLinkFromCFMMRequest{}
 }
 
+// GetCmdSharePrice returns the value of one share of a pool in a quote denom.
+func GetCmdSharePrice() (*osmocli.QueryDescriptor, *types.QuerySharePriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "share-price [poolID] [quoteDenom] [pricePoolID] [twapWindow]",
+		Short: "Query the value of one pool share in a quote denom, priced with geometric TWAPs",
+		Long: `{{.Short}}
+pricePoolID is only used if the quote denom is not a pool asset, and may be 0 otherwise.
+A twapWindow of 0s defaults to 10 minutes.{{.ExampleHeader}}
+{{.CommandPrefix}} share-price 1 uosmo 0 10m`,
+	}, &types.QuerySharePriceRequest{}
+}
+
+// GetCmdAccountPoolPositionsValue returns the value of the shares of a pool held by an account.
+func GetCmdAccountPoolPositionsValue() (*osmocli.QueryDescriptor, *types.QueryAccountPoolPositionsValueRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "account-pool-positions-value [address] [poolID] [quoteDenom] [pricePoolID] [twapWindow]",
+		Short: "Query the value of the balance, locked and unlocking shares of a pool held by an account, priced with geometric TWAPs",
+		Long: `{{.Short}}
+pricePoolID is only used if the quote denom is not a pool asset, and may be 0 otherwise.
+A twapWindow of 0s defaults to 10 minutes.{{.ExampleHeader}}
+{{.CommandPrefix}} account-pool-positions-value osmo1... 1 uosmo 0 10m`,
+	}, &types.QueryAccountPoolPositionsValueRequest{}
+}
+
 // GetCmdTotalPoolLiquidity returns total liquidity in pool.
 // Deprecated: please use the alternative in x/poolmanager
 // nolint: staticcheck
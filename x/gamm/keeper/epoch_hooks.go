@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// Stableswap pools with a scaling factor rate source are updated at the end of every incentives epoch.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier != h.k.incentivesKeeper.GetEpochInfo(ctx).Identifier {
+		return nil
+	}
+
+	return h.k.UpdateScalingFactorsFromRateSources(ctx)
+}
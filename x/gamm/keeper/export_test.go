@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
@@ -21,6 +22,18 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) SetStableSwapScalingFactorRateSource(ctx sdk.Context, poolId uint64, rateSource *stableswap.ScalingFactorRateSource, sender string) error {
+	return k.setStableSwapScalingFactorRateSource(ctx, poolId, rateSource, sender)
+}
+
+func (k Keeper) GetScalingFactorRateSourcePoolIds(ctx sdk.Context) []uint64 {
+	return k.getScalingFactorRateSourcePoolIds(ctx)
+}
+
+func (k Keeper) QueryRateSourceContract(ctx sdk.Context, poolId uint64, contractAddress string) (stableswap.GetScalingFactorsResponse, error) {
+	return k.queryRateSourceContract(ctx, poolId, contractAddress)
+}
+
 func AsCFMMPool(pool poolmanagertypes.PoolI) (types.CFMMPoolI, error) {
 	return asCFMMPool(pool)
 }
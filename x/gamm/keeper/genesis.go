@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 )
 
@@ -26,6 +27,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 		if err != nil {
 			panic(err)
 		}
+		if stableswapPool, ok := pool.(*stableswap.Pool); ok {
+			k.setScalingFactorRateSourcePoolIndex(ctx, stableswapPool)
+		}
 
 		poolAssets := pool.GetTotalPoolLiquidity(ctx)
 		for _, asset := range poolAssets {
@@ -52,7 +52,9 @@ func (s *KeeperTestSuite) TestGammInitGenesis() {
 		Pools:          poolAnys,
 		NextPoolNumber: 7,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:                 sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			MaxScalingFactorChangePerUpdate: types.DefaultParams().MaxScalingFactorChangePerUpdate,
+			RateSourceQueryGasLimit:         types.DefaultParams().RateSourceQueryGasLimit,
 		},
 		MigrationRecords: &DefaultMigrationRecords,
 	}, s.App.AppCodec())
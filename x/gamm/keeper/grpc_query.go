@@ -499,3 +499,62 @@ func (q Querier) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *types.
 		ConcentratedPoolId: poolIdEntering,
 	}, nil
 }
+
+// SharePrice queries the value of one share of a pool in a quote denom, priced with geometric TWAPs.
+func (q Querier) SharePrice(ctx context.Context, req *types.QuerySharePriceRequest) (*types.QuerySharePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.QuoteDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sharePrice, err := q.Keeper.GetSharePrice(sdk.UnwrapSDKContext(ctx), req.PoolId, req.QuoteDenom, req.PricePoolId, req.TwapWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySharePriceResponse{SharePrice: sharePrice}, nil
+}
+
+// AccountPoolPositionsValue queries the value of the shares of a pool held by an account in its balance,
+// locked and unlocking, in a quote denom priced with geometric TWAPs.
+func (q Querier) AccountPoolPositionsValue(ctx context.Context, req *types.QueryAccountPoolPositionsValueRequest) (*types.QueryAccountPoolPositionsValueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(req.QuoteDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	balanceShares, lockedShares, unlockingShares := q.Keeper.GetAccountPoolShares(sdkCtx, addr, req.PoolId)
+
+	balanceValue, err := q.Keeper.GetSharesValue(sdkCtx, req.PoolId, balanceShares, req.QuoteDenom, req.PricePoolId, req.TwapWindow)
+	if err != nil {
+		return nil, err
+	}
+	lockedValue, err := q.Keeper.GetSharesValue(sdkCtx, req.PoolId, lockedShares, req.QuoteDenom, req.PricePoolId, req.TwapWindow)
+	if err != nil {
+		return nil, err
+	}
+	unlockingValue, err := q.Keeper.GetSharesValue(sdkCtx, req.PoolId, unlockingShares, req.QuoteDenom, req.PricePoolId, req.TwapWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	shareDenom := types.GetPoolShareDenom(req.PoolId)
+	return &types.QueryAccountPoolPositionsValueResponse{
+		BalanceShares:   sdk.NewCoin(shareDenom, balanceShares),
+		BalanceValue:    balanceValue,
+		LockedShares:    sdk.NewCoin(shareDenom, lockedShares),
+		LockedValue:     lockedValue,
+		UnlockingShares: sdk.NewCoin(shareDenom, unlockingShares),
+		UnlockingValue:  unlockingValue,
+		TotalValue:      balanceValue.Add(lockedValue).Add(unlockingValue),
+	}, nil
+}
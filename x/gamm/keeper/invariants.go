@@ -3,24 +3,74 @@ package keeper
 // DONTCOVER
 
 import (
+	"errors"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName = "pool-account-balance-equals-expected"
+
+	cfmmConstantFunctionInvariantName = "cfmm-constant-function-non-decreasing-on-swap"
+	cfmmSpotPriceInvariantName        = "cfmm-spot-price-monotonic-on-swap"
+	cfmmShareValueInvariantName       = "cfmm-share-value-non-decreasing-on-join-exit"
+
+	// cfmmInvariantProbeRatio is the inverse of the fraction of the pool reserves
+	// used by the operations the CFMM crisis invariants simulate against each pool.
+	cfmmInvariantProbeRatio = 100
+)
+
+// cfmmInvariantLog2Tolerance is the tolerance on decreases of the base 2 logarithm of the
+// constant function and share values, accounting for the precision of the logarithm and of
+// the swap solvers.
+var cfmmInvariantLog2Tolerance = osmomath.NewDecWithPrec(1, 24)
+
+// CFMMInvariant is a property that all pools implementing types.ConstantFunctionExtension must satisfy.
+// Check simulates operations against the given pool, mutating its in-memory state, and returns an
+// error wrapping types.ErrCFMMInvariantViolated if the property does not hold. Any other error means
+// that the operations could not be simulated, which does not break the invariant.
+type CFMMInvariant struct {
+	Name  string
+	Check func(ctx sdk.Context, pool types.ConstantFunctionExtension) error
+}
+
+// CFMMInvariants returns the CFMM invariants registered as crisis invariants.
+// Each of them probes the pools with operations of a fraction of their reserves.
+func CFMMInvariants() []CFMMInvariant {
+	return []CFMMInvariant{
+		{Name: cfmmConstantFunctionInvariantName, Check: checkSwapsAcrossAllDenoms(CheckSwapConstantFunction)},
+		{Name: cfmmSpotPriceInvariantName, Check: checkSwapsAcrossAllDenoms(CheckSwapSpotPrice)},
+		{Name: cfmmShareValueInvariantName, Check: checkJoinExitProbes},
+	}
+}
 
 // RegisterInvariants registers all gamm invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
+	for _, cfmmInvariant := range CFMMInvariants() {
+		ir.RegisterRoute(types.ModuleName, cfmmInvariant.Name, CFMMPoolInvariant(keeper, cfmmInvariant))
+	}
 }
 
 // AllInvariants runs all invariants of the gamm module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg, broke := PoolAccountInvariant(keeper, bk)(ctx)
+		if broke {
+			return msg, broke
+		}
+
+		for _, cfmmInvariant := range CFMMInvariants() {
+			msg, broke = CFMMPoolInvariant(keeper, cfmmInvariant)(ctx)
+			if broke {
+				return msg, broke
+			}
+		}
 		return msg, broke
 	}
 }
@@ -49,3 +99,190 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 			"\tgamm all pool asset coins and account coins match\n"), false
 	}
 }
+
+// CFMMPoolInvariant checks that the given CFMM invariant holds for every pool
+// implementing types.ConstantFunctionExtension. The operations are only simulated
+// against the in-memory pools, which are never written back to state.
+// As anyone can check the invariant with MsgVerifyInvariant, halting the chain if it is broken,
+// pools whose operations fail to simulate are skipped rather than reported as breaking it.
+func CFMMPoolInvariant(keeper Keeper, cfmmInvariant CFMMInvariant) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, cfmmInvariant.Name,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, pool := range pools {
+			constantFunctionPool, ok := pool.(types.ConstantFunctionExtension)
+			if !ok {
+				continue
+			}
+
+			if err := cfmmInvariant.Check(ctx, constantFunctionPool); errors.Is(err, types.ErrCFMMInvariantViolated) {
+				return sdk.FormatInvariant(types.ModuleName, cfmmInvariant.Name,
+					fmt.Sprintf("\tgamm pool id %d\n\t%s\n", pool.GetId(), err)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, cfmmInvariant.Name,
+			"\tgamm all constant function pools satisfy the invariant\n"), false
+	}
+}
+
+// CheckSwapConstantFunction swaps tokenIn for tokenOutDenom against the pool, charging its spread factor,
+// and checks that the value of the constant function of the pool did not decrease.
+func CheckSwapConstantFunction(ctx sdk.Context, pool types.ConstantFunctionExtension, tokenIn sdk.Coin, tokenOutDenom string) error {
+	valueBefore, err := pool.Log2ConstantFunctionValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, pool.GetSpreadFactor(ctx)); err != nil {
+		return err
+	}
+
+	valueAfter, err := pool.Log2ConstantFunctionValue(ctx)
+	if err != nil {
+		return err
+	}
+
+	if valueAfter.LT(valueBefore.Sub(cfmmInvariantLog2Tolerance)) {
+		return errorsmod.Wrapf(types.ErrCFMMInvariantViolated,
+			"log2 of constant function decreased from (%s) to (%s) swapping (%s) for (%s)", valueBefore, valueAfter, tokenIn, tokenOutDenom)
+	}
+	return nil
+}
+
+// CheckSwapSpotPrice swaps tokenIn for tokenOutDenom against the pool, charging its spread factor,
+// and checks that the spot price of tokenIn in tokenOutDenom did not increase.
+func CheckSwapSpotPrice(ctx sdk.Context, pool types.ConstantFunctionExtension, tokenIn sdk.Coin, tokenOutDenom string) error {
+	spotPriceBefore, err := pool.SpotPrice(ctx, tokenOutDenom, tokenIn.Denom)
+	if err != nil {
+		return err
+	}
+
+	if _, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, pool.GetSpreadFactor(ctx)); err != nil {
+		return err
+	}
+
+	spotPriceAfter, err := pool.SpotPrice(ctx, tokenOutDenom, tokenIn.Denom)
+	if err != nil {
+		return err
+	}
+
+	if spotPriceAfter.GT(spotPriceBefore) {
+		return errorsmod.Wrapf(types.ErrCFMMInvariantViolated,
+			"spot price of (%s) in (%s) increased from (%s) to (%s) swapping (%s)", tokenIn.Denom, tokenOutDenom, spotPriceBefore, spotPriceAfter, tokenIn)
+	}
+	return nil
+}
+
+// CheckJoinExitShareValue joins the pool with tokensIn, charging its spread factor, then exits the
+// pool with the shares received, charging its exit fee. It checks that the value of one share,
+// as measured by the constant function of the pool, did not decrease after either operation.
+func CheckJoinExitShareValue(ctx sdk.Context, pool types.ConstantFunctionExtension, tokensIn sdk.Coins) error {
+	shareValueBefore, err := log2ShareValue(ctx, pool)
+	if err != nil {
+		return err
+	}
+	tolerance := log2ShareValueTolerance(ctx, pool)
+
+	numShares, err := pool.JoinPool(ctx, tokensIn, pool.GetSpreadFactor(ctx))
+	if err != nil {
+		return err
+	}
+
+	shareValueAfterJoin, err := log2ShareValue(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if shareValueAfterJoin.LT(shareValueBefore.Sub(tolerance)) {
+		return errorsmod.Wrapf(types.ErrCFMMInvariantViolated,
+			"log2 of share value decreased from (%s) to (%s) joining with (%s)", shareValueBefore, shareValueAfterJoin, tokensIn)
+	}
+
+	if _, err := pool.ExitPool(ctx, numShares, pool.GetExitFee(ctx)); err != nil {
+		return err
+	}
+
+	shareValueAfterExit, err := log2ShareValue(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if shareValueAfterExit.LT(shareValueAfterJoin.Sub(tolerance)) {
+		return errorsmod.Wrapf(types.ErrCFMMInvariantViolated,
+			"log2 of share value decreased from (%s) to (%s) exiting (%s) shares", shareValueAfterJoin, shareValueAfterExit, numShares)
+	}
+	return nil
+}
+
+// log2ShareValue returns the base 2 logarithm of the normalized constant function value of the pool
+// divided by its total shares.
+func log2ShareValue(ctx sdk.Context, pool types.ConstantFunctionExtension) (osmomath.BigDec, error) {
+	log2Value, err := pool.Log2ConstantFunctionValue(ctx)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return log2Value.Sub(osmomath.BigDecFromSDKDec(pool.GetTotalShares().ToDec()).LogBase2()), nil
+}
+
+// log2ShareValueTolerance returns the tolerance on decreases of the base 2 logarithm of the share value
+// of the pool. Joins and exits round token amounts to whole units, so the share value is allowed to
+// decrease by the value of one unit of each pool asset, i.e. by a factor of 1 + sum_i(1 / reserve_i).
+func log2ShareValueTolerance(ctx sdk.Context, pool types.ConstantFunctionExtension) osmomath.BigDec {
+	roundingFactor := osmomath.OneDec()
+	for _, reserve := range pool.GetTotalPoolLiquidity(ctx) {
+		roundingFactor = roundingFactor.Add(osmomath.OneDec().Quo(osmomath.BigDecFromSDKDec(reserve.Amount.ToDec())))
+	}
+	return roundingFactor.LogBase2().Add(cfmmInvariantLog2Tolerance)
+}
+
+// checkSwapsAcrossAllDenoms returns a check running the given swap check for every
+// ordered pair of pool assets, swapping a fraction of the reserves of the input asset.
+// Swaps that fail, e.g. as the pool cannot swap out a positive amount, are skipped.
+func checkSwapsAcrossAllDenoms(checkSwap func(ctx sdk.Context, pool types.ConstantFunctionExtension, tokenIn sdk.Coin, tokenOutDenom string) error) func(sdk.Context, types.ConstantFunctionExtension) error {
+	return func(ctx sdk.Context, pool types.ConstantFunctionExtension) error {
+		for _, reserveIn := range pool.GetTotalPoolLiquidity(ctx) {
+			for _, reserveOut := range pool.GetTotalPoolLiquidity(ctx) {
+				if reserveIn.Denom == reserveOut.Denom {
+					continue
+				}
+
+				tokenIn := sdk.NewCoin(reserveIn.Denom, reserveIn.Amount.QuoRaw(cfmmInvariantProbeRatio))
+				if !tokenIn.IsPositive() {
+					continue
+				}
+				if err := checkSwap(ctx, pool, tokenIn, reserveOut.Denom); errors.Is(err, types.ErrCFMMInvariantViolated) {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// checkJoinExitProbes runs CheckJoinExitShareValue with a fraction of all the pool reserves,
+// and with a fraction of the reserves of each pool asset alone. Joins and exits that fail are skipped.
+func checkJoinExitProbes(ctx sdk.Context, pool types.ConstantFunctionExtension) error {
+	allAssetsIn := sdk.Coins{}
+	for _, reserve := range pool.GetTotalPoolLiquidity(ctx) {
+		tokenIn := sdk.NewCoin(reserve.Denom, reserve.Amount.QuoRaw(cfmmInvariantProbeRatio))
+		if !tokenIn.IsPositive() {
+			continue
+		}
+		allAssetsIn = allAssetsIn.Add(tokenIn)
+
+		if err := CheckJoinExitShareValue(ctx, pool, sdk.NewCoins(tokenIn)); errors.Is(err, types.ErrCFMMInvariantViolated) {
+			return err
+		}
+	}
+
+	if allAssetsIn.Empty() {
+		return nil
+	}
+	if err := CheckJoinExitShareValue(ctx, pool, allAssetsIn); errors.Is(err, types.ErrCFMMInvariantViolated) {
+		return err
+	}
+	return nil
+}
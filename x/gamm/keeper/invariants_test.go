@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// fuzzCtx is the context used for operations against in-memory pools, which only consume gas.
+var fuzzCtx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+func (s *KeeperTestSuite) TestCFMMInvariants() {
+	s.SetupTest()
+	s.PrepareBalancerPool()
+	s.PrepareBalancerPoolWithCoinsAndWeights(sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 9_000_000_000)), []int64{1, 9})
+	s.PrepareBasicStableswapPool()
+	s.PrepareImbalancedStableswapPool()
+	// Concentrated liquidity pools do not implement the constant function extension and are skipped.
+	s.PrepareConcentratedPoolWithCoinsAndFullRangePosition("foo", "bar")
+
+	for _, cfmmInvariant := range keeper.CFMMInvariants() {
+		msg, broken := keeper.CFMMPoolInvariant(*s.App.GAMMKeeper, cfmmInvariant)(s.Ctx)
+		s.Require().False(broken, msg)
+	}
+
+	msg, broken := keeper.AllInvariants(*s.App.GAMMKeeper, s.App.BankKeeper)(s.Ctx)
+	s.Require().False(broken, msg)
+
+	// The invariants only simulate operations, so pools are left untouched.
+	pools, err := s.App.GAMMKeeper.GetPoolsAndPoke(s.Ctx)
+	s.Require().NoError(err)
+	for _, cfmmInvariant := range keeper.CFMMInvariants() {
+		keeper.CFMMPoolInvariant(*s.App.GAMMKeeper, cfmmInvariant)(s.Ctx)
+	}
+	poolsAfter, err := s.App.GAMMKeeper.GetPoolsAndPoke(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(pools, poolsAfter)
+}
+
+// TestCFMMInvariantsProbeErrors checks that pools whose probe operations fail, e.g. with reserves too small
+// for a probe to swap out a positive amount, are skipped rather than reported as breaking the invariants,
+// as anyone can check them with MsgVerifyInvariant.
+func (s *KeeperTestSuite) TestCFMMInvariantsProbeErrors() {
+	s.SetupTest()
+	s.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("foo", 100), Weight: sdk.NewInt(1)},
+		{Token: sdk.NewInt64Coin("bar", 100), Weight: sdk.NewInt(1)},
+	}, balancer.PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2), ExitFee: sdk.ZeroDec()})
+
+	for _, cfmmInvariant := range keeper.CFMMInvariants() {
+		msg, broken := keeper.CFMMPoolInvariant(*s.App.GAMMKeeper, cfmmInvariant)(s.Ctx)
+		s.Require().False(broken, msg)
+	}
+}
+
+// failingBalancerPool is a balancer pool whose swaps, joins and exits all fail.
+type failingBalancerPool struct {
+	*balancer.Pool
+}
+
+var errProbeFailed = errors.New("probe failed")
+
+func (p failingBalancerPool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Coin, error) {
+	return sdk.Coin{}, errProbeFailed
+}
+
+func (p failingBalancerPool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, spreadFactor sdk.Dec) (sdk.Int, error) {
+	return sdk.Int{}, errProbeFailed
+}
+
+func (p failingBalancerPool) ExitPool(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (sdk.Coins, error) {
+	return sdk.Coins{}, errProbeFailed
+}
+
+func TestCFMMInvariantsSkipFailingProbes(t *testing.T) {
+	for _, cfmmInvariant := range keeper.CFMMInvariants() {
+		pool := failingBalancerPool{newFuzzBalancerPool(t, 1_000_000, 1_000_000, 1, 1, sdk.ZeroDec())}
+		require.NoError(t, cfmmInvariant.Check(fuzzCtx, pool), cfmmInvariant.Name)
+	}
+}
+
+// leakyBalancerPool is a balancer pool whose swaps hand out one more token than the pool computes.
+type leakyBalancerPool struct {
+	*balancer.Pool
+}
+
+func (p leakyBalancerPool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, spreadFactor sdk.Dec) (sdk.Coin, error) {
+	tokenOut, err := p.Pool.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, spreadFactor)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	leaked := sdk.NewCoin(tokenOutDenom, tokenOut.Amount.AddRaw(1_000))
+	poolAsset, err := p.GetPoolAsset(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := p.UpdatePoolAssetBalance(poolAsset.Token.SubAmount(sdk.NewInt(1_000))); err != nil {
+		return sdk.Coin{}, err
+	}
+	return leaked, nil
+}
+
+func TestCheckSwapConstantFunctionViolation(t *testing.T) {
+	pool := leakyBalancerPool{newFuzzBalancerPool(t, 1_000_000, 1_000_000, 1, 1, sdk.ZeroDec())}
+
+	err := keeper.CheckSwapConstantFunction(fuzzCtx, pool, sdk.NewInt64Coin("foo", 1_000), "bar")
+	require.ErrorIs(t, err, types.ErrCFMMInvariantViolated)
+
+	// The violation is still reported when probing the pool across all denoms.
+	err = keeper.CFMMInvariants()[0].Check(fuzzCtx, pool)
+	require.ErrorIs(t, err, types.ErrCFMMInvariantViolated)
+}
+
+// FuzzCFMMInvariants checks the CFMM invariants against balancer and stableswap pools
+// with arbitrary reserves, weights, scaling factors, spread factors and operation sizes.
+// Errors from the operations themselves, e.g. swaps larger than the pool supports, are ignored.
+func FuzzCFMMInvariants(f *testing.F) {
+	f.Add(uint64(1_000_000), uint64(1_000_000), uint64(1), uint64(1), uint64(1), uint64(1_000), uint16(30), false)
+	f.Add(uint64(1_000_000), uint64(5_000_000_000), uint64(1), uint64(9), uint64(1), uint64(400_000), uint16(0), false)
+	f.Add(uint64(1_000_000), uint64(1_000_000), uint64(1), uint64(1), uint64(1), uint64(1_000), uint16(30), true)
+	f.Add(uint64(1_000_000_000), uint64(1_000_000), uint64(1), uint64(1), uint64(1_000), uint64(50_000_000), uint16(0), true)
+
+	f.Fuzz(func(t *testing.T, reserveA, reserveB, weightA, weightB, scalingFactor, amountIn uint64, spreadFactorBps uint16, isStableswap bool) {
+		// Operations are bounded by the max in ratio of balancer pools.
+		if reserveA == 0 || reserveB == 0 || amountIn == 0 || amountIn > reserveA/2 || amountIn > reserveB/2 {
+			t.Skip()
+		}
+		spreadFactor := sdk.NewDecWithPrec(int64(spreadFactorBps%1_000), 4)
+
+		newPool := func() types.ConstantFunctionExtension {
+			if isStableswap {
+				return newFuzzStableswapPool(t, reserveA, reserveB, scalingFactor, spreadFactor)
+			}
+			return newFuzzBalancerPool(t, reserveA, reserveB, weightA, weightB, spreadFactor)
+		}
+
+		tokenIn := sdk.NewCoin("foo", sdk.NewIntFromUint64(amountIn))
+		requireNoViolation(t, keeper.CheckSwapConstantFunction(fuzzCtx, newPool(), tokenIn, "bar"))
+		requireNoViolation(t, keeper.CheckSwapSpotPrice(fuzzCtx, newPool(), tokenIn, "bar"))
+		requireNoViolation(t, keeper.CheckJoinExitShareValue(fuzzCtx, newPool(), sdk.NewCoins(tokenIn)))
+		requireNoViolation(t, keeper.CheckJoinExitShareValue(fuzzCtx, newPool(), sdk.NewCoins(tokenIn, sdk.NewCoin("bar", sdk.NewIntFromUint64(amountIn)))))
+	})
+}
+
+func requireNoViolation(t *testing.T, err error) {
+	t.Helper()
+	require.False(t, errors.Is(err, types.ErrCFMMInvariantViolated), "%v", err)
+}
+
+func newFuzzBalancerPool(t *testing.T, reserveA, reserveB, weightA, weightB uint64, spreadFactor sdk.Dec) *balancer.Pool {
+	t.Helper()
+	if weightA == 0 || weightB == 0 || weightA > 1<<20 || weightB > 1<<20 {
+		t.Skip()
+	}
+
+	pool, err := balancer.NewBalancerPool(1, balancer.NewPoolParams(spreadFactor, sdk.ZeroDec(), nil), []balancer.PoolAsset{
+		{Token: sdk.NewCoin("foo", sdk.NewIntFromUint64(reserveA)), Weight: sdk.NewIntFromUint64(weightA)},
+		{Token: sdk.NewCoin("bar", sdk.NewIntFromUint64(reserveB)), Weight: sdk.NewIntFromUint64(weightB)},
+	}, "", time.Time{})
+	if err != nil {
+		t.Skip()
+	}
+	return &pool
+}
+
+func newFuzzStableswapPool(t *testing.T, reserveA, reserveB, scalingFactor uint64, spreadFactor sdk.Dec) *stableswap.Pool {
+	t.Helper()
+	if scalingFactor == 0 || scalingFactor > 1<<32 {
+		t.Skip()
+	}
+
+	pool, err := stableswap.NewStableswapPool(1, stableswap.PoolParams{SwapFee: spreadFactor, ExitFee: sdk.ZeroDec()},
+		sdk.NewCoins(sdk.NewCoin("foo", sdk.NewIntFromUint64(reserveA)), sdk.NewCoin("bar", sdk.NewIntFromUint64(reserveB))),
+		[]uint64{1, scalingFactor}, "", "")
+	if err != nil {
+		t.Skip()
+	}
+	return &pool
+}
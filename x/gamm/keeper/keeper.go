@@ -36,9 +36,12 @@ type Keeper struct {
 	concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
 	poolIncentivesKeeper        types.PoolIncentivesKeeper
 	incentivesKeeper            types.IncentivesKeeper
+	lockupKeeper                types.LockupKeeper
+	twapKeeper                  types.TwapKeeper
+	wasmKeeper                  types.WasmKeeper
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper, concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper, poolIncentivesKeeper types.PoolIncentivesKeeper, incentivesKeeper types.IncentivesKeeper, lockupKeeper types.LockupKeeper) Keeper {
 	// Ensure that the module account are set.
 	moduleAddr, perms := accountKeeper.GetModuleAddressAndPermissions(types.ModuleName)
 	if moduleAddr == nil {
@@ -64,6 +67,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtyp
 		concentratedLiquidityKeeper: concentratedLiquidityKeeper,
 		poolIncentivesKeeper:        poolIncentivesKeeper,
 		incentivesKeeper:            incentivesKeeper,
+		lockupKeeper:                lockupKeeper,
 	}
 }
 
@@ -108,3 +112,13 @@ func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentiv
 func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
 	k.incentivesKeeper = incentivesKeeper
 }
+
+// Set the twap keeper.
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
+// Set the wasm keeper.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
@@ -63,6 +63,16 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapSetScalingFactorRateSource(goCtx context.Context, msg *stableswap.MsgStableSwapSetScalingFactorRateSource) (*stableswap.MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setStableSwapScalingFactorRateSource(ctx, msg.PoolID, msg.RateSource, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapSetScalingFactorRateSourceResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// setStableSwapScalingFactorRateSource sets the source the stable swap pool's scaling factors are
+// automatically updated from. A nil rateSource removes the existing one.
+// errors if the pool does not exist, is not a stableswap pool, the sender is not the scaling factor
+// controller or the rate source is invalid for the pool, including a TWAP source pool that does not
+// contain the stableswap pool's denoms.
+func (k Keeper) setStableSwapScalingFactorRateSource(ctx sdk.Context, poolId uint64, rateSource *stableswap.ScalingFactorRateSource, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.SetScalingFactorRateSource(rateSource, sender); err != nil {
+		return err
+	}
+	if rateSource != nil && !rateSource.IsContractSource() {
+		if err := k.validateTwapRateSourceDenoms(ctx, rateSource.TwapPoolId, stableswapPool.PoolLiquidity); err != nil {
+			return err
+		}
+	}
+
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+	k.setScalingFactorRateSourcePoolIndex(ctx, stableswapPool)
+	return nil
+}
+
+// validateTwapRateSourceDenoms checks that the pool with id twapPoolId contains every denom of poolLiquidity,
+// so that its TWAP can be read between them.
+func (k Keeper) validateTwapRateSourceDenoms(ctx sdk.Context, twapPoolId uint64, poolLiquidity sdk.Coins) error {
+	twapPoolDenoms, err := k.poolManager.RouteGetPoolDenoms(ctx, twapPoolId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "failed to get denoms of twap pool %d (%s)", twapPoolId, err)
+	}
+
+	for _, coin := range poolLiquidity {
+		if !osmoutils.Contains(twapPoolDenoms, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "twap pool %d does not contain denom %s", twapPoolId, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// setScalingFactorRateSourcePoolIndex adds the pool to the index of stableswap pools with a scaling factor
+// rate source if it has one, and removes it from the index otherwise.
+func (k Keeper) setScalingFactorRateSourcePoolIndex(ctx sdk.Context, pool *stableswap.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixScalingFactorRateSourcePool(pool.Id)
+	if pool.ScalingFactorRateSource == nil {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte{})
+}
+
+// getScalingFactorRateSourcePoolIds returns the ids of the stableswap pools that have a scaling factor rate source.
+func (k Keeper) getScalingFactorRateSourcePoolIds(ctx sdk.Context) []uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixScalingFactorRateSourcePools)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixScalingFactorRateSourcePools):]))
+	}
+	return poolIds
+}
+
+// UpdateScalingFactorsFromRateSources reads new scaling factors for every stableswap pool
+// that has a ScalingFactorRateSource and applies them. Only the pools in the index of pools
+// with a rate source are read.
+// A pool whose rate source fails to produce valid scaling factors, or scaling factors that change
+// by more than the MaxScalingFactorChangePerUpdate param, is skipped and keeps its current scaling
+// factors, so that a single misbehaving source cannot halt the epoch.
+func (k Keeper) UpdateScalingFactorsFromRateSources(ctx sdk.Context) error {
+	maxChange := k.GetParams(ctx).MaxScalingFactorChangePerUpdate
+
+	for _, poolId := range k.getScalingFactorRateSourcePoolIds(ctx) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.updateScalingFactorsFromRateSource(cacheCtx, poolId, maxChange)
+		})
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to update scaling factors of pool %d from its rate source: %v", poolId, err))
+		}
+	}
+
+	return nil
+}
+
+// updateScalingFactorsFromRateSource reads the scaling factors from the given pool's rate source,
+// checks that they change by at most maxChange, sets them on the pool and emits an event.
+func (k Keeper) updateScalingFactorsFromRateSource(ctx sdk.Context, poolId uint64, maxChange sdk.Dec) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok || stableswapPool.ScalingFactorRateSource == nil {
+		return fmt.Errorf("pool id %d is not a stableswap pool with a scaling factor rate source", poolId)
+	}
+
+	scalingFactors, err := k.getScalingFactorsFromRateSource(ctx, stableswapPool.Id, stableswapPool.PoolLiquidity, *stableswapPool.ScalingFactorRateSource)
+	if err != nil {
+		return err
+	}
+
+	if err := stableswap.ValidateScalingFactorChange(stableswapPool.GetScalingFactors(), scalingFactors, maxChange); err != nil {
+		return err
+	}
+
+	if err := stableswapPool.UpdateScalingFactors(scalingFactors); err != nil {
+		return err
+	}
+
+	if err := k.setPool(ctx, stableswapPool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtScalingFactorsUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, fmt.Sprintf("%d", stableswapPool.Id)),
+		sdk.NewAttribute(types.AttributeKeyScalingFactors, fmt.Sprintf("%v", scalingFactors)),
+	))
+	return nil
+}
+
+// getScalingFactorsFromRateSource returns the scaling factors produced by rateSource for the pool
+// with the given id and liquidity. The returned scaling factors do not include the scaling factor multiplier.
+func (k Keeper) getScalingFactorsFromRateSource(ctx sdk.Context, poolId uint64, poolLiquidity sdk.Coins, rateSource stableswap.ScalingFactorRateSource) ([]uint64, error) {
+	if err := rateSource.ValidateForPool(poolLiquidity.Len()); err != nil {
+		return nil, err
+	}
+
+	if rateSource.IsContractSource() {
+		if k.wasmKeeper == nil {
+			return nil, fmt.Errorf("wasm keeper is not set, cannot query rate source contract %s", rateSource.ContractAddress)
+		}
+
+		response, err := k.queryRateSourceContract(ctx, poolId, rateSource.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		return stableswap.ScalingFactorsFromContractResponse(response)
+	}
+
+	if k.twapKeeper == nil {
+		return nil, fmt.Errorf("twap keeper is not set, cannot read twap of pool %d", rateSource.TwapPoolId)
+	}
+
+	// Pool liquidity is sorted by denom, so the first asset is the quote and the second one the base
+	// of the price that is read.
+	startTime := ctx.BlockTime().Add(-rateSource.TwapWindow)
+	twap, err := k.twapKeeper.GetGeometricTwapToNow(ctx, rateSource.TwapPoolId, poolLiquidity[1].Denom, poolLiquidity[0].Denom, startTime)
+	if err != nil {
+		return nil, err
+	}
+	return rateSource.ScalingFactorsFromTwap(twap)
+}
+
+// queryRateSourceContract queries the rate source contract for the scaling factors of the pool with the given id,
+// within the RateSourceQueryGasLimit param. The query runs on a cache context so that a contract running
+// out of gas returns an error instead of panicking.
+func (k Keeper) queryRateSourceContract(ctx sdk.Context, poolId uint64, contractAddress string) (response stableswap.GetScalingFactorsResponse, err error) {
+	gasMeter := sdk.NewGasMeter(k.GetParams(ctx).RateSourceQueryGasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			err = fmt.Errorf("rate source contract %s ran out of gas querying the scaling factors of pool %d", contractAddress, poolId)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "scaling factor rate source query")
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	request := stableswap.GetScalingFactorsRequest{GetScalingFactors: stableswap.GetScalingFactors{PoolId: poolId}}
+	return cosmwasm.Query[stableswap.GetScalingFactorsRequest, stableswap.GetScalingFactorsResponse](cacheCtx.WithGasMeter(gasMeter), k.wasmKeeper, contractAddress, request)
+}
+
+// MigrateScalingFactorRateSourceParams sets the max scaling factor change per update and the rate source
+// query gas limit params, introduced after genesis, to their default values.
+func (k Keeper) MigrateScalingFactorRateSourceParams(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	k.paramSpace.Set(ctx, types.KeyMaxScalingFactorChangePerUpdate, defaultParams.MaxScalingFactorChangePerUpdate)
+	k.paramSpace.Set(ctx, types.KeyRateSourceQueryGasLimit, defaultParams.RateSourceQueryGasLimit)
+	return nil
+}
@@ -0,0 +1,249 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// prepareControlledStableswapPool creates a bar/foo stableswap pool whose scaling factor controller is controller.
+func (s *KeeperTestSuite) prepareControlledStableswapPool(controller sdk.AccAddress) uint64 {
+	poolId := s.prepareCustomStableswapPool(
+		defaultAcctFunds,
+		stableswap.PoolParams{
+			SwapFee: defaultSpreadFactor,
+			ExitFee: defaultZeroExitFee,
+		},
+		sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(5000000)), sdk.NewCoin("foo", sdk.NewInt(5000000))),
+		defaultScalingFactor,
+	)
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	stableswapPool := pool.(*stableswap.Pool)
+	stableswapPool.ScalingFactorController = controller.String()
+	s.Require().NoError(s.App.GAMMKeeper.SetPool(s.Ctx, stableswapPool))
+	return poolId
+}
+
+func (s *KeeperTestSuite) TestSetStableSwapScalingFactorRateSource() {
+	controllerAddr := s.TestAccs[0]
+	twapRateSource := func(twapPoolId uint64) *stableswap.ScalingFactorRateSource {
+		return &stableswap.ScalingFactorRateSource{TwapPoolId: twapPoolId, TwapWindow: time.Hour, TwapPrecision: 1000}
+	}
+
+	tests := map[string]struct {
+		isStableSwapPool bool
+		// twap pool assets, no twap pool is created if nil.
+		twapPoolAssets []balancer.PoolAsset
+		rateSource     func(twapPoolId uint64) *stableswap.ScalingFactorRateSource
+		sender         sdk.AccAddress
+		expectedErr    error
+	}{
+		"valid rate source": {
+			isStableSwapPool: true,
+			twapPoolAssets:   defaultPoolAssets,
+			rateSource:       twapRateSource,
+			sender:           controllerAddr,
+		},
+		"remove rate source": {
+			isStableSwapPool: true,
+			rateSource:       func(uint64) *stableswap.ScalingFactorRateSource { return nil },
+			sender:           controllerAddr,
+		},
+		"not scaling factor controller": {
+			isStableSwapPool: true,
+			twapPoolAssets:   defaultPoolAssets,
+			rateSource:       twapRateSource,
+			sender:           s.TestAccs[1],
+			expectedErr:      types.ErrNotScalingFactorGovernor,
+		},
+		"invalid rate source": {
+			isStableSwapPool: true,
+			rateSource:       func(uint64) *stableswap.ScalingFactorRateSource { return &stableswap.ScalingFactorRateSource{} },
+			sender:           controllerAddr,
+			expectedErr:      types.ErrInvalidScalingFactorRateSource,
+		},
+		"twap pool does not contain the pool denoms": {
+			isStableSwapPool: true,
+			twapPoolAssets: []balancer.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(10000))},
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("baz", sdk.NewInt(10000))},
+			},
+			rateSource:  twapRateSource,
+			sender:      controllerAddr,
+			expectedErr: types.ErrInvalidScalingFactorRateSource,
+		},
+		"twap pool does not exist": {
+			isStableSwapPool: true,
+			rateSource:       func(uint64) *stableswap.ScalingFactorRateSource { return twapRateSource(100) },
+			sender:           controllerAddr,
+			expectedErr:      types.ErrInvalidScalingFactorRateSource,
+		},
+		"not a stableswap pool": {
+			rateSource: twapRateSource,
+			sender:     controllerAddr,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			var twapPoolId uint64
+			if tc.twapPoolAssets != nil {
+				twapPoolId = s.prepareCustomBalancerPool(defaultAcctFunds, tc.twapPoolAssets, defaultPoolParams)
+			}
+			var poolId uint64
+			if tc.isStableSwapPool {
+				poolId = s.prepareControlledStableswapPool(controllerAddr)
+			} else {
+				poolId = s.prepareCustomBalancerPool(defaultAcctFunds, defaultPoolAssets, defaultPoolParams)
+			}
+			rateSource := tc.rateSource(twapPoolId)
+
+			err := s.App.GAMMKeeper.SetStableSwapScalingFactorRateSource(s.Ctx, poolId, rateSource, tc.sender.String())
+			if !tc.isStableSwapPool {
+				s.Require().Error(err)
+				return
+			}
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Empty(s.App.GAMMKeeper.GetScalingFactorRateSourcePoolIds(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(rateSource, pool.(*stableswap.Pool).ScalingFactorRateSource)
+
+			// Only pools with a rate source are indexed.
+			if rateSource == nil {
+				s.Require().Empty(s.App.GAMMKeeper.GetScalingFactorRateSourcePoolIds(s.Ctx))
+			} else {
+				s.Require().Equal([]uint64{poolId}, s.App.GAMMKeeper.GetScalingFactorRateSourcePoolIds(s.Ctx))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateScalingFactorsFromRateSources() {
+	s.SetupTest()
+	controllerAddr := s.TestAccs[0]
+
+	// foo is worth half a bar in this pool.
+	twapPoolId := s.prepareCustomBalancerPool(defaultAcctFunds, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(20000))},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.NewInt(10000))},
+	}, defaultPoolParams)
+	twapSourcePoolId := s.prepareControlledStableswapPool(controllerAddr)
+	failingSourcePoolId := s.prepareControlledStableswapPool(controllerAddr)
+
+	err := s.App.GAMMKeeper.SetStableSwapScalingFactorRateSource(s.Ctx, twapSourcePoolId,
+		&stableswap.ScalingFactorRateSource{TwapPoolId: twapPoolId, TwapWindow: time.Hour, TwapPrecision: 1000}, controllerAddr.String())
+	s.Require().NoError(err)
+	// there is no contract at this address to query.
+	err = s.App.GAMMKeeper.SetStableSwapScalingFactorRateSource(s.Ctx, failingSourcePoolId,
+		&stableswap.ScalingFactorRateSource{ContractAddress: s.TestAccs[1].String()}, controllerAddr.String())
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+	// The twap doubles the price ratio between the scaling factors, which is more than the default max change.
+	err = s.App.GAMMKeeper.UpdateScalingFactorsFromRateSources(s.Ctx)
+	s.Require().NoError(err)
+
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, twapSourcePoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultScalingFactor, pool.(*stableswap.Pool).GetScalingFactors())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtScalingFactorsUpdated, 0)
+
+	params := s.App.GAMMKeeper.GetParams(s.Ctx)
+	params.MaxScalingFactorChangePerUpdate = sdk.OneDec()
+	s.App.GAMMKeeper.SetParams(s.Ctx, params)
+
+	err = s.App.GAMMKeeper.UpdateScalingFactorsFromRateSources(s.Ctx)
+	s.Require().NoError(err)
+
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, twapSourcePoolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1000, 2000}, pool.(*stableswap.Pool).GetScalingFactors())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtScalingFactorsUpdated, 1)
+
+	pool, err = s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, failingSourcePoolId)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultScalingFactor, pool.(*stableswap.Pool).GetScalingFactors())
+}
+
+// TestUpdateScalingFactorsFromRateSourcesPriceOfOne checks that a twap rate source whose pool has a price of one,
+// for which the geometric TWAP of the log price averages to zero, gives equal scaling factors.
+func (s *KeeperTestSuite) TestUpdateScalingFactorsFromRateSourcesPriceOfOne() {
+	s.SetupTest()
+	controllerAddr := s.TestAccs[0]
+
+	twapPoolId := s.prepareCustomBalancerPool(defaultAcctFunds, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(10000))},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.NewInt(10000))},
+	}, defaultPoolParams)
+	twapSourcePoolId := s.prepareControlledStableswapPool(controllerAddr)
+
+	err := s.App.GAMMKeeper.SetStableSwapScalingFactorRateSource(s.Ctx, twapSourcePoolId,
+		&stableswap.ScalingFactorRateSource{TwapPoolId: twapPoolId, TwapWindow: time.Hour, TwapPrecision: 1000}, controllerAddr.String())
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+	err = s.App.GAMMKeeper.UpdateScalingFactorsFromRateSources(s.Ctx)
+	s.Require().NoError(err)
+
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, twapSourcePoolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1000, 1000}, pool.(*stableswap.Pool).GetScalingFactors())
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtScalingFactorsUpdated, 1)
+}
+
+// TestQueryRateSourceContractOutOfGas checks that a rate source contract query exceeding the
+// RateSourceQueryGasLimit param returns an error instead of panicking, and that the pool then keeps its scaling factors.
+func (s *KeeperTestSuite) TestQueryRateSourceContractOutOfGas() {
+	s.SetupTest()
+	params := s.App.GAMMKeeper.GetParams(s.Ctx)
+	params.RateSourceQueryGasLimit = 1
+	s.App.GAMMKeeper.SetParams(s.Ctx, params)
+
+	_, err := s.App.GAMMKeeper.QueryRateSourceContract(s.Ctx, 1, s.TestAccs[1].String())
+	s.Require().ErrorContains(err, "ran out of gas")
+
+	controllerAddr := s.TestAccs[0]
+	poolId := s.prepareControlledStableswapPool(controllerAddr)
+	err = s.App.GAMMKeeper.SetStableSwapScalingFactorRateSource(s.Ctx, poolId,
+		&stableswap.ScalingFactorRateSource{ContractAddress: s.TestAccs[1].String()}, controllerAddr.String())
+	s.Require().NoError(err)
+
+	s.Require().NotPanics(func() {
+		err = s.App.GAMMKeeper.UpdateScalingFactorsFromRateSources(s.Ctx)
+	})
+	s.Require().NoError(err)
+
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultScalingFactor, pool.(*stableswap.Pool).GetScalingFactors())
+}
+
+func (s *KeeperTestSuite) TestMigrateScalingFactorRateSourceParams() {
+	s.SetupTest()
+	// remove the params, as they are absent from the state of chains started before they were introduced.
+	paramsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Delete(types.KeyMaxScalingFactorChangePerUpdate)
+	paramsStore.Delete(types.KeyRateSourceQueryGasLimit)
+	s.Require().Panics(func() { s.App.GAMMKeeper.GetParams(s.Ctx) })
+
+	err := s.App.GAMMKeeper.MigrateScalingFactorRateSourceParams(s.Ctx)
+	s.Require().NoError(err)
+
+	s.Require().Equal(types.DefaultParams(), s.App.GAMMKeeper.GetParams(s.Ctx))
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// GetSharePrice returns the value of one share of the given pool, i.e. types.OneShare share base units, in quoteDenom.
+// See GetSharesValue for details on how the shares are valued.
+func (k Keeper) GetSharePrice(ctx sdk.Context, poolId uint64, quoteDenom string, pricePoolId uint64, twapWindow time.Duration) (sdk.Dec, error) {
+	return k.GetSharesValue(ctx, poolId, types.OneShare, quoteDenom, pricePoolId, twapWindow)
+}
+
+// GetAccountPoolShares returns the amount of shares of the given pool held by the given account
+// in its balance, locked and unlocking.
+func (k Keeper) GetAccountPoolShares(ctx sdk.Context, addr sdk.AccAddress, poolId uint64) (balanceShares, lockedShares, unlockingShares sdk.Int) {
+	shareDenom := types.GetPoolShareDenom(poolId)
+
+	// Locked coins include the unlocking ones.
+	unlockingShares = k.lockupKeeper.GetAccountUnlockingCoins(ctx, addr).AmountOf(shareDenom)
+	lockedShares = k.lockupKeeper.GetAccountLockedCoins(ctx, addr).AmountOf(shareDenom).Sub(unlockingShares)
+	balanceShares = k.bankKeeper.GetBalance(ctx, addr, shareDenom).Amount
+	return balanceShares, lockedShares, unlockingShares
+}
+
+// GetSharesValue returns the value of shareAmount shares of the given pool in quoteDenom.
+// The shares are converted into the pool assets they are redeemable for with CalcExitPoolCoinsFromShares,
+// which are then priced with geometric TWAPs over twapWindow, as opposed to spot prices, so that the value
+// cannot be manipulated within a block.
+// If quoteDenom is one of the pool assets, the pool assets are priced against the pool itself. Otherwise,
+// they are priced against the asset shared by the pool and the pool with id pricePoolId, which is then priced
+// in quoteDenom against pricePoolId.
+// If twapWindow is zero, types.DefaultShareValueTwapWindow is used.
+func (k Keeper) GetSharesValue(ctx sdk.Context, poolId uint64, shareAmount sdk.Int, quoteDenom string, pricePoolId uint64, twapWindow time.Duration) (sdk.Dec, error) {
+	if shareAmount.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+
+	pool, err := k.GetCFMMPool(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	exitCoins, err := k.calcExitPoolCoinsForValuation(ctx, pool, shareAmount)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if twapWindow == 0 {
+		twapWindow = types.DefaultShareValueTwapWindow
+	}
+	startTime := ctx.BlockTime().Add(-twapWindow)
+
+	prices, err := k.getPoolAssetTwapPrices(ctx, pool, quoteDenom, pricePoolId, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	value := sdk.ZeroDec()
+	for _, exitCoin := range exitCoins {
+		value = value.Add(prices[exitCoin.Denom].MulInt(exitCoin.Amount))
+	}
+
+	return value, nil
+}
+
+// calcExitPoolCoinsForValuation returns the coins redeemable for shareAmount shares of the pool.
+// CalcExitPoolCoinsFromShares does not allow exiting with all the shares of a pool. Since those are
+// redeemable for the entire pool liquidity minus the exit fee, the liquidity is used directly instead.
+func (k Keeper) calcExitPoolCoinsForValuation(ctx sdk.Context, pool types.CFMMPoolI, shareAmount sdk.Int) (sdk.Coins, error) {
+	exitFee := pool.GetExitFee(ctx)
+	if shareAmount.LT(pool.GetTotalShares()) {
+		return pool.CalcExitPoolCoinsFromShares(ctx, shareAmount, exitFee)
+	}
+
+	if shareAmount.GT(pool.GetTotalShares()) {
+		return sdk.Coins{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "cannot value (%s) shares, pool has (%s) shares", shareAmount, pool.GetTotalShares())
+	}
+
+	exitCoins := sdk.Coins{}
+	for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+		exitCoins = exitCoins.Add(sdk.NewCoin(asset.Denom, sdk.OneDec().Sub(exitFee).MulInt(asset.Amount).TruncateInt()))
+	}
+	return exitCoins, nil
+}
+
+// getPoolAssetTwapPrices returns the geometric TWAP price of every asset of the pool in quoteDenom, since startTime.
+// If quoteDenom is not one of the pool assets, the first pool asset contained in the pool with id pricePoolId
+// is used as an intermediary to price the pool assets in quoteDenom.
+func (k Keeper) getPoolAssetTwapPrices(ctx sdk.Context, pool types.CFMMPoolI, quoteDenom string, pricePoolId uint64, startTime time.Time) (map[string]sdk.Dec, error) {
+	poolDenoms := osmoutils.CoinsDenoms(pool.GetTotalPoolLiquidity(ctx))
+
+	intermediaryDenom, intermediaryPrice := quoteDenom, sdk.OneDec()
+	if !osmoutils.Contains(poolDenoms, quoteDenom) {
+		var err error
+		intermediaryDenom, err = k.getSharePriceIntermediaryDenom(ctx, poolDenoms, quoteDenom, pricePoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		intermediaryPrice, err = k.twapKeeper.GetGeometricTwapToNow(ctx, pricePoolId, intermediaryDenom, quoteDenom, startTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	prices := make(map[string]sdk.Dec, len(poolDenoms))
+	for _, denom := range poolDenoms {
+		if denom == intermediaryDenom {
+			prices[denom] = intermediaryPrice
+			continue
+		}
+
+		price, err := k.twapKeeper.GetGeometricTwapToNow(ctx, pool.GetId(), denom, intermediaryDenom, startTime)
+		if err != nil {
+			return nil, err
+		}
+		prices[denom] = price.Mul(intermediaryPrice)
+	}
+
+	return prices, nil
+}
+
+// getSharePriceIntermediaryDenom returns the first of the given pool denoms that is
+// contained in the pool with id pricePoolId, alongside quoteDenom.
+func (k Keeper) getSharePriceIntermediaryDenom(ctx sdk.Context, poolDenoms []string, quoteDenom string, pricePoolId uint64) (string, error) {
+	if pricePoolId == 0 {
+		return "", errorsmod.Wrapf(types.ErrInvalidSharePriceQuote, "quote denom (%s) is not in pool and no price pool is given", quoteDenom)
+	}
+
+	poolModule, err := k.poolManager.GetPoolModule(ctx, pricePoolId)
+	if err != nil {
+		return "", err
+	}
+	pricePoolDenoms, err := poolModule.GetPoolDenoms(ctx, pricePoolId)
+	if err != nil {
+		return "", err
+	}
+	if !osmoutils.Contains(pricePoolDenoms, quoteDenom) {
+		return "", errorsmod.Wrapf(types.ErrInvalidSharePriceQuote, "quote denom (%s) is not in price pool (%d)", quoteDenom, pricePoolId)
+	}
+
+	for _, denom := range poolDenoms {
+		if osmoutils.Contains(pricePoolDenoms, denom) {
+			return denom, nil
+		}
+	}
+
+	return "", errorsmod.Wrapf(types.ErrInvalidSharePriceQuote, "price pool (%d) does not contain any pool asset", pricePoolId)
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+var shareValueTolerance = sdk.NewDecWithPrec(1, 6)
+
+// setupShareValuePools creates a FOO/BAR pool where FOO is worth 2 BAR and a BAR/BAZ pool
+// where BAR is worth 3 BAZ, and moves the block time past the default TWAP window.
+func (s *KeeperTestSuite) setupShareValuePools() (poolId, pricePoolId uint64) {
+	poolId = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.FOO, 1_000_000), sdk.NewInt64Coin(apptesting.BAR, 2_000_000))
+	pricePoolId = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.BAR, 1_000_000), sdk.NewInt64Coin(apptesting.BAZ, 3_000_000))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	return poolId, pricePoolId
+}
+
+func (s *KeeperTestSuite) TestSharePrice() {
+	// One share out of 100 exits 10_000 FOO and 20_000 BAR, worth 40_000 BAR.
+	tests := map[string]struct {
+		quoteDenom    string
+		usePricePool  bool
+		twapWindow    time.Duration
+		expectedPrice sdk.Dec
+		expectedErr   error
+	}{
+		"quote denom in pool": {
+			quoteDenom:    apptesting.BAR,
+			expectedPrice: sdk.NewDec(40_000),
+		},
+		"other quote denom in pool": {
+			quoteDenom:    apptesting.FOO,
+			twapWindow:    time.Minute,
+			expectedPrice: sdk.NewDec(20_000),
+		},
+		"quote denom through price pool": {
+			quoteDenom:    apptesting.BAZ,
+			usePricePool:  true,
+			expectedPrice: sdk.NewDec(120_000),
+		},
+		"error: quote denom not in pool and no price pool": {
+			quoteDenom:  apptesting.BAZ,
+			expectedErr: types.ErrInvalidSharePriceQuote,
+		},
+		"error: quote denom not in price pool": {
+			quoteDenom:   apptesting.UOSMO,
+			usePricePool: true,
+			expectedErr:  types.ErrInvalidSharePriceQuote,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, pricePoolId := s.setupShareValuePools()
+			if !tc.usePricePool {
+				pricePoolId = 0
+			}
+
+			res, err := keeper.NewQuerier(*s.App.GAMMKeeper).SharePrice(sdk.WrapSDKContext(s.Ctx), &types.QuerySharePriceRequest{
+				PoolId:      poolId,
+				QuoteDenom:  tc.quoteDenom,
+				PricePoolId: pricePoolId,
+				TwapWindow:  tc.twapWindow,
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), tc.expectedPrice, res.SharePrice, tc.expectedPrice.Mul(shareValueTolerance))
+		})
+	}
+}
+
+// TestSharePriceOfOne checks the share price of a pool whose assets, and those of the price pool,
+// have a price of one, for which the geometric TWAP of the log price averages to zero.
+func (s *KeeperTestSuite) TestSharePriceOfOne() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.FOO, 1_000_000), sdk.NewInt64Coin(apptesting.BAR, 1_000_000))
+	pricePoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(apptesting.BAR, 1_000_000), sdk.NewInt64Coin(apptesting.BAZ, 1_000_000))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+
+	// One share out of 100 exits 10_000 FOO and 10_000 BAR, worth 20_000 BAR and 20_000 BAZ.
+	expectedPrice := sdk.NewDec(20_000)
+	for _, req := range []types.QuerySharePriceRequest{
+		{PoolId: poolId, QuoteDenom: apptesting.BAR},
+		{PoolId: poolId, QuoteDenom: apptesting.BAZ, PricePoolId: pricePoolId},
+	} {
+		res, err := keeper.NewQuerier(*s.App.GAMMKeeper).SharePrice(sdk.WrapSDKContext(s.Ctx), &req)
+		s.Require().NoError(err)
+		osmoassert.DecApproxEq(s.T(), expectedPrice, res.SharePrice, expectedPrice.Mul(shareValueTolerance))
+	}
+}
+
+func (s *KeeperTestSuite) TestAccountPoolPositionsValue() {
+	poolId, _ := s.setupShareValuePools()
+	shareDenom := types.GetPoolShareDenom(poolId)
+	oneShare := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(shareDenom, types.OneShare.MulRaw(amount)))
+	}
+
+	// The pool creator holds all 100 shares in its balance.
+	res, err := keeper.NewQuerier(*s.App.GAMMKeeper).AccountPoolPositionsValue(sdk.WrapSDKContext(s.Ctx), &types.QueryAccountPoolPositionsValueRequest{
+		Address:    s.TestAccs[0].String(),
+		PoolId:     poolId,
+		QuoteDenom: apptesting.BAR,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(oneShare(100)[0], res.BalanceShares)
+	osmoassert.DecApproxEq(s.T(), sdk.NewDec(4_000_000), res.BalanceValue, sdk.NewDec(4_000_000).Mul(shareValueTolerance))
+	s.Require().True(res.LockedValue.IsZero())
+	s.Require().True(res.UnlockingValue.IsZero())
+
+	// Another account holds 30 shares in its balance and 20 locked shares, 5 of which are unlocking.
+	addr := s.TestAccs[1]
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[0], addr, oneShare(30)))
+	lockId := s.LockTokens(addr, oneShare(20), time.Hour)
+	_, err = s.App.LockupKeeper.BeginUnlock(s.Ctx, lockId, oneShare(5))
+	s.Require().NoError(err)
+
+	res, err = keeper.NewQuerier(*s.App.GAMMKeeper).AccountPoolPositionsValue(sdk.WrapSDKContext(s.Ctx), &types.QueryAccountPoolPositionsValueRequest{
+		Address:    addr.String(),
+		PoolId:     poolId,
+		QuoteDenom: apptesting.BAR,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(oneShare(30)[0], res.BalanceShares)
+	s.Require().Equal(oneShare(15)[0], res.LockedShares)
+	s.Require().Equal(oneShare(5)[0], res.UnlockingShares)
+	for _, value := range []struct{ expected, actual sdk.Dec }{
+		{sdk.NewDec(1_200_000), res.BalanceValue},
+		{sdk.NewDec(600_000), res.LockedValue},
+		{sdk.NewDec(200_000), res.UnlockingValue},
+		{sdk.NewDec(2_000_000), res.TotalValue},
+	} {
+		osmoassert.DecApproxEq(s.T(), value.expected, value.actual, value.expected.Mul(shareValueTolerance))
+	}
+}
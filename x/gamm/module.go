@@ -108,6 +108,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	stableswap.RegisterMsgServer(cfg.MsgServer(), keeper.NewStableswapMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 	v2types.RegisterQueryServer(cfg.QueryServer(), keeper.NewV2Querier(am.keeper))
+
+	if err := cfg.RegisterMigration(types.ModuleName, 1, am.keeper.MigrateScalingFactorRateSourceParams); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 1 to 2: %s", types.ModuleName, err))
+	}
 }
 
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper,
@@ -168,7 +172,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // **** simulation implementation ****
 // GenerateGenesisState creates a randomized GenState of the gamm module.
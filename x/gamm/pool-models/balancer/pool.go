@@ -10,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/internal/cfmm_common"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
@@ -28,10 +29,11 @@ const (
 )
 
 var (
-	_ poolmanagertypes.PoolI       = &Pool{}
-	_ types.PoolAmountOutExtension = &Pool{}
-	_ types.WeightedPoolExtension  = &Pool{}
-	_ types.CFMMPoolI              = &Pool{}
+	_ poolmanagertypes.PoolI          = &Pool{}
+	_ types.PoolAmountOutExtension    = &Pool{}
+	_ types.WeightedPoolExtension     = &Pool{}
+	_ types.ConstantFunctionExtension = &Pool{}
+	_ types.CFMMPoolI                 = &Pool{}
 )
 
 // NewPool returns a weighted CPMM pool with the provided parameters, and initial assets.
@@ -647,6 +649,24 @@ func (p Pool) SpotPrice(ctx sdk.Context, quoteAsset, baseAsset string) (spotPric
 	return spotPrice, err
 }
 
+// Log2ConstantFunctionValue returns the base 2 logarithm of the weighted geometric mean of the pool reserves,
+// i.e. log2(prod_i(B_i^(W_i / W))) where B_i and W_i are the balance and weight of asset i and W the total weight.
+// This is the balancer constant function prod_i(B_i^W_i), normalized to be homogeneous of degree one.
+func (p Pool) Log2ConstantFunctionValue(ctx sdk.Context) (osmomath.BigDec, error) {
+	balances := make([]osmomath.BigDec, len(p.PoolAssets))
+	weights := make([]osmomath.BigDec, len(p.PoolAssets))
+	for i, asset := range p.PoolAssets {
+		balances[i] = osmomath.BigDecFromSDKDec(asset.Token.Amount.ToDec())
+		weights[i] = osmomath.BigDecFromSDKDec(asset.Weight.ToDec())
+	}
+
+	log2Product, err := cfmm_common.Log2WeightedProduct(balances, weights)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return log2Product.Quo(osmomath.BigDecFromSDKDec(p.TotalWeight.ToDec())), nil
+}
+
 // calcPoolOutGivenSingleIn - balance pAo.
 func (p *Pool) calcSingleAssetJoin(tokenIn sdk.Coin, spreadFactor sdk.Dec, tokenInPoolAsset PoolAsset, totalShares sdk.Int) (numShares sdk.Int, err error) {
 	_, err = p.GetPoolAsset(tokenIn.Denom)
@@ -0,0 +1,27 @@
+package cfmm_common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Log2WeightedProduct returns log2(prod_i(values_i^weights_i)) = sum_i(weights_i * log2(values_i)).
+// Working with logarithms allows comparing constant function values that would otherwise be too large
+// to compute, or that require non-integer exponents.
+// Returns an error if the lengths of values and weights differ, or if any value is not positive.
+func Log2WeightedProduct(values []osmomath.BigDec, weights []osmomath.BigDec) (osmomath.BigDec, error) {
+	if len(values) != len(weights) {
+		return osmomath.BigDec{}, errors.New("values and weights must have the same length")
+	}
+
+	log2Product := osmomath.ZeroDec()
+	for i, value := range values {
+		if !value.IsPositive() {
+			return osmomath.BigDec{}, fmt.Errorf("values must be positive, got (%s)", value)
+		}
+		log2Product = log2Product.Add(weights[i].Mul(value.LogBase2()))
+	}
+	return log2Product, nil
+}
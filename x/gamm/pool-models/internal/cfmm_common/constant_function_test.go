@@ -0,0 +1,53 @@
+package cfmm_common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/internal/cfmm_common"
+)
+
+func TestLog2WeightedProduct(t *testing.T) {
+	tests := map[string]struct {
+		values  []osmomath.BigDec
+		weights []osmomath.BigDec
+
+		expected    osmomath.BigDec
+		expectError bool
+	}{
+		"unit weights": {
+			values:   []osmomath.BigDec{osmomath.NewBigDec(4), osmomath.NewBigDec(8)},
+			weights:  []osmomath.BigDec{osmomath.OneDec(), osmomath.OneDec()},
+			expected: osmomath.NewBigDec(5),
+		},
+		"fractional weights": {
+			values:   []osmomath.BigDec{osmomath.NewBigDec(16), osmomath.NewBigDec(2)},
+			weights:  []osmomath.BigDec{osmomath.NewDecWithPrec(25, 2), osmomath.NewBigDec(3)},
+			expected: osmomath.NewBigDec(4),
+		},
+		"mismatched lengths": {
+			values:      []osmomath.BigDec{osmomath.NewBigDec(2)},
+			weights:     []osmomath.BigDec{},
+			expectError: true,
+		},
+		"non-positive value": {
+			values:      []osmomath.BigDec{osmomath.ZeroDec()},
+			weights:     []osmomath.BigDec{osmomath.OneDec()},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			log2Product, err := cfmm_common.Log2WeightedProduct(tc.values, tc.weights)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(osmomath.DecApproxEq(t, tc.expected, log2Product, osmomath.NewDecWithPrec(1, 30)))
+		})
+	}
+}
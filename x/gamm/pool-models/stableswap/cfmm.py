@@ -3,6 +3,16 @@
 
 cfmm = lambda x,y: x*y*(x*x + y*y)
 
+# Multi-asset CFMM prod(x_i) * sum(x_i^2), normalized to be homogeneous of degree one
+# by taking its (n + 2)-th root. This is the value the gamm CFMM invariants check
+# does not decrease across swaps, and that does not decrease per share across joins and exits.
+def normalized_cfmm(reserves):
+    prod, sum_squares = 1, 0
+    for r in reserves:
+        prod *= r
+        sum_squares += r * r
+    return (prod * sum_squares) ** (1. / (len(reserves) + 2))
+
 x0, y0 = 100, 100
 yin = 1000
 err_threshold = .001
@@ -12,6 +12,8 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+
+	TypeMsgStableSwapSetScalingFactorRateSource = "stable_swap_set_scaling_factor_rate_source"
 )
 
 var (
@@ -169,3 +171,52 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapSetScalingFactorRateSource{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapSetScalingFactorRateSource(
+	sender string,
+	poolID uint64,
+	rateSource *ScalingFactorRateSource,
+) MsgStableSwapSetScalingFactorRateSource {
+	return MsgStableSwapSetScalingFactorRateSource{
+		Sender:     sender,
+		PoolID:     poolID,
+		RateSource: rateSource,
+	}
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) Type() string {
+	return TypeMsgStableSwapSetScalingFactorRateSource
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.RateSource != nil {
+		return msg.RateSource.Validate()
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapSetScalingFactorRateSource) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	_ poolmanagertypes.PoolI = &Pool{}
-	_ types.CFMMPoolI        = &Pool{}
+	_ poolmanagertypes.PoolI          = &Pool{}
+	_ types.CFMMPoolI                 = &Pool{}
+	_ types.ConstantFunctionExtension = &Pool{}
 )
 
 // NewStableswapPool returns a stableswap pool
@@ -316,6 +317,31 @@ func (p Pool) SpotPrice(ctx sdk.Context, quoteAssetDenom string, baseAssetDenom
 	return p.spotPrice(quoteAssetDenom, baseAssetDenom)
 }
 
+// Log2ConstantFunctionValue returns the base 2 logarithm of the multi-asset CFMM
+// prod_i(x_i) * sum_i(x_i^2) over the scaled reserves x_i, normalized to be
+// homogeneous of degree one by taking its (n + 2)-th root, n being the number of assets.
+// See cfmm.py for the two asset reference model.
+func (p Pool) Log2ConstantFunctionValue(ctx sdk.Context) (osmomath.BigDec, error) {
+	scaledReserves, err := osmomath.DivCoinAmtsByU64ToBigDec(p.PoolLiquidity, p.ScalingFactors, osmomath.RoundDown)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	sumSquares := osmomath.ZeroDec()
+	weights := make([]osmomath.BigDec, 0, len(scaledReserves)+1)
+	for _, reserve := range scaledReserves {
+		sumSquares = sumSquares.Add(reserve.Mul(reserve))
+		weights = append(weights, osmomath.OneDec())
+	}
+	weights = append(weights, osmomath.OneDec())
+
+	log2Product, err := cfmm_common.Log2WeightedProduct(append(scaledReserves, sumSquares), weights)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+	return log2Product.QuoInt64(int64(len(scaledReserves) + 2)), nil
+}
+
 func (p Pool) Copy() Pool {
 	p2 := p
 	p2.PoolLiquidity = sdk.NewCoins(p.PoolLiquidity...)
@@ -399,6 +425,13 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 		return types.ErrNotScalingFactorGovernor
 	}
 
+	return p.UpdateScalingFactors(scalingFactors)
+}
+
+// UpdateScalingFactors sets scaling factors for pool to the given amount without
+// checking who requested the change. It is used to apply scaling factors read from
+// the pool's ScalingFactorRateSource.
+func (p *Pool) UpdateScalingFactors(scalingFactors []uint64) error {
 	scalingFactors, err := applyScalingFactorMultiplier(scalingFactors)
 	if err != nil {
 		return err
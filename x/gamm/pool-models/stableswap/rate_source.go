@@ -0,0 +1,146 @@
+package stableswap
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+// GetScalingFactorsRequest is the query sent to a ScalingFactorRateSource contract.
+type GetScalingFactorsRequest struct {
+	GetScalingFactors GetScalingFactors `json:"get_scaling_factors"`
+}
+
+type GetScalingFactors struct {
+	PoolId uint64 `json:"pool_id"`
+}
+
+// GetScalingFactorsResponse is the response expected from a ScalingFactorRateSource contract.
+// Scaling factors are encoded as strings, matching cosmwasm's Uint64 serialization.
+type GetScalingFactorsResponse struct {
+	ScalingFactors []string `json:"scaling_factors"`
+}
+
+// IsContractSource returns true if the scaling factors are read from a CosmWasm contract.
+func (s ScalingFactorRateSource) IsContractSource() bool {
+	return s.ContractAddress != ""
+}
+
+// Validate checks that exactly one rate source is configured and that its fields are well formed.
+func (s ScalingFactorRateSource) Validate() error {
+	if s.IsContractSource() == (s.TwapPoolId != 0) {
+		return errorsmod.Wrap(types.ErrInvalidScalingFactorRateSource, "exactly one of contract address or twap pool id must be set")
+	}
+
+	if s.IsContractSource() {
+		if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "invalid contract address (%s)", err)
+		}
+		return nil
+	}
+
+	if s.TwapWindow <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "twap window must be positive, got %s", s.TwapWindow)
+	}
+	if s.TwapPrecision == 0 {
+		return errorsmod.Wrap(types.ErrInvalidScalingFactorRateSource, "twap precision must be positive")
+	}
+	return nil
+}
+
+// ValidateForPool validates the rate source and checks that it can produce
+// scaling factors for a pool with numAssets assets.
+func (s ScalingFactorRateSource) ValidateForPool(numAssets int) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	if !s.IsContractSource() && numAssets != 2 {
+		return errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "twap rate sources only support two asset pools, got %d assets", numAssets)
+	}
+	return nil
+}
+
+// ScalingFactorsFromTwap returns the scaling factors for a two asset pool, given the
+// price of the second pool asset in terms of the first one.
+// The first asset gets TwapPrecision as its scaling factor, and the second one gets
+// TwapPrecision / twap, so that amounts of equal value scale to equal amm units.
+func (s ScalingFactorRateSource) ScalingFactorsFromTwap(twap sdk.Dec) ([]uint64, error) {
+	if !twap.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScalingFactorRateSource, "twap must be positive, got %s", twap)
+	}
+
+	secondScalingFactor := sdk.NewDecFromInt(sdk.NewIntFromUint64(s.TwapPrecision)).Quo(twap).RoundInt()
+	if !secondScalingFactor.IsPositive() || !secondScalingFactor.IsUint64() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidScalingFactors, "derived scaling factor %s is out of range", secondScalingFactor)
+	}
+
+	return []uint64{s.TwapPrecision, secondScalingFactor.Uint64()}, nil
+}
+
+// ScalingFactorsFromContractResponse converts the scaling factors returned by a
+// rate source contract into the uint64 scaling factors stored in the pool.
+func ScalingFactorsFromContractResponse(response GetScalingFactorsResponse) ([]uint64, error) {
+	scalingFactors := make([]uint64, len(response.ScalingFactors))
+	for i, scalingFactorStr := range response.ScalingFactors {
+		scalingFactor, err := strconv.ParseUint(scalingFactorStr, 10, 64)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidScalingFactors, "scaling factor at index %d is invalid (%s)", i, err)
+		}
+		scalingFactors[i] = scalingFactor
+	}
+	return scalingFactors, nil
+}
+
+// ValidateScalingFactorChange checks that updating the scaling factors from oldScalingFactors to
+// newScalingFactors changes the price ratio between any two of them by at most a factor of 1 + maxChange,
+// in either direction. Scaling factors only matter relative to each other, so the two sets of scaling
+// factors may differ by a common multiplier.
+func ValidateScalingFactorChange(oldScalingFactors, newScalingFactors []uint64, maxChange sdk.Dec) error {
+	if len(oldScalingFactors) != len(newScalingFactors) {
+		return types.ErrInvalidScalingFactorLength
+	}
+
+	for i := range oldScalingFactors {
+		for j := i + 1; j < len(oldScalingFactors); j++ {
+			if oldScalingFactors[i] == 0 || newScalingFactors[j] == 0 {
+				return types.ErrInvalidScalingFactors
+			}
+
+			// (new_i / new_j) / (old_i / old_j), inverted if it is below one so that
+			// increases and decreases of the ratio are bounded alike.
+			numerator := sdk.NewIntFromUint64(newScalingFactors[i]).Mul(sdk.NewIntFromUint64(oldScalingFactors[j]))
+			denominator := sdk.NewIntFromUint64(oldScalingFactors[i]).Mul(sdk.NewIntFromUint64(newScalingFactors[j]))
+			if numerator.LT(denominator) {
+				numerator, denominator = denominator, numerator
+			}
+			ratioChange := sdk.NewDecFromInt(numerator).QuoInt(denominator)
+			if ratioChange.Sub(sdk.OneDec()).GT(maxChange) {
+				return errorsmod.Wrapf(types.ErrScalingFactorChangeTooLarge,
+					"price ratio between scaling factors %d and %d changes by a factor of %s, max change is %s", i, j, ratioChange, maxChange)
+			}
+		}
+	}
+	return nil
+}
+
+// SetScalingFactorRateSource sets the source the pool's scaling factors are automatically updated from.
+// A nil rateSource removes the existing one.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor
+func (p *Pool) SetScalingFactorRateSource(rateSource *ScalingFactorRateSource, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if rateSource != nil {
+		if err := rateSource.ValidateForPool(p.NumAssets()); err != nil {
+			return err
+		}
+	}
+
+	p.ScalingFactorRateSource = rateSource
+	return nil
+}
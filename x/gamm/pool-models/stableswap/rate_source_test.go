@@ -0,0 +1,211 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+)
+
+func TestScalingFactorRateSourceValidateForPool(t *testing.T) {
+	contractAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	validTwapSource := ScalingFactorRateSource{TwapPoolId: 1, TwapWindow: time.Hour, TwapPrecision: 1000}
+
+	tests := map[string]struct {
+		rateSource ScalingFactorRateSource
+		numAssets  int
+		expectPass bool
+	}{
+		"valid contract source": {
+			rateSource: ScalingFactorRateSource{ContractAddress: contractAddr},
+			numAssets:  3,
+			expectPass: true,
+		},
+		"valid twap source": {
+			rateSource: validTwapSource,
+			numAssets:  2,
+			expectPass: true,
+		},
+		"no source set": {
+			rateSource: ScalingFactorRateSource{},
+			numAssets:  2,
+		},
+		"both sources set": {
+			rateSource: ScalingFactorRateSource{ContractAddress: contractAddr, TwapPoolId: 1, TwapWindow: time.Hour, TwapPrecision: 1000},
+			numAssets:  2,
+		},
+		"invalid contract address": {
+			rateSource: ScalingFactorRateSource{ContractAddress: "invalid"},
+			numAssets:  2,
+		},
+		"twap source on three asset pool": {
+			rateSource: validTwapSource,
+			numAssets:  3,
+		},
+		"twap source with zero window": {
+			rateSource: ScalingFactorRateSource{TwapPoolId: 1, TwapPrecision: 1000},
+			numAssets:  2,
+		},
+		"twap source with zero precision": {
+			rateSource: ScalingFactorRateSource{TwapPoolId: 1, TwapWindow: time.Hour},
+			numAssets:  2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rateSource.ValidateForPool(tc.numAssets)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidScalingFactorRateSource)
+			}
+		})
+	}
+}
+
+func TestScalingFactorsFromTwap(t *testing.T) {
+	rateSource := ScalingFactorRateSource{TwapPoolId: 1, TwapWindow: time.Hour, TwapPrecision: 1000}
+
+	tests := map[string]struct {
+		twap                   sdk.Dec
+		expectedScalingFactors []uint64
+		expectedErr            error
+	}{
+		"second asset worth twice the first one": {
+			twap:                   sdk.NewDec(2),
+			expectedScalingFactors: []uint64{1000, 500},
+		},
+		"second asset worth half the first one": {
+			twap:                   sdk.MustNewDecFromStr("0.5"),
+			expectedScalingFactors: []uint64{1000, 2000},
+		},
+		"assets of equal value": {
+			twap:                   sdk.OneDec(),
+			expectedScalingFactors: []uint64{1000, 1000},
+		},
+		"rounds to nearest scaling factor": {
+			twap:                   sdk.MustNewDecFromStr("1.07"),
+			expectedScalingFactors: []uint64{1000, 935},
+		},
+		"zero twap": {
+			twap:        sdk.ZeroDec(),
+			expectedErr: types.ErrInvalidScalingFactorRateSource,
+		},
+		"twap too large for precision": {
+			twap:        sdk.NewDec(10000),
+			expectedErr: types.ErrInvalidScalingFactors,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scalingFactors, err := rateSource.ScalingFactorsFromTwap(tc.twap)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedScalingFactors, scalingFactors)
+		})
+	}
+}
+
+func TestScalingFactorsFromContractResponse(t *testing.T) {
+	scalingFactors, err := ScalingFactorsFromContractResponse(GetScalingFactorsResponse{ScalingFactors: []string{"1", "18446744073709551615"}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 18446744073709551615}, scalingFactors)
+
+	_, err = ScalingFactorsFromContractResponse(GetScalingFactorsResponse{ScalingFactors: []string{"1", "18446744073709551616"}})
+	require.ErrorIs(t, err, types.ErrInvalidScalingFactors)
+}
+
+func TestValidateScalingFactorChange(t *testing.T) {
+	maxChange := sdk.NewDecWithPrec(5, 2)
+
+	tests := map[string]struct {
+		oldScalingFactors []uint64
+		newScalingFactors []uint64
+		expectedErr       error
+	}{
+		"no change": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 1000},
+		},
+		"common multiplier is ignored": {
+			oldScalingFactors: []uint64{1000, 2000},
+			newScalingFactors: []uint64{1, 2},
+		},
+		"change at max change": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 1050},
+		},
+		"change above max change": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 1051},
+			expectedErr:       types.ErrScalingFactorChangeTooLarge,
+		},
+		"decrease above max change": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 949},
+			expectedErr:       types.ErrScalingFactorChangeTooLarge,
+		},
+		"change between the last two scaling factors above max change": {
+			oldScalingFactors: []uint64{1000, 1000, 1000},
+			newScalingFactors: []uint64{1000, 1040, 960},
+			expectedErr:       types.ErrScalingFactorChangeTooLarge,
+		},
+		"different number of scaling factors": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 1000, 1000},
+			expectedErr:       types.ErrInvalidScalingFactorLength,
+		},
+		"zero scaling factor": {
+			oldScalingFactors: []uint64{1000, 1000},
+			newScalingFactors: []uint64{1000, 0},
+			expectedErr:       types.ErrInvalidScalingFactors,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateScalingFactorChange(tc.oldScalingFactors, tc.newScalingFactors, maxChange)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetScalingFactorRateSource(t *testing.T) {
+	controller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	pool := Pool{
+		PoolLiquidity:           sdk.NewCoins(sdk.NewInt64Coin("bar", 100), sdk.NewInt64Coin("foo", 100)),
+		ScalingFactors:          []uint64{1, 1},
+		ScalingFactorController: controller,
+	}
+	rateSource := &ScalingFactorRateSource{TwapPoolId: 1, TwapWindow: time.Hour, TwapPrecision: 1000}
+
+	err := pool.SetScalingFactorRateSource(rateSource, "not controller")
+	require.ErrorIs(t, err, types.ErrNotScalingFactorGovernor)
+	require.Nil(t, pool.ScalingFactorRateSource)
+
+	err = pool.SetScalingFactorRateSource(&ScalingFactorRateSource{TwapPoolId: 1}, controller)
+	require.ErrorIs(t, err, types.ErrInvalidScalingFactorRateSource)
+	require.Nil(t, pool.ScalingFactorRateSource)
+
+	err = pool.SetScalingFactorRateSource(rateSource, controller)
+	require.NoError(t, err)
+	require.Equal(t, rateSource, pool.ScalingFactorRateSource)
+
+	err = pool.SetScalingFactorRateSource(nil, controller)
+	require.NoError(t, err)
+	require.Nil(t, pool.ScalingFactorRateSource)
+}
@@ -13,15 +13,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -96,6 +99,9 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_rate_source, if set, is where the pool's scaling factors
+	// are automatically read from at the end of every incentives epoch.
+	ScalingFactorRateSource *ScalingFactorRateSource `protobuf:"bytes,9,opt,name=scaling_factor_rate_source,json=scalingFactorRateSource,proto3" json:"scaling_factor_rate_source,omitempty" yaml:"scaling_factor_rate_source"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -130,9 +136,89 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ScalingFactorRateSource defines where a stableswap pool reads its scaling
+// factors from. Exactly one of contract_address or twap_pool_id must be set.
+type ScalingFactorRateSource struct {
+	// contract_address is a CosmWasm contract that is queried with
+	// {"get_scaling_factors":{"pool_id":<pool id>}} and must respond with
+	// {"scaling_factors":["<factor>", ...]}, ordered like the pool liquidity.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// twap_pool_id is a pool trading both assets of a two-asset stableswap
+	// pool. Its geometric TWAP of the second asset in terms of the first one
+	// over twap_window is used as the exchange rate between the two assets.
+	TwapPoolId uint64        `protobuf:"varint,2,opt,name=twap_pool_id,json=twapPoolId,proto3" json:"twap_pool_id,omitempty" yaml:"twap_pool_id"`
+	TwapWindow time.Duration `protobuf:"bytes,3,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	// twap_precision is the scaling factor given to the first asset when
+	// deriving scaling factors from a TWAP. The second asset's scaling factor is
+	// twap_precision divided by the TWAP.
+	TwapPrecision uint64 `protobuf:"varint,4,opt,name=twap_precision,json=twapPrecision,proto3" json:"twap_precision,omitempty" yaml:"twap_precision"`
+}
+
+func (m *ScalingFactorRateSource) Reset()         { *m = ScalingFactorRateSource{} }
+func (m *ScalingFactorRateSource) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRateSource) ProtoMessage()    {}
+func (*ScalingFactorRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *ScalingFactorRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRateSource.Merge(m, src)
+}
+func (m *ScalingFactorRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRateSource proto.InternalMessageInfo
+
+func (m *ScalingFactorRateSource) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ScalingFactorRateSource) GetTwapPoolId() uint64 {
+	if m != nil {
+		return m.TwapPoolId
+	}
+	return 0
+}
+
+func (m *ScalingFactorRateSource) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *ScalingFactorRateSource) GetTwapPrecision() uint64 {
+	if m != nil {
+		return m.TwapPrecision
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*ScalingFactorRateSource)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRateSource")
 }
 
 func init() {
@@ -140,50 +226,60 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd4, 0x4c,
-	0x18, 0xde, 0xc2, 0xc2, 0xf2, 0x0d, 0xdf, 0xb7, 0xe4, 0xab, 0x24, 0x16, 0x88, 0x9d, 0xa5, 0x11,
-	0xb2, 0x21, 0x6e, 0xeb, 0x6a, 0x42, 0x22, 0x37, 0x16, 0x83, 0x31, 0x31, 0x06, 0xcb, 0xc9, 0x1f,
-	0xc9, 0x3a, 0x6d, 0x67, 0xcb, 0xc4, 0x76, 0xa7, 0x76, 0xa6, 0x08, 0x17, 0xcf, 0xc6, 0x93, 0x47,
-	0x8f, 0x9c, 0x3d, 0x79, 0xf0, 0x8f, 0x20, 0x7a, 0xe1, 0x68, 0x3c, 0x54, 0x03, 0x07, 0xaf, 0x66,
-	0xff, 0x02, 0x33, 0xd3, 0xd9, 0x5d, 0x16, 0x95, 0x90, 0x78, 0xd9, 0x9d, 0xf7, 0x7d, 0x9f, 0xf7,
-	0x79, 0x7f, 0x3d, 0x29, 0xb8, 0x45, 0x59, 0x4c, 0x19, 0x61, 0x4e, 0x88, 0xe2, 0xd8, 0x49, 0x28,
-	0x8d, 0x1a, 0x31, 0x0d, 0x70, 0xc4, 0x1c, 0xc6, 0x91, 0x17, 0x61, 0xf6, 0x02, 0x25, 0xa7, 0x9e,
-	0x6d, 0x81, 0xb0, 0x93, 0x94, 0x72, 0xaa, 0xaf, 0xa8, 0x54, 0x5b, 0xa4, 0xda, 0x22, 0x50, 0x64,
-	0xda, 0x43, 0xb8, 0xbd, 0xdb, 0xf4, 0x30, 0x47, 0xcd, 0xf9, 0x39, 0x5f, 0x82, 0xdb, 0x32, 0xd3,
-	0x29, 0x8c, 0x82, 0x66, 0x7e, 0x36, 0xa4, 0x21, 0x2d, 0xfc, 0xe2, 0xa5, 0xbc, 0xff, 0xa3, 0x98,
-	0x74, 0xa9, 0x23, 0x7f, 0x95, 0xcb, 0x0c, 0x29, 0x0d, 0x23, 0xec, 0x48, 0xcb, 0xcb, 0x3a, 0x4e,
-	0x90, 0xa5, 0x88, 0x13, 0xda, 0x55, 0x71, 0x78, 0x36, 0xce, 0x49, 0x8c, 0x19, 0x47, 0x71, 0xd2,
-	0x27, 0x28, 0xea, 0x3a, 0x28, 0xe3, 0x3b, 0x8e, 0xea, 0x4c, 0x1a, 0x67, 0xe2, 0x1e, 0x62, 0x78,
-	0x10, 0xf7, 0x29, 0x51, 0x05, 0xac, 0x1f, 0x1a, 0x00, 0x5b, 0x94, 0x46, 0x5b, 0x28, 0x45, 0x31,
-	0xd3, 0x9f, 0x80, 0x29, 0xb9, 0x92, 0x0e, 0xc6, 0x86, 0x56, 0xd3, 0xea, 0xff, 0xb4, 0xd6, 0x0f,
-	0x73, 0x58, 0xfa, 0x92, 0xc3, 0xe5, 0x90, 0xf0, 0x9d, 0xcc, 0xb3, 0x7d, 0x1a, 0xab, 0x59, 0xd5,
-	0x5f, 0x83, 0x05, 0xcf, 0x1c, 0xbe, 0x9f, 0x60, 0x66, 0xdf, 0xc6, 0x7e, 0x2f, 0x87, 0x33, 0xfb,
-	0x28, 0x8e, 0xd6, 0xac, 0x3e, 0x8f, 0xe5, 0x56, 0xc4, 0x73, 0x13, 0x63, 0xc1, 0x8e, 0xf7, 0x08,
-	0x97, 0xec, 0x63, 0x7f, 0xc7, 0xde, 0xe7, 0xb1, 0xdc, 0x8a, 0x78, 0x6e, 0x62, 0xbc, 0xb6, 0xfc,
-	0xfa, 0xfb, 0xfb, 0x95, 0xc5, 0x91, 0xdb, 0x6f, 0x0f, 0xae, 0x36, 0x9c, 0xd1, 0xfa, 0x34, 0x01,
-	0xca, 0xc2, 0xd4, 0xaf, 0x81, 0x0a, 0x0a, 0x82, 0x14, 0x33, 0xa6, 0x66, 0xd5, 0x7b, 0x39, 0xac,
-	0x16, 0xfc, 0x2a, 0x60, 0xb9, 0x7d, 0x88, 0x5e, 0x05, 0x63, 0x24, 0x90, 0x6d, 0x97, 0xdd, 0x31,
-	0x12, 0xe8, 0x2f, 0xc1, 0xb4, 0xd0, 0x47, 0x3b, 0x91, 0xac, 0xc6, 0x78, 0x4d, 0xab, 0x4f, 0xdf,
-	0x58, 0xb5, 0x2f, 0x2e, 0x20, 0x7b, 0xd8, 0x53, 0x6b, 0x49, 0xec, 0xa1, 0x97, 0xc3, 0x2b, 0x6a,
-	0x77, 0xa3, 0xe2, 0x54, 0x35, 0x2c, 0x17, 0x24, 0xc3, 0x53, 0x3d, 0x00, 0xb3, 0x9d, 0x8c, 0x67,
-	0x29, 0x2e, 0x20, 0x21, 0xdd, 0xc5, 0x69, 0x97, 0xa6, 0x46, 0x59, 0x8e, 0x02, 0x7b, 0x39, 0x5c,
-	0x28, 0xc8, 0x7e, 0x87, 0xb2, 0x5c, 0xbd, 0x70, 0x8b, 0x1e, 0xee, 0x28, 0xa7, 0xfe, 0x10, 0xfc,
-	0xcb, 0x29, 0x47, 0x51, 0x9b, 0xed, 0xa0, 0x14, 0x33, 0x63, 0x42, 0xce, 0x34, 0x67, 0x2b, 0x6d,
-	0x0b, 0x0d, 0x0d, 0x9a, 0xdf, 0xa0, 0xa4, 0xdb, 0x5a, 0x50, 0x6d, 0x5f, 0x2a, 0x2a, 0x9d, 0x4e,
-	0xb6, 0xdc, 0x69, 0x69, 0x6e, 0x4b, 0x4b, 0x4f, 0x41, 0x55, 0x36, 0x10, 0x91, 0xe7, 0x19, 0x09,
-	0x08, 0xdf, 0x37, 0x26, 0x6b, 0xe3, 0xe7, 0x93, 0x5f, 0x17, 0xe4, 0xef, 0xbe, 0xc2, 0xfa, 0x05,
-	0xb4, 0x21, 0x12, 0x98, 0xfb, 0x9f, 0x28, 0x71, 0xaf, 0x5f, 0x41, 0xbf, 0x0f, 0x66, 0x98, 0x8f,
-	0x22, 0xd2, 0x0d, 0xdb, 0x1d, 0xe4, 0x73, 0x9a, 0x32, 0xa3, 0x52, 0x1b, 0xaf, 0x97, 0x5b, 0x4b,
-	0xbd, 0x1c, 0x2e, 0xfe, 0xb2, 0xe9, 0x33, 0x58, 0xcb, 0xad, 0x2a, 0xcf, 0x66, 0xe1, 0xd0, 0x9f,
-	0x82, 0xb9, 0x51, 0x4c, 0xdb, 0xa7, 0x5d, 0x9e, 0xd2, 0x28, 0xc2, 0xa9, 0x31, 0x25, 0xd7, 0x7e,
-	0xb5, 0x97, 0xc3, 0x9a, 0x62, 0xfe, 0x13, 0xd4, 0x72, 0x2f, 0x8f, 0x10, 0x6f, 0x0c, 0x22, 0x6b,
-	0xcd, 0x57, 0x07, 0xb0, 0xf4, 0xf6, 0x00, 0x96, 0x3e, 0x7e, 0x68, 0x4c, 0x88, 0xd3, 0xdc, 0x15,
-	0x9a, 0x5e, 0x38, 0x47, 0xd3, 0xad, 0xc7, 0x87, 0xc7, 0xa6, 0x76, 0x74, 0x6c, 0x6a, 0xdf, 0x8e,
-	0x4d, 0xed, 0xcd, 0x89, 0x59, 0x3a, 0x3a, 0x31, 0x4b, 0x9f, 0x4f, 0xcc, 0xd2, 0xa3, 0xf5, 0x53,
-	0x7b, 0x53, 0x0c, 0x8d, 0x08, 0x79, 0xac, 0x6f, 0x38, 0xbb, 0xcd, 0x55, 0x67, 0xef, 0xbc, 0x8f,
-	0xa4, 0x37, 0x29, 0x3f, 0x12, 0x37, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xce, 0x87, 0x39, 0xee,
-	0x52, 0x05, 0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6f, 0x1c, 0x45,
+	0x14, 0xbf, 0xb5, 0x2f, 0x76, 0x32, 0x4e, 0xce, 0x30, 0x04, 0xf9, 0xce, 0x16, 0x37, 0x97, 0x11,
+	0x89, 0xac, 0x08, 0xef, 0x62, 0x90, 0x22, 0xc5, 0x15, 0x3e, 0x47, 0x46, 0x91, 0x10, 0x0a, 0xeb,
+	0x02, 0x11, 0x90, 0x8e, 0xb9, 0xdd, 0xb9, 0xf5, 0x88, 0xdd, 0x9d, 0x63, 0x67, 0xd6, 0x8e, 0x1b,
+	0x6a, 0x44, 0x45, 0x99, 0x32, 0x1d, 0x12, 0x15, 0x05, 0x15, 0x7f, 0x41, 0x44, 0x95, 0x12, 0x51,
+	0x6c, 0x90, 0x5d, 0xd0, 0xa2, 0x95, 0xe8, 0xd1, 0x7c, 0xec, 0xdd, 0xde, 0x81, 0x2d, 0xa3, 0x34,
+	0x77, 0xf3, 0xbe, 0x7e, 0xef, 0xf7, 0xde, 0xbc, 0x37, 0x0b, 0xee, 0x73, 0x91, 0x70, 0xc1, 0x84,
+	0x17, 0x91, 0x24, 0xf1, 0xc6, 0x9c, 0xc7, 0x5b, 0x09, 0x0f, 0x69, 0x2c, 0x3c, 0x21, 0xc9, 0x30,
+	0xa6, 0xe2, 0x98, 0x8c, 0x6b, 0xc7, 0x81, 0xf2, 0x70, 0xc7, 0x19, 0x97, 0x1c, 0xde, 0xb5, 0xa1,
+	0xae, 0x0a, 0x75, 0x95, 0xc1, 0x44, 0xba, 0x53, 0x77, 0xf7, 0x68, 0x7b, 0x48, 0x25, 0xd9, 0x5e,
+	0xef, 0x04, 0xda, 0x79, 0xa0, 0x23, 0x3d, 0x23, 0x18, 0x98, 0xf5, 0x9b, 0x11, 0x8f, 0xb8, 0xd1,
+	0xab, 0x93, 0xd5, 0xbe, 0x4e, 0x12, 0x96, 0x72, 0x4f, 0xff, 0x5a, 0x55, 0x37, 0xe2, 0x3c, 0x8a,
+	0xa9, 0xa7, 0xa5, 0x61, 0x3e, 0xf2, 0xc2, 0x3c, 0x23, 0x92, 0xf1, 0xd4, 0xda, 0xd1, 0xbc, 0x5d,
+	0xb2, 0x84, 0x0a, 0x49, 0x92, 0x71, 0x05, 0x60, 0xf2, 0x7a, 0x24, 0x97, 0x87, 0x9e, 0x65, 0xa6,
+	0x85, 0x39, 0xfb, 0x90, 0x08, 0x3a, 0xb1, 0x07, 0x9c, 0xd9, 0x04, 0xf8, 0x2f, 0x07, 0x80, 0x47,
+	0x9c, 0xc7, 0x8f, 0x48, 0x46, 0x12, 0x01, 0xbf, 0x00, 0x57, 0x75, 0x4b, 0x46, 0x94, 0xb6, 0x9d,
+	0x9e, 0xb3, 0x79, 0xad, 0xbf, 0xfb, 0xbc, 0x40, 0x8d, 0xdf, 0x0b, 0x74, 0x27, 0x62, 0xf2, 0x30,
+	0x1f, 0xba, 0x01, 0x4f, 0x6c, 0xad, 0xf6, 0x6f, 0x4b, 0x84, 0x5f, 0x79, 0xf2, 0x64, 0x4c, 0x85,
+	0xfb, 0x80, 0x06, 0x65, 0x81, 0x56, 0x4f, 0x48, 0x12, 0xef, 0xe0, 0x0a, 0x07, 0xfb, 0xcb, 0xea,
+	0xb8, 0x4f, 0xa9, 0x42, 0xa7, 0x4f, 0x98, 0xd4, 0xe8, 0x0b, 0xaf, 0x86, 0x5e, 0xe1, 0x60, 0x7f,
+	0x59, 0x1d, 0xf7, 0x29, 0xdd, 0xb9, 0xf3, 0xdd, 0x9f, 0x3f, 0xdd, 0xbd, 0x35, 0x73, 0xf7, 0x07,
+	0x93, 0x5b, 0x9b, 0xd6, 0x88, 0xff, 0x5e, 0x02, 0x4d, 0x25, 0xc2, 0x77, 0xc0, 0x32, 0x09, 0xc3,
+	0x8c, 0x0a, 0x61, 0x6b, 0x85, 0x65, 0x81, 0x5a, 0x06, 0xdf, 0x1a, 0xb0, 0x5f, 0xb9, 0xc0, 0x16,
+	0x58, 0x60, 0xa1, 0xa6, 0xdd, 0xf4, 0x17, 0x58, 0x08, 0xbf, 0x01, 0x2b, 0x6a, 0x3e, 0x06, 0x63,
+	0x8d, 0xda, 0x5e, 0xec, 0x39, 0x9b, 0x2b, 0xef, 0xdd, 0x73, 0x2f, 0x3f, 0x40, 0xee, 0x94, 0x53,
+	0xff, 0xb6, 0xea, 0x43, 0x59, 0xa0, 0xb7, 0x6c, 0xef, 0x66, 0x87, 0xd3, 0xe6, 0xc0, 0x3e, 0x18,
+	0x4f, 0xaf, 0xea, 0x13, 0x70, 0x73, 0x94, 0xcb, 0x3c, 0xa3, 0xc6, 0x25, 0xe2, 0x47, 0x34, 0x4b,
+	0x79, 0xd6, 0x6e, 0xea, 0x52, 0x50, 0x59, 0xa0, 0x0d, 0x03, 0xf6, 0x5f, 0x5e, 0xd8, 0x87, 0x46,
+	0xad, 0x38, 0x7c, 0x68, 0x95, 0xf0, 0x33, 0x70, 0x5d, 0x72, 0x49, 0xe2, 0x81, 0x38, 0x24, 0x19,
+	0x15, 0xed, 0x2b, 0xba, 0xa6, 0x8e, 0x6b, 0x67, 0x5b, 0xcd, 0xd0, 0x84, 0xfc, 0x1e, 0x67, 0x69,
+	0x7f, 0xc3, 0xd2, 0x7e, 0xc3, 0x64, 0xaa, 0x07, 0x63, 0x7f, 0x45, 0x8b, 0x07, 0x5a, 0x82, 0x19,
+	0x68, 0x69, 0x02, 0x31, 0xfb, 0x3a, 0x67, 0x21, 0x93, 0x27, 0xed, 0xa5, 0xde, 0xe2, 0xc5, 0xe0,
+	0xef, 0x2a, 0xf0, 0x1f, 0x5f, 0xa2, 0xcd, 0x4b, 0xcc, 0x86, 0x0a, 0x10, 0xfe, 0x0d, 0x95, 0xe2,
+	0xa3, 0x2a, 0x03, 0xfc, 0x18, 0xac, 0x8a, 0x80, 0xc4, 0x2c, 0x8d, 0x06, 0x23, 0x12, 0x48, 0x9e,
+	0x89, 0xf6, 0x72, 0x6f, 0x71, 0xb3, 0xd9, 0xbf, 0x5d, 0x16, 0xe8, 0xd6, 0xbf, 0x3a, 0x3d, 0xe7,
+	0x8b, 0xfd, 0x96, 0xd5, 0xec, 0x1b, 0x05, 0xfc, 0x12, 0x74, 0x66, 0x7d, 0x06, 0x01, 0x4f, 0x65,
+	0xc6, 0xe3, 0x98, 0x66, 0xed, 0xab, 0xba, 0xed, 0x6f, 0x97, 0x05, 0xea, 0x59, 0xe4, 0xf3, 0x5c,
+	0xb1, 0xbf, 0x36, 0x03, 0xbc, 0x37, 0xb1, 0xc0, 0x1f, 0x1c, 0xb0, 0x3e, 0x17, 0x97, 0x11, 0x49,
+	0x07, 0x82, 0xe7, 0x59, 0x40, 0xdb, 0xd7, 0xf4, 0x7d, 0xec, 0xfd, 0x9f, 0x19, 0x3b, 0xa8, 0x67,
+	0xf2, 0x89, 0xa4, 0x07, 0x1a, 0x6a, 0xa6, 0x05, 0xe7, 0x26, 0x9c, 0x67, 0x3a, 0x8d, 0xdf, 0xd9,
+	0xfe, 0xf6, 0x19, 0x6a, 0x3c, 0x7d, 0x86, 0x1a, 0xbf, 0xfe, 0xbc, 0x75, 0x45, 0x0d, 0xd1, 0x43,
+	0xb5, 0x7d, 0x1b, 0x17, 0x6c, 0x1f, 0xfe, 0x65, 0x01, 0xac, 0x9d, 0x43, 0x07, 0xee, 0x83, 0xd7,
+	0x74, 0x83, 0x48, 0x20, 0x07, 0xb3, 0x3b, 0xb9, 0x51, 0x16, 0x68, 0xcd, 0x10, 0x9d, 0xf7, 0xc0,
+	0xfe, 0x6a, 0xa5, 0xda, 0xb5, 0x4b, 0x7a, 0x1f, 0x5c, 0x97, 0x93, 0xad, 0xa9, 0xd6, 0xb5, 0xbf,
+	0x56, 0x1b, 0xd1, 0x9a, 0x15, 0xfb, 0x40, 0x5a, 0x72, 0x0f, 0x43, 0xf8, 0x18, 0xac, 0x68, 0xe3,
+	0x31, 0x4b, 0x43, 0x7e, 0x6c, 0xf7, 0xb9, 0xe3, 0x9a, 0x07, 0xd8, 0xad, 0x1e, 0x60, 0xf7, 0x81,
+	0x7d, 0xa0, 0xfb, 0x5d, 0x3b, 0xfb, 0xb0, 0x06, 0x6c, 0x62, 0xf1, 0xd3, 0x97, 0xc8, 0x31, 0xd8,
+	0x9f, 0x6a, 0x05, 0xfc, 0x00, 0xb4, 0x4c, 0xe2, 0x8c, 0x06, 0x4c, 0x30, 0x9e, 0xea, 0x2d, 0x6d,
+	0xf6, 0x3b, 0x65, 0x81, 0xde, 0xac, 0x13, 0xab, 0xec, 0xd8, 0xbf, 0xa1, 0xa9, 0x55, 0x72, 0xff,
+	0xf3, 0xe7, 0xa7, 0x5d, 0xe7, 0xc5, 0x69, 0xd7, 0xf9, 0xe3, 0xb4, 0xeb, 0x7c, 0x7f, 0xd6, 0x6d,
+	0xbc, 0x38, 0xeb, 0x36, 0x7e, 0x3b, 0xeb, 0x36, 0x1e, 0xef, 0xd6, 0xd6, 0xc3, 0xb6, 0x7f, 0x2b,
+	0x26, 0x43, 0x51, 0x09, 0xde, 0xd1, 0xf6, 0x3d, 0xef, 0xc9, 0x45, 0xdf, 0xc2, 0xe1, 0x92, 0xae,
+	0xee, 0xfd, 0x7f, 0x06, 0x00, 0x47, 0xbd, 0x3a, 0x3e, 0x39, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -249,6 +345,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorRateSource != nil {
+		{
+			size, err := m.ScalingFactorRateSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -257,20 +365,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ScalingFactors)*10)
+		var j2 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -330,6 +438,54 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapPrecision != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TwapPrecision))
+		i--
+		dAtA[i] = 0x20
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.TwapPoolId != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.TwapPoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -392,6 +548,31 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorRateSource != nil {
+		l = m.ScalingFactorRateSource.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *ScalingFactorRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.TwapPoolId != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TwapPoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.TwapPrecision != 0 {
+		n += 1 + sovStableswapPool(uint64(m.TwapPrecision))
+	}
 	return n
 }
 
@@ -839,6 +1020,195 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRateSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRateSource == nil {
+				m.ScalingFactorRateSource = &ScalingFactorRateSource{}
+			}
+			if err := m.ScalingFactorRateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPoolId", wireType)
+			}
+			m.TwapPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPrecision", wireType)
+			}
+			m.TwapPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapPrecision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -263,11 +263,118 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_controller in order for the tx to
+// succeed. Sets the source the pool's scaling factors are automatically
+// updated from. An unset rate_source removes the existing one.
+type MsgStableSwapSetScalingFactorRateSource struct {
+	Sender     string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID     uint64                   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	RateSource *ScalingFactorRateSource `protobuf:"bytes,3,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty" yaml:"rate_source"`
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateSource{}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapSetScalingFactorRateSource) ProtoMessage()    {}
+func (*MsgStableSwapSetScalingFactorRateSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateSource proto.InternalMessageInfo
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) GetRateSource() *ScalingFactorRateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return nil
+}
+
+type MsgStableSwapSetScalingFactorRateSourceResponse struct {
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Reset() {
+	*m = MsgStableSwapSetScalingFactorRateSourceResponse{}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapSetScalingFactorRateSourceResponse) ProtoMessage() {}
+func (*MsgStableSwapSetScalingFactorRateSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.Merge(m, src)
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapSetScalingFactorRateSourceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateSource)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateSource")
+	proto.RegisterType((*MsgStableSwapSetScalingFactorRateSourceResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapSetScalingFactorRateSourceResponse")
 }
 
 func init() {
@@ -275,49 +382,55 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0x10, 0xc4, 0x55, 0x80, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8, 0x0c, 0x69,
-	0x21, 0x3e, 0xda, 0x4a, 0x95, 0xe8, 0xd6, 0x04, 0x15, 0x55, 0x10, 0xa9, 0xb8, 0x62, 0x81, 0x21,
-	0x5c, 0xec, 0xab, 0x39, 0xb0, 0x7d, 0xc6, 0x77, 0xe9, 0x8f, 0x91, 0x95, 0x89, 0x3f, 0x03, 0x31,
-	0xf1, 0x57, 0xa0, 0x8e, 0x1d, 0x99, 0x02, 0x4a, 0x87, 0xee, 0x59, 0x10, 0x1b, 0xba, 0xf3, 0xe5,
-	0x97, 0xd4, 0xf4, 0x07, 0xea, 0x92, 0x9c, 0x9f, 0xbf, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0xf9, 0xc0,
-	0x23, 0xca, 0x42, 0xca, 0x08, 0x83, 0x3e, 0x0a, 0x43, 0x18, 0x53, 0x1a, 0x54, 0x43, 0xea, 0xe1,
-	0x80, 0x41, 0xc6, 0x51, 0x2b, 0xc0, 0x6c, 0x1f, 0xc5, 0x90, 0x1f, 0xd8, 0x71, 0x42, 0x39, 0xd5,
-	0x97, 0x14, 0xda, 0x16, 0x68, 0x5b, 0xa0, 0x53, 0xb0, 0x3d, 0x04, 0xdb, 0x7b, 0xcb, 0x2d, 0xcc,
-	0xd1, 0x72, 0xc9, 0x70, 0x25, 0x18, 0xb6, 0x10, 0xc3, 0x50, 0x05, 0xa1, 0x4b, 0x49, 0x94, 0x72,
-	0x95, 0x0a, 0x3e, 0xf5, 0xa9, 0x3c, 0x42, 0x71, 0x52, 0xd1, 0x19, 0x14, 0x92, 0x88, 0x42, 0xf9,
-	0xab, 0x42, 0x4f, 0x2e, 0x53, 0xe2, 0xf0, 0xd8, 0x14, 0x88, 0x34, 0xd5, 0x3a, 0xcd, 0x81, 0xb9,
-	0x06, 0xf3, 0xeb, 0x09, 0x46, 0x1c, 0xef, 0x0c, 0x20, 0xdb, 0x94, 0x06, 0xfa, 0x22, 0xc8, 0x33,
-	0x1c, 0x79, 0x38, 0x29, 0x6a, 0x65, 0xad, 0x72, 0xab, 0x36, 0xd3, 0xeb, 0x98, 0xb7, 0x0f, 0x51,
-	0x18, 0xac, 0x5b, 0x69, 0xdc, 0x72, 0x14, 0x40, 0xa7, 0x60, 0x5a, 0x90, 0x36, 0x63, 0x94, 0xa0,
-	0x90, 0x15, 0xa7, 0xca, 0x5a, 0x65, 0x7a, 0x65, 0xcd, 0xbe, 0xbc, 0x19, 0xb6, 0x50, 0xdc, 0x96,
-	0xd9, 0xb5, 0xd9, 0x5e, 0xc7, 0xd4, 0x53, 0x9d, 0x11, 0x52, 0xcb, 0x01, 0xf1, 0x00, 0xa3, 0x7f,
-	0xd2, 0xc0, 0x2c, 0x89, 0x08, 0x27, 0x28, 0x90, 0xed, 0x34, 0x03, 0xf2, 0xb1, 0x4d, 0x3c, 0xc2,
-	0x0f, 0x8b, 0xd9, 0x72, 0xb6, 0x32, 0xbd, 0x32, 0x6f, 0xa7, 0xee, 0xda, 0xc2, 0xdd, 0x81, 0x4a,
-	0x9d, 0x92, 0xa8, 0xf6, 0xf8, 0xa8, 0x63, 0x66, 0xbe, 0xfd, 0x32, 0x2b, 0x3e, 0xe1, 0xef, 0xda,
-	0x2d, 0xdb, 0xa5, 0x21, 0x54, 0xa3, 0x48, 0xff, 0xaa, 0xcc, 0xfb, 0x00, 0xf9, 0x61, 0x8c, 0x99,
-	0x4c, 0x60, 0x4e, 0x41, 0x49, 0x89, 0x22, 0x5f, 0xf4, 0x85, 0xf4, 0x06, 0xb8, 0xcb, 0x5c, 0x14,
-	0x90, 0xc8, 0x6f, 0xee, 0x22, 0x97, 0xd3, 0x84, 0x15, 0x73, 0xe5, 0x6c, 0x25, 0x57, 0x7b, 0xd0,
-	0xeb, 0x98, 0x65, 0x65, 0xd4, 0xd0, 0xf5, 0x71, 0xac, 0xe5, 0xdc, 0x51, 0x81, 0xcd, 0x34, 0x57,
-	0x7f, 0x09, 0x0a, 0xbb, 0x6d, 0xde, 0x4e, 0x70, 0xda, 0x90, 0x4f, 0xf7, 0x70, 0x12, 0xd1, 0xa4,
-	0x78, 0x43, 0x9a, 0x6f, 0xf6, 0x3a, 0xe6, 0xbd, 0x94, 0xf3, 0x2c, 0x94, 0xe5, 0xe8, 0x69, 0x58,
-	0x94, 0xf8, 0x4c, 0x05, 0xf5, 0xb7, 0x60, 0x7e, 0x5c, 0xb5, 0xe9, 0xd2, 0x88, 0x27, 0x34, 0x08,
-	0x70, 0x52, 0xcc, 0x4b, 0xde, 0xd1, 0x5a, 0x27, 0x41, 0x2d, 0x67, 0x6e, 0xac, 0xd6, 0xfa, 0xe0,
-	0xcd, 0x7a, 0xe5, 0xf3, 0xe9, 0xf7, 0xa5, 0x85, 0xb1, 0xfd, 0x73, 0xe5, 0x2e, 0x55, 0x87, 0x9d,
-	0x57, 0x45, 0xa5, 0xd6, 0x26, 0x30, 0x27, 0x2c, 0x9a, 0x83, 0x59, 0x4c, 0x23, 0x86, 0xf5, 0x05,
-	0x70, 0x53, 0x36, 0x45, 0x3c, 0xb9, 0x71, 0xb9, 0x1a, 0xe8, 0x76, 0xcc, 0xbc, 0x80, 0x6c, 0x3d,
-	0x75, 0xf2, 0xe2, 0xd5, 0x96, 0x67, 0xfd, 0xd1, 0xc0, 0xfd, 0x06, 0xf3, 0x53, 0x8a, 0x9d, 0x7d,
-	0x14, 0x6f, 0x78, 0xef, 0xdb, 0x8c, 0xef, 0x8c, 0x9b, 0x79, 0x85, 0xdd, 0x1d, 0x51, 0x9d, 0x9a,
-	0xa4, 0x7a, 0xd6, 0xac, 0xb3, 0xff, 0x3f, 0xeb, 0xf5, 0x55, 0x61, 0x9b, 0x3d, 0x66, 0xdb, 0x88,
-	0x5f, 0x48, 0x76, 0x54, 0x55, 0x39, 0x55, 0x25, 0x68, 0x3d, 0x04, 0x8b, 0x17, 0x36, 0xde, 0xf7,
-	0x72, 0xe5, 0xef, 0x14, 0xc8, 0x36, 0x98, 0xaf, 0x7f, 0xd5, 0x40, 0xe1, 0xcc, 0xaf, 0xbb, 0x7e,
-	0x95, 0xaf, 0x73, 0xc2, 0xe4, 0x4a, 0xcf, 0xaf, 0x81, 0x64, 0x30, 0xfe, 0x1f, 0x1a, 0x30, 0x2e,
-	0x18, 0x6b, 0xe3, 0x8a, 0x7a, 0xe7, 0xd3, 0x95, 0x5e, 0x5d, 0x2b, 0x5d, 0xbf, 0x91, 0xda, 0x9b,
-	0xa3, 0xae, 0xa1, 0x1d, 0x77, 0x0d, 0xed, 0x77, 0xd7, 0xd0, 0xbe, 0x9c, 0x18, 0x99, 0xe3, 0x13,
-	0x23, 0xf3, 0xf3, 0xc4, 0xc8, 0xbc, 0xde, 0x18, 0xb9, 0x72, 0x94, 0x74, 0x35, 0x40, 0x2d, 0xd6,
-	0x7f, 0x80, 0x7b, 0xcb, 0x6b, 0xf0, 0xe0, 0xbc, 0x7b, 0xbc, 0x95, 0x97, 0x17, 0xf7, 0xea, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xdb, 0x04, 0x3e, 0x98, 0x06, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0x8e, 0x9b, 0x7c, 0xf9, 0xf4, 0x5d, 0xf4, 0x81, 0x6a, 0x45, 0x6d, 0x1a, 0x24, 0x3b, 0xb8,
+	0x95, 0x48, 0x0b, 0xb6, 0x49, 0x2b, 0x55, 0x22, 0x4c, 0x4d, 0x50, 0x51, 0x05, 0x91, 0x8a, 0x23,
+	0x16, 0x3a, 0x84, 0x8b, 0x73, 0x35, 0x06, 0xdb, 0x67, 0x7c, 0x97, 0xfe, 0x90, 0x58, 0x58, 0x99,
+	0xf8, 0x33, 0x10, 0x13, 0x03, 0x7f, 0x03, 0xea, 0xd8, 0x81, 0xa1, 0x53, 0x40, 0xe9, 0xd0, 0x3d,
+	0x0b, 0x2b, 0xba, 0xb3, 0xf3, 0xc3, 0xa8, 0x69, 0x93, 0xaa, 0x2c, 0xc9, 0xe5, 0xf5, 0xf3, 0x3e,
+	0xcf, 0xfb, 0x3e, 0xf7, 0xde, 0x39, 0xe0, 0x1e, 0x26, 0x2e, 0x26, 0x36, 0xd1, 0x2d, 0xe8, 0xba,
+	0xba, 0x8f, 0xb1, 0xa3, 0xba, 0xb8, 0x85, 0x1c, 0xa2, 0x13, 0x0a, 0x9b, 0x0e, 0x22, 0xfb, 0xd0,
+	0xd7, 0xe9, 0x81, 0xe6, 0x07, 0x98, 0x62, 0x71, 0x25, 0x42, 0x6b, 0x0c, 0xad, 0x31, 0x74, 0x08,
+	0xd6, 0x86, 0x60, 0x6d, 0xaf, 0xd4, 0x44, 0x14, 0x96, 0xf2, 0x92, 0xc9, 0xc1, 0x7a, 0x13, 0x12,
+	0xa4, 0x47, 0x41, 0xdd, 0xc4, 0xb6, 0x17, 0x72, 0xe5, 0xb3, 0x16, 0xb6, 0x30, 0x5f, 0xea, 0x6c,
+	0x15, 0x45, 0x67, 0xa1, 0x6b, 0x7b, 0x58, 0xe7, 0x9f, 0x51, 0xe8, 0xc1, 0x24, 0x25, 0x0e, 0x97,
+	0x0d, 0x86, 0x08, 0x53, 0x95, 0xb3, 0x14, 0x98, 0xaf, 0x11, 0xab, 0x1a, 0x20, 0x48, 0x51, 0x7d,
+	0x00, 0xd9, 0xc6, 0xd8, 0x11, 0x97, 0x41, 0x9a, 0x20, 0xaf, 0x85, 0x82, 0x9c, 0x50, 0x10, 0x8a,
+	0xff, 0x55, 0x66, 0x7b, 0x1d, 0xf9, 0xff, 0x43, 0xe8, 0x3a, 0x65, 0x25, 0x8c, 0x2b, 0x46, 0x04,
+	0x10, 0x31, 0xc8, 0x30, 0xd2, 0x86, 0x0f, 0x03, 0xe8, 0x92, 0xdc, 0x4c, 0x41, 0x28, 0x66, 0x56,
+	0xd7, 0xb5, 0xc9, 0xcd, 0xd0, 0x98, 0xe2, 0x36, 0xcf, 0xae, 0xcc, 0xf5, 0x3a, 0xb2, 0x18, 0xea,
+	0x8c, 0x90, 0x2a, 0x06, 0xf0, 0x07, 0x18, 0xf1, 0xbd, 0x00, 0xe6, 0x6c, 0xcf, 0xa6, 0x36, 0x74,
+	0x78, 0x3b, 0x0d, 0xc7, 0x7e, 0xdb, 0xb6, 0x5b, 0x36, 0x3d, 0xcc, 0x25, 0x0b, 0xc9, 0x62, 0x66,
+	0x75, 0x41, 0x0b, 0xdd, 0xd5, 0x98, 0xbb, 0x03, 0x95, 0x2a, 0xb6, 0xbd, 0xca, 0xfd, 0xa3, 0x8e,
+	0x9c, 0xf8, 0xfc, 0x43, 0x2e, 0x5a, 0x36, 0x7d, 0xd5, 0x6e, 0x6a, 0x26, 0x76, 0xf5, 0x68, 0x2b,
+	0xc2, 0x2f, 0x95, 0xb4, 0xde, 0xe8, 0xf4, 0xd0, 0x47, 0x84, 0x27, 0x10, 0x23, 0x1b, 0x49, 0xb1,
+	0x22, 0x9f, 0xf6, 0x85, 0xc4, 0x1a, 0xb8, 0x49, 0x4c, 0xe8, 0xd8, 0x9e, 0xd5, 0xd8, 0x85, 0x26,
+	0xc5, 0x01, 0xc9, 0xa5, 0x0a, 0xc9, 0x62, 0xaa, 0xb2, 0xd4, 0xeb, 0xc8, 0x85, 0xc8, 0xa8, 0xa1,
+	0xeb, 0x71, 0xac, 0x62, 0xdc, 0x88, 0x02, 0x9b, 0x61, 0xae, 0xf8, 0x0c, 0x64, 0x77, 0xdb, 0xb4,
+	0x1d, 0xa0, 0xb0, 0x21, 0x0b, 0xef, 0xa1, 0xc0, 0xc3, 0x41, 0xee, 0x1f, 0x6e, 0xbe, 0xdc, 0xeb,
+	0xc8, 0xb7, 0x42, 0xce, 0xf3, 0x50, 0x8a, 0x21, 0x86, 0x61, 0x56, 0xe2, 0xe3, 0x28, 0x28, 0xbe,
+	0x04, 0x0b, 0x71, 0xd5, 0x86, 0x89, 0x3d, 0x1a, 0x60, 0xc7, 0x41, 0x41, 0x2e, 0xcd, 0x79, 0x47,
+	0x6b, 0x1d, 0x07, 0x55, 0x8c, 0xf9, 0x58, 0xad, 0xd5, 0xc1, 0x93, 0x72, 0xf1, 0xc3, 0xd9, 0x97,
+	0x95, 0xc5, 0xd8, 0xfc, 0x99, 0x7c, 0x96, 0xd4, 0x61, 0xe7, 0x2a, 0xab, 0x54, 0xd9, 0x04, 0xf2,
+	0x98, 0x41, 0x33, 0x10, 0xf1, 0xb1, 0x47, 0x90, 0xb8, 0x08, 0xfe, 0xe5, 0x4d, 0xd9, 0x2d, 0x3e,
+	0x71, 0xa9, 0x0a, 0xe8, 0x76, 0xe4, 0x34, 0x83, 0x6c, 0x3d, 0x32, 0xd2, 0xec, 0xd1, 0x56, 0x4b,
+	0xf9, 0x25, 0x80, 0xdb, 0x35, 0x62, 0x85, 0x14, 0xf5, 0x7d, 0xe8, 0x6f, 0xb4, 0x5e, 0xb7, 0x09,
+	0xad, 0xc7, 0xcd, 0x9c, 0x62, 0x76, 0x47, 0x54, 0x67, 0xc6, 0xa9, 0x9e, 0xb7, 0xd7, 0xc9, 0xab,
+	0xef, 0x75, 0x79, 0x8d, 0xd9, 0xa6, 0xc5, 0x6c, 0x1b, 0xf1, 0x0b, 0xf2, 0x8e, 0xd4, 0x28, 0x47,
+	0x8d, 0x04, 0x95, 0xbb, 0x60, 0xf9, 0xd2, 0xc6, 0xfb, 0x5e, 0x2a, 0x5f, 0x67, 0xc0, 0x9d, 0x18,
+	0xba, 0x8e, 0xe2, 0x50, 0x83, 0xed, 0x03, 0x6e, 0x07, 0x26, 0xba, 0x76, 0xb3, 0xde, 0x81, 0x4c,
+	0x00, 0x29, 0x6a, 0x10, 0x4e, 0x9f, 0x4b, 0xf2, 0xdb, 0xa0, 0x3a, 0xcd, 0x6d, 0x30, 0xa6, 0xd2,
+	0xd1, 0xab, 0x61, 0x44, 0x41, 0x31, 0x40, 0x30, 0xc0, 0x94, 0x1f, 0x32, 0x6f, 0xd7, 0xc7, 0x79,
+	0x4b, 0xd0, 0x9f, 0xc6, 0xaa, 0x01, 0x1f, 0xd7, 0x90, 0xa9, 0x04, 0xf4, 0x09, 0x5d, 0xeb, 0x3b,
+	0xbd, 0xfa, 0x3d, 0x05, 0x92, 0x35, 0x62, 0x89, 0x9f, 0x04, 0x90, 0x3d, 0xf7, 0x1e, 0x9d, 0xaa,
+	0xf3, 0x31, 0x67, 0x24, 0xff, 0xe4, 0x1a, 0x48, 0x06, 0x07, 0xed, 0x9b, 0x00, 0xa4, 0x4b, 0x0e,
+	0x50, 0x6d, 0x4a, 0xbd, 0x8b, 0xe9, 0xf2, 0xcf, 0xaf, 0x95, 0x6e, 0xd0, 0xc8, 0x89, 0x00, 0x96,
+	0x26, 0x1a, 0xf1, 0xfa, 0x95, 0xf5, 0xc7, 0x93, 0xe6, 0x77, 0xfe, 0x02, 0x69, 0xbf, 0xb5, 0xca,
+	0xce, 0x51, 0x57, 0x12, 0x8e, 0xbb, 0x92, 0xf0, 0xb3, 0x2b, 0x09, 0x1f, 0x4f, 0xa5, 0xc4, 0xf1,
+	0xa9, 0x94, 0x38, 0x39, 0x95, 0x12, 0x2f, 0x36, 0x46, 0xde, 0x5b, 0x51, 0x01, 0xaa, 0x03, 0x9b,
+	0xa4, 0xff, 0x43, 0xdf, 0x2b, 0xad, 0xeb, 0x07, 0x17, 0xfd, 0x19, 0x68, 0xa6, 0xf9, 0xdb, 0x7f,
+	0xed, 0xf7, 0x00, 0x97, 0xb7, 0x82, 0xef, 0xdd, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +447,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateSource(ctx context.Context, in *MsgStableSwapSetScalingFactorRateSource, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateSourceResponse, error)
 }
 
 type msgClient struct {
@@ -362,10 +476,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapSetScalingFactorRateSource(ctx context.Context, in *MsgStableSwapSetScalingFactorRateSource, opts ...grpc.CallOption) (*MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	out := new(MsgStableSwapSetScalingFactorRateSourceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapSetScalingFactorRateSource(context.Context, *MsgStableSwapSetScalingFactorRateSource) (*MsgStableSwapSetScalingFactorRateSourceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -378,6 +502,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapSetScalingFactorRateSource(ctx context.Context, req *MsgStableSwapSetScalingFactorRateSource) (*MsgStableSwapSetScalingFactorRateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapSetScalingFactorRateSource not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -419,6 +546,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapSetScalingFactorRateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapSetScalingFactorRateSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapSetScalingFactorRateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapSetScalingFactorRateSource(ctx, req.(*MsgStableSwapSetScalingFactorRateSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -431,6 +576,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapSetScalingFactorRateSource",
+			Handler:    _Msg_StableSwapSetScalingFactorRateSource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -628,6 +777,76 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapSetScalingFactorRateSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateSource != nil {
+		{
+			size, err := m.RateSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -721,6 +940,35 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapSetScalingFactorRateSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.RateSource != nil {
+		l = m.RateSource.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1315,6 +1563,193 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapSetScalingFactorRateSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateSource == nil {
+				m.RateSource = &ScalingFactorRateSource{}
+			}
+			if err := m.RateSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapSetScalingFactorRateSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapSetScalingFactorRateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
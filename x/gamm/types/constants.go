@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,10 @@ const (
 	// pools can be created with min and max number of assets defined with this constants
 	MinNumOfAssetsInPool = 2
 	MaxNumOfAssetsInPool = 8
+
+	// DefaultShareValueTwapWindow is the window of the geometric TWAPs used to value pool shares
+	// when none is given.
+	DefaultShareValueTwapWindow = 10 * time.Minute
 )
 
 var (
@@ -90,4 +90,12 @@ var (
 	ErrInvalidScalingFactors      = errorsmod.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = errorsmod.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+
+	ErrInvalidScalingFactorRateSource = errorsmod.Register(ModuleName, 67, "invalid scaling factor rate source")
+
+	ErrInvalidSharePriceQuote = errorsmod.Register(ModuleName, 68, "share price quote denom cannot be reached from pool assets")
+
+	ErrCFMMInvariantViolated = errorsmod.Register(ModuleName, 69, "cfmm invariant violated")
+
+	ErrScalingFactorChangeTooLarge = errorsmod.Register(ModuleName, 70, "scaling factor change exceeds the max scaling factor change per update")
 )
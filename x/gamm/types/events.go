@@ -6,6 +6,8 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
+	TypeEvtScalingFactorsUpdated = "scaling_factors_updated"
+
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
 	AttributeKeyPoolIdEntering = "pool_id_entering"
@@ -13,6 +15,7 @@ const (
 	AttributeKeySwapFee        = "swap_fee"
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
+	AttributeKeyScalingFactors = "scaling_factors"
 
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
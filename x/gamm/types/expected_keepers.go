@@ -43,6 +43,7 @@ type BankKeeper interface {
 	// TODO: Look into golang syntax to make this "Everything in stakingtypes.bankkeeper + extra funcs"
 	// I think it has to do with listing another interface as the first line here?
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
@@ -91,6 +92,8 @@ type PoolManager interface {
 	GetPoolModule(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolModuleI, error)
 
 	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+
+	RouteGetPoolDenoms(ctx sdk.Context, poolId uint64) (denoms []string, err error)
 }
 
 type PoolIncentivesKeeper interface {
@@ -103,3 +106,25 @@ type PoolIncentivesKeeper interface {
 type IncentivesKeeper interface {
 	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
 }
+
+// TwapKeeper defines the contract needed to be fulfilled for the twap keeper.
+type TwapKeeper interface {
+	GetGeometricTwapToNow(
+		ctx sdk.Context,
+		poolId uint64,
+		baseAssetDenom string,
+		quoteAssetDenom string,
+		startTime time.Time,
+	) (sdk.Dec, error)
+}
+
+// WasmKeeper defines the contract needed to be fulfilled for the wasm keeper.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, queryMsg []byte) ([]byte, error)
+}
+
+// LockupKeeper defines the contract needed to be fulfilled for the lockup keeper.
+type LockupKeeper interface {
+	GetAccountLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetAccountUnlockingCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -30,6 +30,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// max_scaling_factor_change_per_update is the largest relative change of the
+	// price ratio between any two scaling factors of a stableswap pool that can be
+	// applied in a single update from the pool's scaling factor rate source.
+	MaxScalingFactorChangePerUpdate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_scaling_factor_change_per_update,json=maxScalingFactorChangePerUpdate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_scaling_factor_change_per_update" yaml:"max_scaling_factor_change_per_update"`
+	// rate_source_query_gas_limit is the maximum amount of gas a scaling factor
+	// rate source contract can consume when queried for the scaling factors of a
+	// stableswap pool.
+	RateSourceQueryGasLimit uint64 `protobuf:"varint,3,opt,name=rate_source_query_gas_limit,json=rateSourceQueryGasLimit,proto3" json:"rate_source_query_gas_limit,omitempty" yaml:"rate_source_query_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,6 +80,13 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetRateSourceQueryGasLimit() uint64 {
+	if m != nil {
+		return m.RateSourceQueryGasLimit
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
@@ -258,41 +273,49 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0x6d, 0x1a, 0xfd, 0x7e, 0x57, 0x04, 0xa9, 0x95, 0xc1, 0xad, 0x2a, 0x27, 0x32,
-	0x12, 0xca, 0x92, 0x33, 0x29, 0x7f, 0x86, 0x6c, 0x24, 0x12, 0x08, 0x54, 0x50, 0xe5, 0x32, 0xb1,
-	0x58, 0x67, 0xe7, 0x6a, 0xac, 0xd8, 0xf7, 0x44, 0xbe, 0x4b, 0xd5, 0x6c, 0x8c, 0x8c, 0x48, 0xcc,
-	0x20, 0x66, 0x66, 0x5e, 0x44, 0xc5, 0xd4, 0x91, 0xa9, 0xa0, 0x64, 0x61, 0xe6, 0x15, 0xa0, 0xfb,
-	0xe3, 0xa8, 0x2a, 0x51, 0x27, 0xfb, 0x79, 0x9e, 0xcf, 0xf3, 0xf5, 0xf3, 0x7c, 0xef, 0x8c, 0x7d,
-	0x10, 0x05, 0x88, 0x4c, 0x04, 0x29, 0x2d, 0x8a, 0xe0, 0xb4, 0x1f, 0x33, 0x49, 0xfb, 0x41, 0xca,
-	0x38, 0x13, 0x99, 0x20, 0xd3, 0x12, 0x24, 0x38, 0x2d, 0xcb, 0x10, 0xc5, 0x10, 0xcb, 0xec, 0xb5,
-	0x52, 0x48, 0x41, 0x03, 0x81, 0x7a, 0x33, 0xec, 0xde, 0x6e, 0x0a, 0x90, 0xe6, 0x2c, 0xd0, 0x51,
-	0x3c, 0x3b, 0x09, 0x28, 0x9f, 0x57, 0xa5, 0x44, 0xeb, 0x44, 0xa6, 0xc7, 0x04, 0xb6, 0xe4, 0x99,
-	0x28, 0x88, 0xa9, 0x60, 0xab, 0x21, 0x12, 0xc8, 0xb8, 0xa9, 0xfb, 0x9f, 0x11, 0x6e, 0x1c, 0xd1,
-	0x92, 0x16, 0xc2, 0xf9, 0x88, 0xf0, 0xce, 0x14, 0x20, 0x8f, 0x92, 0x92, 0x51, 0x99, 0x01, 0x8f,
-	0x4e, 0x18, 0x73, 0x51, 0x67, 0xb3, 0xbb, 0x7d, 0xb0, 0x4b, 0xac, 0xaa, 0xd2, 0xa9, 0x06, 0x25,
-	0x23, 0xc8, 0xf8, 0xf0, 0xf0, 0xfc, 0xb2, 0x5d, 0xfb, 0x73, 0xd9, 0x76, 0xe7, 0xb4, 0xc8, 0x07,
-	0xfe, 0x3f, 0x0a, 0xfe, 0xd7, 0x9f, 0xed, 0x6e, 0x9a, 0xc9, 0xb7, 0xb3, 0x98, 0x24, 0x50, 0xd8,
-	0xf1, 0xec, 0xa3, 0x27, 0xc6, 0x93, 0x40, 0xce, 0xa7, 0x4c, 0x68, 0x31, 0x11, 0xde, 0x51, 0xfd,
-	0x23, 0xdb, 0xfe, 0x94, 0x31, 0xff, 0xdd, 0x06, 0xbe, 0xf5, 0xcc, 0x98, 0x76, 0x2c, 0xa9, 0x64,
-	0xce, 0x23, 0xbc, 0xa5, 0x18, 0x61, 0x27, 0x6b, 0x11, 0xe3, 0x0b, 0xa9, 0x7c, 0x21, 0x4f, 0xf8,
-	0x7c, 0xf8, 0xff, 0xf7, 0x6f, 0xbd, 0xad, 0x23, 0x80, 0xfc, 0x79, 0x68, 0x68, 0xa7, 0x8b, 0x9b,
-	0x9c, 0x9d, 0xc9, 0x48, 0xcf, 0xc7, 0x67, 0x45, 0xcc, 0x4a, 0x77, 0xa3, 0x83, 0xba, 0xf5, 0xf0,
-	0xb6, 0xca, 0x2b, 0xf6, 0x95, 0xce, 0x3a, 0x03, 0xdc, 0x98, 0x6a, 0x47, 0xdc, 0xcd, 0x0e, 0xea,
-	0x6e, 0x1f, 0xec, 0x93, 0x75, 0xa7, 0x44, 0x8c, 0x6b, 0xc3, 0xba, 0x5a, 0x3f, 0xb4, 0x1d, 0xce,
-	0x31, 0xde, 0x29, 0xb2, 0xb4, 0x34, 0xcb, 0x97, 0x2c, 0x81, 0x72, 0x2c, 0xdc, 0xba, 0x96, 0xb9,
-	0xb7, 0x5e, 0xe6, 0x65, 0x85, 0x87, 0x86, 0x0e, 0x9b, 0xc5, 0xb5, 0x8c, 0xff, 0x09, 0xe1, 0xe6,
-	0x75, 0xcc, 0x79, 0x8f, 0xf0, 0xdd, 0x98, 0xe6, 0x94, 0x27, 0xac, 0x8c, 0x24, 0x44, 0x09, 0xf0,
-	0x84, 0x71, 0x59, 0x52, 0xc9, 0xc6, 0x66, 0xc9, 0x3c, 0xe3, 0x93, 0xca, 0xa5, 0x87, 0xeb, 0x3f,
-	0x3e, 0xb4, 0x02, 0xaf, 0x61, 0x74, 0xa5, 0x5d, 0x79, 0x71, 0x98, 0xf1, 0x89, 0xdd, 0xad, 0x1d,
-	0xdf, 0x48, 0x09, 0x9f, 0x63, 0xef, 0x66, 0x21, 0x65, 0xfe, 0x6a, 0x56, 0x3d, 0x5b, 0x36, 0x76,
-	0x91, 0x31, 0xbf, 0xca, 0xeb, 0xc3, 0x1a, 0x3b, 0xfb, 0x18, 0x27, 0xf9, 0x8a, 0x31, 0x07, 0xf4,
-	0x5f, 0x92, 0x9b, 0xea, 0xa0, 0xfe, 0xfb, 0x4b, 0x1b, 0x0d, 0x5f, 0x9c, 0x2f, 0x3c, 0x74, 0xb1,
-	0xf0, 0xd0, 0xaf, 0x85, 0x87, 0x3e, 0x2c, 0xbd, 0xda, 0xc5, 0xd2, 0xab, 0xfd, 0x58, 0x7a, 0xb5,
-	0x37, 0xf7, 0xaf, 0xdc, 0x33, 0xbb, 0x70, 0x2f, 0xa7, 0xb1, 0xa8, 0x82, 0xe0, 0xb4, 0xff, 0x38,
-	0x38, 0x33, 0x7f, 0xa4, 0xbe, 0x75, 0x71, 0x43, 0x5f, 0x9b, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0x5b, 0xa5, 0xbb, 0xae, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4f, 0x14, 0x4b,
+	0x10, 0xc7, 0x77, 0x60, 0x21, 0x8f, 0xe6, 0xe5, 0x3d, 0x98, 0x90, 0xb8, 0x20, 0x99, 0x21, 0xa3,
+	0x21, 0x9b, 0x18, 0x66, 0x04, 0x7f, 0x1c, 0xb8, 0x39, 0x6b, 0x20, 0x1a, 0x34, 0x38, 0xab, 0x17,
+	0x13, 0xd3, 0xe9, 0xe9, 0x69, 0x86, 0x0e, 0x33, 0xdd, 0x6b, 0x77, 0x2f, 0xd9, 0xbd, 0x79, 0xf4,
+	0x68, 0xe2, 0xd9, 0xc4, 0xb3, 0xf1, 0xa6, 0x7f, 0x04, 0xf1, 0xc4, 0xd1, 0x78, 0x58, 0x0d, 0x5c,
+	0x3c, 0xf3, 0x17, 0x98, 0xee, 0x9e, 0x21, 0x04, 0x09, 0xe1, 0xb4, 0x5b, 0x5d, 0x9f, 0xfa, 0x56,
+	0x75, 0x55, 0xf5, 0x80, 0x80, 0xcb, 0x92, 0x4b, 0x2a, 0xa3, 0x1c, 0x95, 0x65, 0xb4, 0xbf, 0x9a,
+	0x12, 0x85, 0x56, 0xa3, 0x9c, 0x30, 0x22, 0xa9, 0x0c, 0x7b, 0x82, 0x2b, 0xee, 0xce, 0x55, 0x4c,
+	0xa8, 0x99, 0xb0, 0x62, 0x16, 0xe6, 0x72, 0x9e, 0x73, 0x03, 0x44, 0xfa, 0x9f, 0x65, 0x17, 0xe6,
+	0x73, 0xce, 0xf3, 0x82, 0x44, 0xc6, 0x4a, 0xfb, 0x3b, 0x11, 0x62, 0xc3, 0xda, 0x85, 0x8d, 0x0e,
+	0xb4, 0x31, 0xd6, 0xa8, 0x5c, 0x9e, 0xb5, 0xa2, 0x14, 0x49, 0x72, 0x5a, 0x04, 0xe6, 0x94, 0x59,
+	0x7f, 0xf0, 0x65, 0x1c, 0x4c, 0x6e, 0x23, 0x81, 0x4a, 0xe9, 0xbe, 0x77, 0xc0, 0x6c, 0x8f, 0xf3,
+	0x02, 0x62, 0x41, 0x90, 0xa2, 0x9c, 0xc1, 0x1d, 0x42, 0x5a, 0xce, 0xd2, 0x78, 0x7b, 0x7a, 0x6d,
+	0x3e, 0xac, 0x54, 0xb5, 0x4e, 0x5d, 0x68, 0xd8, 0xe1, 0x94, 0xc5, 0x5b, 0x07, 0x23, 0xbf, 0x71,
+	0x32, 0xf2, 0x5b, 0x43, 0x54, 0x16, 0xeb, 0xc1, 0x5f, 0x0a, 0xc1, 0xa7, 0x9f, 0x7e, 0x3b, 0xa7,
+	0x6a, 0xb7, 0x9f, 0x86, 0x98, 0x97, 0x55, 0x79, 0xd5, 0xcf, 0x8a, 0xcc, 0xf6, 0x22, 0x35, 0xec,
+	0x11, 0x69, 0xc4, 0x64, 0xf2, 0xbf, 0x8e, 0xef, 0x54, 0xe1, 0x1b, 0x84, 0xb8, 0x9f, 0x1d, 0x70,
+	0xb3, 0x44, 0x03, 0x28, 0x31, 0x2a, 0x28, 0xcb, 0xe1, 0x0e, 0xc2, 0x8a, 0x0b, 0x88, 0x77, 0x11,
+	0xcb, 0x09, 0xec, 0x11, 0x01, 0xfb, 0xbd, 0x0c, 0x29, 0xd2, 0x1a, 0x5b, 0x72, 0xda, 0x53, 0xf1,
+	0x2b, 0x5d, 0xcd, 0x8f, 0x91, 0xbf, 0x7c, 0x85, 0x8c, 0x0f, 0x09, 0x3e, 0x19, 0xf9, 0xb7, 0x6c,
+	0xdd, 0x57, 0xc9, 0x11, 0x24, 0x7e, 0x89, 0x06, 0x5d, 0x4b, 0x6d, 0x18, 0xa8, 0x63, 0x98, 0x6d,
+	0x22, 0x5e, 0x18, 0xc2, 0xcd, 0xc0, 0x75, 0x81, 0x14, 0x81, 0x92, 0xf7, 0x05, 0x26, 0xf0, 0x75,
+	0x9f, 0x88, 0x21, 0xcc, 0x91, 0x84, 0x05, 0x2d, 0xa9, 0x6a, 0x8d, 0x2f, 0x39, 0xed, 0x66, 0xbc,
+	0x7c, 0x32, 0xf2, 0x03, 0x9b, 0xf6, 0x12, 0x38, 0x48, 0xae, 0x69, 0x6f, 0xd7, 0x38, 0x9f, 0x69,
+	0xdf, 0x26, 0x92, 0x5b, 0xc6, 0xf3, 0x66, 0x0c, 0xfc, 0xbb, 0x69, 0x37, 0xa9, 0xab, 0x74, 0xda,
+	0x7b, 0x60, 0x42, 0x37, 0x4e, 0x56, 0xe3, 0x9a, 0x0b, 0xed, 0xb2, 0x84, 0xf5, 0xb2, 0x84, 0x0f,
+	0xd8, 0x30, 0x9e, 0xfa, 0xf6, 0x75, 0x65, 0x62, 0x9b, 0xf3, 0xe2, 0x51, 0x62, 0x69, 0xb7, 0x0d,
+	0x66, 0x18, 0x19, 0x28, 0xa8, 0x2d, 0xc8, 0xfa, 0x65, 0x4a, 0x84, 0xe9, 0x63, 0x33, 0xf9, 0x4f,
+	0x9f, 0x6b, 0xf6, 0xa9, 0x39, 0x75, 0xd7, 0xc1, 0x64, 0xcf, 0xac, 0x89, 0xb9, 0xc2, 0xf4, 0xda,
+	0x62, 0x78, 0xd1, 0xea, 0x86, 0x76, 0x95, 0xe2, 0xa6, 0x9e, 0x42, 0x52, 0x45, 0xb8, 0x5d, 0x30,
+	0x5b, 0xd2, 0x5c, 0xd8, 0x8d, 0x10, 0x04, 0x73, 0x91, 0xc9, 0x56, 0xd3, 0xc8, 0x2c, 0x5f, 0x2c,
+	0xf3, 0xa4, 0xc6, 0x13, 0x4b, 0x27, 0x33, 0xe5, 0xb9, 0x93, 0xe0, 0x83, 0x03, 0x66, 0xce, 0x63,
+	0xee, 0x5b, 0x07, 0xdc, 0x48, 0x51, 0x81, 0x18, 0x26, 0x02, 0x2a, 0x0e, 0x31, 0x67, 0x98, 0x30,
+	0xa5, 0xdb, 0x98, 0xd9, 0x4b, 0x16, 0x94, 0xed, 0xd5, 0x5d, 0xba, 0x7b, 0x71, 0xf2, 0xb8, 0x12,
+	0x78, 0xce, 0x3b, 0x67, 0xc2, 0x75, 0x2f, 0xb6, 0x28, 0xdb, 0xab, 0xee, 0xe6, 0xa7, 0x97, 0x52,
+	0x32, 0x60, 0xc0, 0xbb, 0x5c, 0x48, 0x37, 0xff, 0xb4, 0x56, 0x53, 0x1b, 0xcd, 0x5a, 0x8e, 0x6d,
+	0x7e, 0x7d, 0x6e, 0x86, 0x95, 0xb9, 0x8b, 0x00, 0xe0, 0xe2, 0x94, 0xb1, 0x03, 0xfa, 0x07, 0x17,
+	0xd6, 0xbb, 0xde, 0xfc, 0xfd, 0xd1, 0x77, 0xe2, 0xc7, 0x07, 0x47, 0x9e, 0x73, 0x78, 0xe4, 0x39,
+	0xbf, 0x8e, 0x3c, 0xe7, 0xdd, 0xb1, 0xd7, 0x38, 0x3c, 0xf6, 0x1a, 0xdf, 0x8f, 0xbd, 0xc6, 0xcb,
+	0xdb, 0x67, 0x9e, 0x42, 0x75, 0xe1, 0x95, 0x02, 0xa5, 0xb2, 0x36, 0xa2, 0xfd, 0xd5, 0xfb, 0xd1,
+	0xc0, 0x7e, 0xa6, 0xcc, 0xc3, 0x48, 0x27, 0xcd, 0xda, 0xdc, 0xf9, 0x33, 0x00, 0xd1, 0x6e, 0xa7,
+	0x5c, 0xc3, 0x04, 0x00, 0x00,
 }
 
 func (this *BalancerToConcentratedPoolLink) Equal(that interface{}) bool {
@@ -342,6 +365,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateSourceQueryGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RateSourceQueryGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxScalingFactorChangePerUpdate.Size()
+		i -= size
+		if _, err := m.MaxScalingFactorChangePerUpdate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -516,6 +554,11 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.MaxScalingFactorChangePerUpdate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RateSourceQueryGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.RateSourceQueryGasLimit))
+	}
 	return n
 }
 
@@ -642,6 +685,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScalingFactorChangePerUpdate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxScalingFactorChangePerUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSourceQueryGasLimit", wireType)
+			}
+			m.RateSourceQueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSourceQueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
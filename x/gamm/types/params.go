@@ -11,7 +11,9 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee                 = []byte("PoolCreationFee")
+	KeyMaxScalingFactorChangePerUpdate = []byte("MaxScalingFactorChangePerUpdate")
+	KeyRateSourceQueryGasLimit         = []byte("RateSourceQueryGasLimit")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +21,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, maxScalingFactorChangePerUpdate sdk.Dec, rateSourceQueryGasLimit uint64) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:                 poolCreationFee,
+		MaxScalingFactorChangePerUpdate: maxScalingFactorChangePerUpdate,
+		RateSourceQueryGasLimit:         rateSourceQueryGasLimit,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:                 sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		MaxScalingFactorChangePerUpdate: sdk.NewDecWithPrec(5, 2),                                          // 5%
+		RateSourceQueryGasLimit:         500_000,
 	}
 }
 
@@ -37,6 +43,12 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateMaxScalingFactorChangePerUpdate(p.MaxScalingFactorChangePerUpdate); err != nil {
+		return err
+	}
+	if err := validateRateSourceQueryGasLimit(p.RateSourceQueryGasLimit); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +57,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyMaxScalingFactorChangePerUpdate, &p.MaxScalingFactorChangePerUpdate, validateMaxScalingFactorChangePerUpdate),
+		paramtypes.NewParamSetPair(KeyRateSourceQueryGasLimit, &p.RateSourceQueryGasLimit, validateRateSourceQueryGasLimit),
 	}
 }
 
@@ -60,3 +74,29 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxScalingFactorChangePerUpdate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max scaling factor change per update must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateRateSourceQueryGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("rate source query gas limit must be positive: %d", v)
+	}
+
+	return nil
+}
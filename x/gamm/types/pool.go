@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
@@ -112,3 +113,16 @@ type WeightedPoolExtension interface {
 	// GetTokenWeight returns the weight of the specified token in the pool.
 	GetTokenWeight(denom string) (sdk.Int, error)
 }
+
+// ConstantFunctionExtension is an extension of the CFMMPoolI interface
+// for pools exposing the value of their constant function, which the
+// CFMM invariants of the module are checked against.
+type ConstantFunctionExtension interface {
+	CFMMPoolI
+
+	// Log2ConstantFunctionValue returns the base 2 logarithm of the pool's constant function
+	// at its current reserves, normalized to be homogeneous of degree one in the reserves.
+	// That is, scaling all reserves by a factor c adds log2(c) to the returned value,
+	// so that the value of one share can be compared across joins and exits.
+	Log2ConstantFunctionValue(ctx sdk.Context) (osmomath.BigDec, error)
+}
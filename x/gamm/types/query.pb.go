@@ -14,6 +14,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types2 "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -22,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1588,6 +1592,267 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) GetConcentratedPoolId() ui
 	return 0
 }
 
+// =============================== SharePrice
+type QuerySharePriceRequest struct {
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// price_pool_id is the pool used to price the pool assets in quote_denom
+	// when quote_denom is not one of the pool assets. It must contain
+	// quote_denom and one of the pool assets.
+	PricePoolId uint64 `protobuf:"varint,3,opt,name=price_pool_id,json=pricePoolId,proto3" json:"price_pool_id,omitempty" yaml:"price_pool_id"`
+	// twap_window is the window of the geometric TWAPs used for pricing.
+	// Defaults to 10 minutes if unset.
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+}
+
+func (m *QuerySharePriceRequest) Reset()         { *m = QuerySharePriceRequest{} }
+func (m *QuerySharePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceRequest) ProtoMessage()    {}
+func (*QuerySharePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
+}
+func (m *QuerySharePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceRequest.Merge(m, src)
+}
+func (m *QuerySharePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceRequest proto.InternalMessageInfo
+
+func (m *QuerySharePriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QuerySharePriceRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QuerySharePriceRequest) GetPricePoolId() uint64 {
+	if m != nil {
+		return m.PricePoolId
+	}
+	return 0
+}
+
+func (m *QuerySharePriceRequest) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+type QuerySharePriceResponse struct {
+	// share_price is the value of one pool share, i.e. 10^18 share base units,
+	// in quote denom base units.
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price" yaml:"share_price"`
+}
+
+func (m *QuerySharePriceResponse) Reset()         { *m = QuerySharePriceResponse{} }
+func (m *QuerySharePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceResponse) ProtoMessage()    {}
+func (*QuerySharePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
+}
+func (m *QuerySharePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceResponse.Merge(m, src)
+}
+func (m *QuerySharePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceResponse proto.InternalMessageInfo
+
+// =============================== AccountPoolPositionsValue
+type QueryAccountPoolPositionsValueRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId     uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
+	// price_pool_id is the pool used to price the pool assets in quote_denom
+	// when quote_denom is not one of the pool assets. It must contain
+	// quote_denom and one of the pool assets.
+	PricePoolId uint64 `protobuf:"varint,4,opt,name=price_pool_id,json=pricePoolId,proto3" json:"price_pool_id,omitempty" yaml:"price_pool_id"`
+	// twap_window is the window of the geometric TWAPs used for pricing.
+	// Defaults to 10 minutes if unset.
+	TwapWindow time.Duration `protobuf:"bytes,5,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) Reset()         { *m = QueryAccountPoolPositionsValueRequest{} }
+func (m *QueryAccountPoolPositionsValueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPoolPositionsValueRequest) ProtoMessage()    {}
+func (*QueryAccountPoolPositionsValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
+}
+func (m *QueryAccountPoolPositionsValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPoolPositionsValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPoolPositionsValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPoolPositionsValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPoolPositionsValueRequest.Merge(m, src)
+}
+func (m *QueryAccountPoolPositionsValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPoolPositionsValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPoolPositionsValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPoolPositionsValueRequest proto.InternalMessageInfo
+
+func (m *QueryAccountPoolPositionsValueRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) GetPricePoolId() uint64 {
+	if m != nil {
+		return m.PricePoolId
+	}
+	return 0
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+type QueryAccountPoolPositionsValueResponse struct {
+	BalanceShares   types1.Coin                            `protobuf:"bytes,1,opt,name=balance_shares,json=balanceShares,proto3" json:"balance_shares" yaml:"balance_shares"`
+	BalanceValue    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=balance_value,json=balanceValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"balance_value" yaml:"balance_value"`
+	LockedShares    types1.Coin                            `protobuf:"bytes,3,opt,name=locked_shares,json=lockedShares,proto3" json:"locked_shares" yaml:"locked_shares"`
+	LockedValue     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=locked_value,json=lockedValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"locked_value" yaml:"locked_value"`
+	UnlockingShares types1.Coin                            `protobuf:"bytes,5,opt,name=unlocking_shares,json=unlockingShares,proto3" json:"unlocking_shares" yaml:"unlocking_shares"`
+	UnlockingValue  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=unlocking_value,json=unlockingValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unlocking_value" yaml:"unlocking_value"`
+	TotalValue      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_value,json=totalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_value" yaml:"total_value"`
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) Reset() {
+	*m = QueryAccountPoolPositionsValueResponse{}
+}
+func (m *QueryAccountPoolPositionsValueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPoolPositionsValueResponse) ProtoMessage()    {}
+func (*QueryAccountPoolPositionsValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
+}
+func (m *QueryAccountPoolPositionsValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPoolPositionsValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPoolPositionsValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPoolPositionsValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPoolPositionsValueResponse.Merge(m, src)
+}
+func (m *QueryAccountPoolPositionsValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPoolPositionsValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPoolPositionsValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPoolPositionsValueResponse proto.InternalMessageInfo
+
+func (m *QueryAccountPoolPositionsValueResponse) GetBalanceShares() types1.Coin {
+	if m != nil {
+		return m.BalanceShares
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) GetLockedShares() types1.Coin {
+	if m != nil {
+		return m.LockedShares
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) GetUnlockingShares() types1.Coin {
+	if m != nil {
+		return m.UnlockingShares
+	}
+	return types1.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryPoolRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolRequest")
 	proto.RegisterType((*QueryPoolResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolResponse")
@@ -1621,135 +1886,165 @@ func init() {
 	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMRequest)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMRequest")
 	proto.RegisterType((*QueryConcentratedPoolIdLinkFromCFMMResponse)(nil), "osmosis.gamm.v1beta1.QueryConcentratedPoolIdLinkFromCFMMResponse")
+	proto.RegisterType((*QuerySharePriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySharePriceRequest")
+	proto.RegisterType((*QuerySharePriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySharePriceResponse")
+	proto.RegisterType((*QueryAccountPoolPositionsValueRequest)(nil), "osmosis.gamm.v1beta1.QueryAccountPoolPositionsValueRequest")
+	proto.RegisterType((*QueryAccountPoolPositionsValueResponse)(nil), "osmosis.gamm.v1beta1.QueryAccountPoolPositionsValueResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x76, 0x4f, 0x1c, 0xaf, 0xfd, 0xb2, 0xb1, 0x9d, 0x5a, 0x27, 0x99, 0x8c, 0x93, 0x99, 0x50,
-	0xec, 0xc6, 0xd9, 0xc4, 0xee, 0xb1, 0x13, 0x2f, 0x3f, 0x86, 0xec, 0xc6, 0xf6, 0xda, 0xc9, 0x58,
-	0x49, 0xec, 0xed, 0xac, 0x40, 0x80, 0xa0, 0xd5, 0x1e, 0x77, 0xc6, 0xbd, 0x99, 0xee, 0x9a, 0x4c,
-	0x57, 0x6f, 0x6c, 0xad, 0xa2, 0x95, 0xf6, 0x04, 0x5c, 0x16, 0x09, 0x58, 0x04, 0x42, 0x70, 0x59,
-	0x21, 0xc4, 0x15, 0x24, 0x4e, 0x1c, 0x10, 0x97, 0x88, 0x53, 0x24, 0x38, 0x20, 0x0e, 0x03, 0x4a,
-	0xe0, 0xc6, 0xc9, 0x97, 0xbd, 0xa2, 0xaa, 0x7a, 0xfd, 0x33, 0x3f, 0xee, 0xf9, 0x59, 0x22, 0x2d,
-	0xa7, 0x99, 0xae, 0x7a, 0x3f, 0xdf, 0xfb, 0xa9, 0x57, 0xaf, 0x1e, 0x9c, 0x67, 0xbe, 0xcb, 0x7c,
-	0xc7, 0x2f, 0x56, 0x2c, 0xd7, 0x2d, 0xbe, 0xbb, 0xb0, 0x6d, 0x73, 0x6b, 0xa1, 0xf8, 0x20, 0xb0,
-	0xeb, 0xfb, 0x7a, 0xad, 0xce, 0x38, 0x23, 0x53, 0x48, 0xa1, 0x0b, 0x0a, 0x1d, 0x29, 0x72, 0x53,
-	0x15, 0x56, 0x61, 0x92, 0xa0, 0x28, 0xfe, 0x29, 0xda, 0xdc, 0xb9, 0x8e, 0xd2, 0xf8, 0x1e, 0x6e,
-	0xcf, 0x86, 0xdb, 0x35, 0xc6, 0xaa, 0xae, 0xe5, 0x59, 0x15, 0xbb, 0x1e, 0x51, 0xf9, 0x0f, 0xad,
-	0x9a, 0x59, 0x67, 0x01, 0xb7, 0x91, 0x3a, 0x5f, 0x96, 0xe4, 0xc5, 0x6d, 0xcb, 0xb7, 0x23, 0xaa,
-	0x32, 0x73, 0x3c, 0xdc, 0xbf, 0x94, 0xdc, 0x97, 0x88, 0x23, 0xaa, 0x9a, 0x55, 0x71, 0x3c, 0x8b,
-	0x3b, 0x2c, 0xa4, 0x3d, 0x5b, 0x61, 0xac, 0x52, 0xb5, 0x8b, 0x56, 0xcd, 0x29, 0x5a, 0x9e, 0xc7,
-	0xb8, 0xdc, 0xf4, 0x71, 0xf7, 0x0c, 0xee, 0xca, 0xaf, 0xed, 0xe0, 0x5e, 0xd1, 0xf2, 0xf6, 0xc3,
-	0x2d, 0xa5, 0xc4, 0x54, 0xa6, 0xaa, 0x0f, 0xb5, 0x45, 0x57, 0x61, 0xf2, 0x2d, 0xa1, 0x75, 0x8b,
-	0xb1, 0xaa, 0x61, 0x3f, 0x08, 0x6c, 0x9f, 0x93, 0xcb, 0xf0, 0x82, 0xb0, 0xcd, 0x74, 0x76, 0xb2,
-	0xda, 0x79, 0xed, 0xe2, 0xf0, 0x0a, 0x39, 0x68, 0x14, 0xc6, 0xf7, 0x2d, 0xb7, 0xba, 0x44, 0x71,
-	0x83, 0x1a, 0x23, 0xe2, 0x5f, 0x69, 0x67, 0x29, 0x93, 0xd5, 0xe8, 0x2d, 0x38, 0x91, 0x10, 0xe2,
-	0xd7, 0x98, 0xe7, 0xdb, 0xe4, 0x2a, 0x0c, 0x0b, 0x12, 0x29, 0xe2, 0xd8, 0x95, 0x29, 0x5d, 0xc1,
-	0xd3, 0x43, 0x78, 0xfa, 0xb2, 0xb7, 0xbf, 0x32, 0xf6, 0xe7, 0xdf, 0xcd, 0x1d, 0x15, 0x5c, 0x25,
-	0x43, 0x12, 0x4b, 0x69, 0xdf, 0x4a, 0x48, 0xf3, 0x43, 0x4c, 0xeb, 0x00, 0xb1, 0x3f, 0xb2, 0x19,
-	0x29, 0xf3, 0x82, 0x8e, 0xa6, 0x08, 0xe7, 0xe9, 0x2a, 0xdc, 0xe8, 0x3c, 0x7d, 0xcb, 0xaa, 0xd8,
-	0xc8, 0x6b, 0x24, 0x38, 0xe9, 0x8f, 0x34, 0x20, 0x49, 0xe9, 0x08, 0xf6, 0x35, 0x38, 0x2a, 0xf4,
-	0xfb, 0x59, 0xed, 0xfc, 0x91, 0x5e, 0xd0, 0x2a, 0x6a, 0x72, 0xa3, 0x03, 0xaa, 0x99, 0xae, 0xa8,
-	0x94, 0xce, 0x26, 0x58, 0x39, 0x98, 0x92, 0xa8, 0xee, 0x04, 0x6e, 0xd2, 0x6c, 0xe9, 0x8f, 0x3b,
-	0x70, 0xb2, 0x65, 0x0f, 0x41, 0x2f, 0xc0, 0x98, 0x17, 0xb8, 0x66, 0x08, 0x5c, 0x44, 0x6a, 0xea,
-	0xa0, 0x51, 0x98, 0x54, 0x91, 0x8a, 0xb6, 0xa8, 0x31, 0xea, 0x21, 0xab, 0x94, 0xb7, 0x8a, 0xba,
-	0xc4, 0xca, 0xdb, 0xfb, 0x35, 0x7b, 0x90, 0xb0, 0xd3, 0x0d, 0x04, 0x15, 0x0b, 0x89, 0x41, 0x49,
-	0x62, 0xbe, 0x5f, 0xb3, 0xa5, 0x9c, 0xb1, 0x24, 0xa8, 0x68, 0x8b, 0x1a, 0xa3, 0x35, 0x64, 0xa5,
-	0xbf, 0xd7, 0x20, 0x2f, 0x85, 0xad, 0x5a, 0xd5, 0xf2, 0x06, 0x73, 0x3c, 0x21, 0xf4, 0xee, 0xae,
-	0x55, 0xb7, 0xfd, 0x41, 0xb0, 0x91, 0x5d, 0x18, 0xe3, 0xec, 0xbe, 0xed, 0xf9, 0xa6, 0x23, 0x82,
-	0x22, 0x02, 0x7a, 0xa6, 0x29, 0x28, 0x61, 0x38, 0x56, 0x99, 0xe3, 0xad, 0xcc, 0x3f, 0x6e, 0x14,
-	0x86, 0x7e, 0xf3, 0x8f, 0xc2, 0xc5, 0x8a, 0xc3, 0x77, 0x83, 0x6d, 0xbd, 0xcc, 0x5c, 0x3c, 0x22,
-	0xf8, 0x33, 0xe7, 0xef, 0xdc, 0x2f, 0x0a, 0xcc, 0xbe, 0x64, 0xf0, 0x8d, 0x51, 0x25, 0xbd, 0xe4,
-	0xd1, 0x0f, 0x32, 0x50, 0x38, 0x14, 0x39, 0x3a, 0xc4, 0x87, 0x49, 0x5f, 0xac, 0x98, 0x2c, 0xe0,
-	0xa6, 0xe5, 0xb2, 0xc0, 0xe3, 0xe8, 0x97, 0x92, 0xd0, 0xfc, 0xf7, 0x46, 0xe1, 0x42, 0x0f, 0x9a,
-	0x4b, 0x1e, 0x3f, 0x68, 0x14, 0x4e, 0x2b, 0x8b, 0x5b, 0xe5, 0x51, 0x63, 0x5c, 0x2e, 0x6d, 0x06,
-	0x7c, 0x59, 0x2e, 0x90, 0x77, 0x00, 0xd0, 0x05, 0x2c, 0xe0, 0xcf, 0xc3, 0x07, 0xe8, 0xe1, 0xcd,
-	0x80, 0xd3, 0x9f, 0x69, 0x30, 0x13, 0x39, 0x61, 0x6d, 0xcf, 0xe1, 0xc2, 0x09, 0x92, 0x6a, 0xbd,
-	0xce, 0xdc, 0xe6, 0x38, 0x9e, 0x6e, 0x89, 0x63, 0x14, 0xb3, 0xaf, 0xc1, 0x84, 0xb2, 0xca, 0xf1,
-	0x42, 0x27, 0x65, 0xa4, 0x93, 0xf4, 0xfe, 0x9c, 0x64, 0x1c, 0x97, 0x62, 0x4a, 0x9e, 0x72, 0x04,
-	0xfd, 0x48, 0x83, 0x8b, 0xdd, 0xc1, 0x61, 0xa8, 0x9a, 0xbd, 0xa6, 0x3d, 0x57, 0xaf, 0xad, 0xc1,
-	0xa9, 0xe8, 0x00, 0x6d, 0x59, 0x75, 0xcb, 0x1d, 0x28, 0xd7, 0xe9, 0x0d, 0x38, 0xdd, 0x26, 0x06,
-	0xad, 0x99, 0x85, 0x91, 0x9a, 0x5c, 0x49, 0x2b, 0xc1, 0x06, 0xd2, 0xd0, 0xb7, 0xf0, 0x0c, 0xbe,
-	0xcd, 0xb8, 0x55, 0x15, 0xd2, 0x6e, 0x39, 0x0f, 0x02, 0x67, 0xc7, 0xe1, 0xfb, 0x03, 0x5f, 0x0b,
-	0x1f, 0x6b, 0x78, 0x3a, 0x3a, 0xc9, 0x44, 0x90, 0x8f, 0x60, 0xac, 0x1a, 0x2e, 0x76, 0xf7, 0xf8,
-	0x9b, 0xc2, 0xe3, 0x71, 0x35, 0x89, 0x38, 0x69, 0x7f, 0x51, 0x88, 0xf8, 0x24, 0xcc, 0x75, 0x74,
-	0xa1, 0x44, 0x39, 0x78, 0xd9, 0xa1, 0x01, 0x64, 0xdb, 0xe5, 0xa0, 0x99, 0xdf, 0x80, 0x17, 0xb9,
-	0x58, 0x36, 0x65, 0x76, 0x86, 0x11, 0x49, 0xb1, 0x74, 0x1a, 0x2d, 0x7d, 0x49, 0x29, 0x4b, 0x32,
-	0x53, 0xe3, 0x18, 0x8f, 0x55, 0xd0, 0x3f, 0x68, 0xf0, 0x72, 0x5b, 0x0d, 0xba, 0xc3, 0xee, 0x3e,
-	0xb4, 0x6a, 0xff, 0x17, 0x35, 0xf4, 0x13, 0x0d, 0x5e, 0xe9, 0x82, 0x1f, 0x9d, 0xf8, 0x7e, 0x7f,
-	0xc7, 0x73, 0x0d, 0x5d, 0x78, 0x22, 0x74, 0x61, 0xc8, 0x4a, 0x07, 0x3c, 0xb3, 0xe4, 0x36, 0x80,
-	0x0a, 0x01, 0x56, 0xd5, 0x41, 0xea, 0xd3, 0x98, 0x92, 0x20, 0x4a, 0xc0, 0x7f, 0x34, 0xbc, 0x44,
-	0xef, 0xd6, 0x18, 0xdf, 0xaa, 0x3b, 0xe5, 0x81, 0xae, 0x62, 0xb2, 0x06, 0x93, 0xc2, 0x78, 0xd3,
-	0xf2, 0x7d, 0x9b, 0x9b, 0x3b, 0xb6, 0xc7, 0x5c, 0xc4, 0x36, 0x1d, 0x5f, 0x19, 0xad, 0x14, 0xd4,
-	0x18, 0x17, 0x4b, 0xcb, 0x62, 0xe5, 0x4d, 0xb1, 0x40, 0x6e, 0xc2, 0x89, 0x07, 0x01, 0xe3, 0xcd,
-	0x72, 0x8e, 0x48, 0x39, 0x67, 0x0f, 0x1a, 0x85, 0xac, 0x92, 0xd3, 0x46, 0x42, 0x8d, 0x09, 0xb9,
-	0x16, 0x4b, 0x12, 0x87, 0x6a, 0x63, 0x78, 0x74, 0x78, 0xf2, 0xa8, 0x71, 0xec, 0xa1, 0xc3, 0x77,
-	0x45, 0x24, 0xd7, 0x6d, 0x9b, 0xfe, 0x51, 0x83, 0xe9, 0xb8, 0xf5, 0xfa, 0xba, 0xc3, 0x77, 0xd7,
-	0x9d, 0x2a, 0xb7, 0xeb, 0xa1, 0xd1, 0xd7, 0xe0, 0xb8, 0xeb, 0x78, 0x66, 0xb2, 0x1c, 0x08, 0xe5,
-	0xd9, 0x83, 0x46, 0x61, 0x4a, 0x29, 0x6f, 0xda, 0xa6, 0xc6, 0x8b, 0xae, 0xe3, 0x45, 0x15, 0x85,
-	0x4c, 0x27, 0x1b, 0x0f, 0x69, 0x7f, 0xdc, 0x62, 0xb4, 0xb4, 0x8f, 0x47, 0x06, 0x6e, 0x1f, 0x7f,
-	0xa1, 0xc1, 0xd9, 0xce, 0x36, 0x7c, 0x46, 0x1a, 0x49, 0x03, 0xaf, 0x95, 0x44, 0x4a, 0x21, 0xb2,
-	0x45, 0x00, 0xbf, 0xc6, 0xb8, 0x59, 0x13, 0xab, 0xe8, 0xdb, 0x93, 0xf1, 0xf1, 0x88, 0xf7, 0xa8,
-	0x31, 0xe6, 0x87, 0xdc, 0xb2, 0x40, 0x7e, 0x3f, 0x03, 0xe7, 0x94, 0xd0, 0x87, 0x56, 0x6d, 0x6d,
-	0xcf, 0x2a, 0x63, 0x97, 0x51, 0xf2, 0xc2, 0xd0, 0xbd, 0x0a, 0x23, 0xbe, 0xed, 0xed, 0xd8, 0x75,
-	0x94, 0x7b, 0xe2, 0xa0, 0x51, 0x38, 0x8e, 0x72, 0xe5, 0x3a, 0x35, 0x90, 0x20, 0x99, 0xda, 0x99,
-	0xae, 0xa9, 0xad, 0x83, 0xaa, 0x13, 0xa2, 0x08, 0xa9, 0x54, 0x7c, 0xe9, 0xa0, 0x51, 0x98, 0x48,
-	0x1c, 0x68, 0xd3, 0xf1, 0xa8, 0xf1, 0x82, 0xfc, 0x5b, 0xf2, 0xc8, 0xb7, 0x61, 0x44, 0x3e, 0xbe,
-	0xfc, 0xec, 0xb0, 0x74, 0xbf, 0xae, 0x87, 0xef, 0xbe, 0xc4, 0x63, 0x2d, 0x72, 0xa2, 0x30, 0x27,
-	0xb2, 0x44, 0xb0, 0xad, 0x9c, 0xc4, 0x92, 0x81, 0xd8, 0x95, 0x2c, 0x6a, 0xa0, 0x50, 0xe9, 0x8c,
-	0x9f, 0x86, 0xcd, 0x6a, 0x07, 0x67, 0xc4, 0x1d, 0x9f, 0xc2, 0xf6, 0xbf, 0xeb, 0xf8, 0x5a, 0xe5,
-	0x51, 0x63, 0x5c, 0x2e, 0x45, 0x1d, 0x9f, 0xc4, 0xf6, 0x61, 0xa6, 0x33, 0xb6, 0xcd, 0x80, 0x3f,
-	0xef, 0x48, 0x7d, 0x27, 0xf2, 0xfc, 0x11, 0xe9, 0xf9, 0x62, 0x8f, 0x9e, 0x17, 0xd0, 0x7a, 0x70,
-	0xbd, 0x78, 0x56, 0x44, 0x3e, 0xc8, 0x0e, 0xb7, 0x3e, 0x2b, 0xa2, 0x2d, 0x8a, 0x17, 0xcb, 0x66,
-	0xa0, 0x3c, 0xf2, 0x93, 0xb0, 0x05, 0xe9, 0xe4, 0x11, 0x0c, 0x57, 0x0d, 0x26, 0xc2, 0x54, 0x6a,
-	0x8e, 0xd6, 0xcd, 0xbe, 0xa3, 0x75, 0xaa, 0x39, 0x33, 0xa3, 0x60, 0x1d, 0xc7, 0x04, 0x4d, 0xc4,
-	0xea, 0x2c, 0xe4, 0xe2, 0x6e, 0xa1, 0xb5, 0xd7, 0xa2, 0x3f, 0x0f, 0x6b, 0x65, 0xeb, 0xf6, 0x67,
-	0xa2, 0x6d, 0xa2, 0x15, 0xb8, 0xa4, 0xae, 0x6c, 0xe6, 0x95, 0x6d, 0x8f, 0xd7, 0x2d, 0x6e, 0xef,
-	0xc8, 0x7a, 0xb6, 0x73, 0xcb, 0xf1, 0xee, 0x8b, 0xce, 0x7a, 0x75, 0xfd, 0xf6, 0xed, 0x30, 0xe7,
-	0xbe, 0x0c, 0x2f, 0x96, 0xef, 0xb9, 0xea, 0x35, 0x1a, 0x5f, 0x69, 0xa7, 0xe3, 0xee, 0x26, 0xb9,
-	0x4b, 0x0d, 0x10, 0x9f, 0x4a, 0x1a, 0x35, 0xe1, 0x72, 0x4f, 0x8a, 0xd0, 0x2d, 0xf3, 0x30, 0x55,
-	0x4e, 0x50, 0x36, 0x6b, 0x34, 0x48, 0xb9, 0x4d, 0xca, 0x95, 0xdf, 0x9e, 0x82, 0xa3, 0x52, 0x03,
-	0x79, 0x1f, 0x64, 0x49, 0xf6, 0xc9, 0x8c, 0xde, 0x69, 0x58, 0xa4, 0xb7, 0xcd, 0x24, 0x72, 0x17,
-	0xbb, 0x13, 0x2a, 0x5c, 0xf4, 0xf3, 0x1f, 0xfc, 0xe5, 0x5f, 0x3f, 0xcc, 0x9c, 0x23, 0xd3, 0xc5,
-	0x8e, 0xb3, 0x25, 0x75, 0x07, 0x7c, 0xa8, 0xc1, 0x68, 0xf8, 0xc6, 0x27, 0x97, 0x52, 0x64, 0xb7,
-	0x0c, 0x09, 0x72, 0x97, 0x7b, 0xa2, 0x45, 0x28, 0x97, 0x24, 0x94, 0xcf, 0x91, 0x42, 0x67, 0x28,
-	0xd1, 0xd4, 0xe0, 0xbb, 0x19, 0x8d, 0x7c, 0xac, 0xc1, 0x78, 0x73, 0x02, 0x92, 0xf9, 0x14, 0x5d,
-	0x1d, 0x53, 0x39, 0xb7, 0xd0, 0x07, 0x07, 0x62, 0x9c, 0x93, 0x18, 0x67, 0xc8, 0x2b, 0x9d, 0x31,
-	0xaa, 0x66, 0x38, 0xca, 0x46, 0xf2, 0x2b, 0x0d, 0x26, 0x5a, 0xee, 0x63, 0xb2, 0xd0, 0x2d, 0x36,
-	0x6d, 0xfd, 0x47, 0xee, 0x4a, 0x3f, 0x2c, 0x88, 0x74, 0x56, 0x22, 0xbd, 0x40, 0x5e, 0xee, 0x8c,
-	0xf4, 0x9e, 0xa4, 0xc6, 0x44, 0xf4, 0xc9, 0xf7, 0x34, 0x18, 0x16, 0x92, 0xc8, 0x85, 0x2e, 0xaa,
-	0x42, 0x48, 0x33, 0x5d, 0xe9, 0x10, 0xc7, 0x7c, 0xba, 0xc7, 0xa4, 0xfa, 0xe2, 0x7b, 0x78, 0x1c,
-	0x1e, 0x89, 0xd8, 0x7e, 0xa4, 0xc1, 0x68, 0x38, 0xbc, 0x49, 0xcd, 0xb6, 0x96, 0x31, 0x51, 0x6a,
-	0xb6, 0xb5, 0x4e, 0x83, 0xe8, 0x82, 0xc4, 0x75, 0x99, 0xbc, 0x7a, 0x38, 0x2e, 0xd9, 0xb0, 0xc5,
-	0xd8, 0xc8, 0x8f, 0x35, 0xc8, 0x1e, 0xf6, 0x14, 0x20, 0x4b, 0x29, 0xca, 0xbb, 0xbc, 0x7f, 0x72,
-	0x5f, 0x19, 0x88, 0x17, 0x0d, 0x19, 0x22, 0x7f, 0xd2, 0x80, 0xb4, 0x8f, 0x79, 0xc8, 0x62, 0x8f,
-	0x52, 0x9b, 0xb1, 0xbc, 0xd6, 0x27, 0x17, 0xa2, 0xb8, 0x2e, 0xdd, 0xb9, 0x44, 0xbe, 0xd4, 0x53,
-	0x98, 0x8b, 0xef, 0x30, 0xc7, 0x33, 0xe5, 0x48, 0xda, 0x16, 0x57, 0x9f, 0xe9, 0x78, 0xe4, 0xdf,
-	0x1a, 0x4c, 0xa7, 0x8c, 0x42, 0xc8, 0xb5, 0x2e, 0xc0, 0xd2, 0xe7, 0x3b, 0xb9, 0xd7, 0x07, 0x65,
-	0x47, 0x03, 0x6f, 0x48, 0x03, 0x97, 0xc9, 0x1b, 0xbd, 0x19, 0x68, 0xef, 0x39, 0x5c, 0x19, 0xa8,
-	0x86, 0x47, 0xea, 0xbe, 0x15, 0x76, 0xfe, 0x52, 0x03, 0x88, 0x67, 0x22, 0x64, 0xb6, 0x4b, 0xd2,
-	0x36, 0x4d, 0x60, 0x72, 0x73, 0x3d, 0x52, 0x23, 0xe8, 0x45, 0x09, 0x5a, 0x27, 0xb3, 0xbd, 0x81,
-	0x56, 0x03, 0x17, 0xf2, 0x58, 0x03, 0xd2, 0x3e, 0x18, 0x49, 0xcd, 0xa7, 0x43, 0x67, 0x33, 0xa9,
-	0xf9, 0x74, 0xf8, 0xf4, 0x85, 0xae, 0x49, 0xe4, 0x5f, 0x25, 0x4b, 0xbd, 0x21, 0x57, 0x85, 0x57,
-	0x7e, 0x46, 0xd5, 0x57, 0xd4, 0x92, 0x5f, 0x6b, 0x70, 0x2c, 0x31, 0xf5, 0x20, 0x73, 0xdd, 0xd0,
-	0x34, 0x27, 0x8d, 0xde, 0x2b, 0x39, 0xa2, 0x5e, 0x92, 0xa8, 0x17, 0xc9, 0x95, 0x7e, 0x50, 0xab,
-	0x67, 0xb7, 0xc8, 0x8b, 0xb1, 0xe8, 0x6d, 0x44, 0xd2, 0x6a, 0x59, 0xeb, 0xa3, 0x3c, 0x37, 0xdb,
-	0x1b, 0x31, 0x82, 0xfc, 0x62, 0x9f, 0x49, 0x21, 0x98, 0xe5, 0xa5, 0xfb, 0x44, 0x83, 0x33, 0x6b,
-	0x3e, 0x77, 0x5c, 0x8b, 0xdb, 0x6d, 0x6f, 0x0c, 0x72, 0x35, 0x0d, 0xc4, 0x21, 0xcf, 0xb3, 0xdc,
-	0x62, 0x7f, 0x4c, 0x68, 0xc1, 0x4d, 0x69, 0xc1, 0x1b, 0xe4, 0x5a, 0x67, 0x0b, 0x12, 0xa7, 0x10,
-	0xd1, 0x16, 0x13, 0xa5, 0x26, 0x3a, 0x89, 0xc2, 0xa4, 0xbf, 0x6a, 0x90, 0x3b, 0xc4, 0xa4, 0xcd,
-	0x80, 0x93, 0x3e, 0xe0, 0xc5, 0x2f, 0x99, 0xd4, 0x94, 0x3f, 0xbc, 0xdb, 0xa7, 0x25, 0x69, 0xd5,
-	0x75, 0xf2, 0xfa, 0xa7, 0xb0, 0x8a, 0x05, 0x5c, 0x98, 0xf5, 0x89, 0x06, 0xf9, 0xf4, 0xc6, 0x94,
-	0x5c, 0x4f, 0xab, 0x87, 0xbd, 0x34, 0xcf, 0xb9, 0xe5, 0x4f, 0x21, 0x01, 0x4d, 0xde, 0x92, 0x26,
-	0x6f, 0x90, 0x9b, 0x9d, 0x4d, 0xee, 0xd4, 0x31, 0x9b, 0x55, 0xc7, 0xbb, 0x6f, 0xde, 0xab, 0x33,
-	0xd7, 0x14, 0xdd, 0x78, 0xf1, 0xbd, 0x64, 0x8b, 0xfe, 0x68, 0x65, 0xe3, 0xf1, 0xd3, 0xbc, 0xf6,
-	0xe4, 0x69, 0x5e, 0xfb, 0xe7, 0xd3, 0xbc, 0xf6, 0x83, 0x67, 0xf9, 0xa1, 0x27, 0xcf, 0xf2, 0x43,
-	0x7f, 0x7b, 0x96, 0x1f, 0xfa, 0xe6, 0x7c, 0xe2, 0x39, 0x81, 0xda, 0xe6, 0xaa, 0xd6, 0xb6, 0x1f,
-	0xa9, 0x7e, 0x77, 0xe1, 0x0b, 0xc5, 0x3d, 0x05, 0x40, 0x3e, 0x2e, 0xb6, 0x47, 0xe4, 0x68, 0xe4,
-	0xea, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x02, 0xa0, 0xcd, 0xc5, 0x1d, 0x00, 0x00,
+	// 2384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x4e, 0x8f, 0x7f, 0x62, 0x3f, 0xc7, 0x3f, 0xa9, 0x75, 0xec, 0x71, 0xdb, 0x99, 0x09, 0xc5,
+	0xae, 0x9d, 0x8d, 0xed, 0x19, 0x3b, 0x71, 0x58, 0xd6, 0x24, 0xbb, 0xf1, 0x6f, 0x62, 0x2b, 0x3f,
+	0xde, 0xce, 0x6a, 0x57, 0x2c, 0x3f, 0xad, 0xf6, 0x4c, 0x67, 0xdc, 0xeb, 0x99, 0xee, 0xf1, 0x74,
+	0x77, 0x6c, 0x2b, 0x8a, 0x16, 0xed, 0x09, 0xb8, 0x2c, 0x12, 0xb0, 0x2c, 0x08, 0xc1, 0x65, 0x85,
+	0x10, 0x67, 0x24, 0x2e, 0x70, 0x40, 0x5c, 0x22, 0x4e, 0x91, 0x00, 0x09, 0x71, 0x98, 0xa0, 0x04,
+	0x6e, 0x9c, 0x7c, 0xd9, 0x23, 0xa8, 0xaa, 0x5e, 0xff, 0x4c, 0xcf, 0x78, 0xfe, 0xb2, 0x91, 0x96,
+	0x93, 0xdd, 0x55, 0xaf, 0xde, 0xfb, 0xbe, 0xf7, 0xaa, 0x5e, 0xbd, 0x7a, 0x03, 0xe7, 0x2c, 0xbb,
+	0x60, 0xd9, 0x86, 0x9d, 0xce, 0x69, 0x85, 0x42, 0xfa, 0xfe, 0xfc, 0xb6, 0xee, 0x68, 0xf3, 0xe9,
+	0x3d, 0x57, 0x2f, 0x1d, 0xa6, 0x8a, 0x25, 0xcb, 0xb1, 0xc8, 0x30, 0x4a, 0xa4, 0x98, 0x44, 0x0a,
+	0x25, 0xe4, 0xe1, 0x9c, 0x95, 0xb3, 0xb8, 0x40, 0x9a, 0xfd, 0x27, 0x64, 0xe5, 0xb3, 0x35, 0xb5,
+	0x39, 0x07, 0x38, 0x3d, 0xe3, 0x4d, 0x17, 0x2d, 0x2b, 0x5f, 0xd0, 0x4c, 0x2d, 0xa7, 0x97, 0x7c,
+	0x29, 0x7b, 0x5f, 0x2b, 0xaa, 0x25, 0xcb, 0x75, 0x74, 0x94, 0x4e, 0x64, 0xb8, 0x78, 0x7a, 0x5b,
+	0xb3, 0x75, 0x5f, 0x2a, 0x63, 0x19, 0x26, 0xce, 0x5f, 0x08, 0xcf, 0x73, 0xc4, 0xbe, 0x54, 0x51,
+	0xcb, 0x19, 0xa6, 0xe6, 0x18, 0x96, 0x27, 0x3b, 0x91, 0xb3, 0xac, 0x5c, 0x5e, 0x4f, 0x6b, 0x45,
+	0x23, 0xad, 0x99, 0xa6, 0xe5, 0xf0, 0x49, 0x1b, 0x67, 0xc7, 0x70, 0x96, 0x7f, 0x6d, 0xbb, 0xf7,
+	0xd2, 0x9a, 0x79, 0xe8, 0x81, 0x88, 0x4e, 0x65, 0xdd, 0x52, 0x58, 0xf1, 0x98, 0x00, 0xa1, 0x0a,
+	0x57, 0x88, 0x0f, 0x31, 0x45, 0x57, 0x60, 0xe8, 0x2d, 0x86, 0x6a, 0xcb, 0xb2, 0xf2, 0x8a, 0xbe,
+	0xe7, 0xea, 0xb6, 0x43, 0xa6, 0xe1, 0x24, 0xe3, 0xae, 0x1a, 0xd9, 0xb8, 0x74, 0x4e, 0x3a, 0xdf,
+	0xb9, 0x4c, 0x8e, 0xca, 0xc9, 0x81, 0x43, 0xad, 0x90, 0x5f, 0xa4, 0x38, 0x41, 0x95, 0x6e, 0xf6,
+	0xdf, 0x46, 0x76, 0x31, 0x16, 0x97, 0xe8, 0x4d, 0x38, 0x1d, 0x52, 0x62, 0x17, 0x2d, 0xd3, 0xd6,
+	0xc9, 0x25, 0xe8, 0x64, 0x22, 0x5c, 0x45, 0xdf, 0xc5, 0xe1, 0x94, 0xc0, 0x98, 0xf2, 0x30, 0xa6,
+	0x96, 0xcc, 0xc3, 0xe5, 0xde, 0x3f, 0xff, 0x76, 0xb6, 0x8b, 0xad, 0xda, 0x50, 0xb8, 0x30, 0xd7,
+	0xf6, 0x8d, 0x90, 0x36, 0xdb, 0xc3, 0xb4, 0x0e, 0x10, 0xf8, 0x2b, 0x1e, 0xe3, 0x3a, 0x27, 0x53,
+	0x48, 0x85, 0x39, 0x37, 0x25, 0xb6, 0x03, 0x3a, 0x37, 0xb5, 0xa5, 0xe5, 0x74, 0x5c, 0xab, 0x84,
+	0x56, 0xd2, 0x1f, 0x49, 0x40, 0xc2, 0xda, 0x11, 0xec, 0x65, 0xe8, 0x62, 0xf6, 0xed, 0xb8, 0x74,
+	0xae, 0xa3, 0x19, 0xb4, 0x42, 0x9a, 0x5c, 0xaf, 0x81, 0x6a, 0xaa, 0x21, 0x2a, 0x61, 0xb3, 0x02,
+	0x96, 0x0c, 0xc3, 0x1c, 0xd5, 0x6d, 0xb7, 0x10, 0xa6, 0xcd, 0xfd, 0x71, 0x1b, 0xce, 0x44, 0xe6,
+	0x10, 0xf4, 0x3c, 0xf4, 0x9a, 0x6e, 0x41, 0xf5, 0x80, 0xb3, 0x48, 0x0d, 0x1f, 0x95, 0x93, 0x43,
+	0x22, 0x52, 0xfe, 0x14, 0x55, 0x7a, 0x4c, 0x5c, 0xca, 0xf5, 0xad, 0xa0, 0x2d, 0x36, 0xf2, 0xf6,
+	0x61, 0x51, 0x6f, 0x27, 0xec, 0x74, 0x13, 0xce, 0x44, 0x94, 0x04, 0xa0, 0xb8, 0xb0, 0x73, 0x58,
+	0xd4, 0xb9, 0x9e, 0xde, 0x30, 0x28, 0x7f, 0x8a, 0x2a, 0x3d, 0x45, 0x5c, 0x4a, 0x7f, 0x27, 0x41,
+	0x82, 0x2b, 0x5b, 0xd1, 0xf2, 0x99, 0x4d, 0xcb, 0x30, 0x99, 0xd2, 0xbb, 0x3b, 0x5a, 0x49, 0xb7,
+	0xdb, 0xc1, 0x46, 0x76, 0xa0, 0xd7, 0xb1, 0x76, 0x75, 0xd3, 0x56, 0x0d, 0x16, 0x14, 0x16, 0xd0,
+	0xb1, 0x8a, 0xa0, 0x78, 0xe1, 0x58, 0xb1, 0x0c, 0x73, 0x79, 0xee, 0x51, 0x39, 0x79, 0xe2, 0x37,
+	0x4f, 0x92, 0xe7, 0x73, 0x86, 0xb3, 0xe3, 0x6e, 0xa7, 0x32, 0x56, 0x01, 0x8f, 0x08, 0xfe, 0x99,
+	0xb5, 0xb3, 0xbb, 0x69, 0x86, 0xd9, 0xe6, 0x0b, 0x6c, 0xa5, 0x47, 0x68, 0xdf, 0x30, 0xe9, 0x87,
+	0x31, 0x48, 0x1e, 0x8b, 0x1c, 0x1d, 0x62, 0xc3, 0x90, 0xcd, 0x46, 0x54, 0xcb, 0x75, 0x54, 0xad,
+	0x60, 0xb9, 0xa6, 0x83, 0x7e, 0xd9, 0x60, 0x96, 0xff, 0x51, 0x4e, 0x4e, 0x36, 0x61, 0x79, 0xc3,
+	0x74, 0x8e, 0xca, 0xc9, 0x51, 0xc1, 0x38, 0xaa, 0x8f, 0x2a, 0x03, 0x7c, 0xe8, 0x8e, 0xeb, 0x2c,
+	0xf1, 0x01, 0xf2, 0x3e, 0x00, 0xba, 0xc0, 0x72, 0x9d, 0x17, 0xe1, 0x03, 0xf4, 0xf0, 0x1d, 0xd7,
+	0xa1, 0x3f, 0x93, 0x60, 0xca, 0x77, 0xc2, 0xda, 0x81, 0xe1, 0x30, 0x27, 0x70, 0xa9, 0xf5, 0x92,
+	0x55, 0xa8, 0x8c, 0xe3, 0x68, 0x24, 0x8e, 0x7e, 0xcc, 0xde, 0x81, 0x41, 0xc1, 0xca, 0x30, 0x3d,
+	0x27, 0xc5, 0xb8, 0x93, 0x52, 0xad, 0x39, 0x49, 0xe9, 0xe7, 0x6a, 0x36, 0x4c, 0xe1, 0x08, 0xfa,
+	0xb1, 0x04, 0xe7, 0x1b, 0x83, 0xc3, 0x50, 0x55, 0x7a, 0x4d, 0x7a, 0xa1, 0x5e, 0x5b, 0x83, 0x11,
+	0xff, 0x00, 0x6d, 0x69, 0x25, 0xad, 0xd0, 0xd6, 0x5e, 0xa7, 0xd7, 0x61, 0xb4, 0x4a, 0x0d, 0xb2,
+	0x99, 0x81, 0xee, 0x22, 0x1f, 0xa9, 0x97, 0x82, 0x15, 0x94, 0xa1, 0x6f, 0xe1, 0x19, 0x7c, 0xdb,
+	0x72, 0xb4, 0x3c, 0xd3, 0x76, 0xd3, 0xd8, 0x73, 0x8d, 0xac, 0xe1, 0x1c, 0xb6, 0x7d, 0x2d, 0x7c,
+	0x2a, 0x41, 0xf2, 0x58, 0x9d, 0x08, 0xf2, 0x21, 0xf4, 0xe6, 0xbd, 0xc1, 0xc6, 0x1e, 0x5f, 0x65,
+	0x1e, 0x0f, 0xb2, 0x89, 0xbf, 0x92, 0xb6, 0x16, 0x05, 0x7f, 0x1d, 0x87, 0xb9, 0x0e, 0xa3, 0x01,
+	0xca, 0xf6, 0xd3, 0x0e, 0x75, 0x21, 0x5e, 0xad, 0x07, 0x69, 0x7e, 0x1d, 0x4e, 0x39, 0x6c, 0x58,
+	0xe5, 0xbb, 0xd3, 0x8b, 0x48, 0x1d, 0xa6, 0xe3, 0xc8, 0xf4, 0x25, 0x61, 0x2c, 0xbc, 0x98, 0x2a,
+	0x7d, 0x4e, 0x60, 0x82, 0xfe, 0x41, 0x82, 0x97, 0xab, 0x72, 0xd0, 0x6d, 0xeb, 0xee, 0xbe, 0x56,
+	0xfc, 0xbf, 0xc8, 0xa1, 0x9f, 0x49, 0xf0, 0x4a, 0x03, 0xfc, 0xe8, 0xc4, 0x0f, 0x5a, 0x3b, 0x9e,
+	0x6b, 0xe8, 0xc2, 0xd3, 0x9e, 0x0b, 0xbd, 0xa5, 0xb4, 0xcd, 0x33, 0x4b, 0x6e, 0x01, 0x88, 0x10,
+	0x60, 0x56, 0x6d, 0x27, 0x3f, 0xf5, 0x0a, 0x0d, 0x2c, 0x05, 0xfc, 0x47, 0xc2, 0x4b, 0xf4, 0x6e,
+	0xd1, 0x72, 0xb6, 0x4a, 0x46, 0xa6, 0xad, 0xab, 0x98, 0xac, 0xc1, 0x10, 0x23, 0xaf, 0x6a, 0xb6,
+	0xad, 0x3b, 0x6a, 0x56, 0x37, 0xad, 0x02, 0x62, 0x1b, 0x0f, 0xae, 0x8c, 0xa8, 0x04, 0x55, 0x06,
+	0xd8, 0xd0, 0x12, 0x1b, 0x59, 0x65, 0x03, 0xe4, 0x06, 0x9c, 0xde, 0x73, 0x2d, 0xa7, 0x52, 0x4f,
+	0x07, 0xd7, 0x33, 0x71, 0x54, 0x4e, 0xc6, 0x85, 0x9e, 0x2a, 0x11, 0xaa, 0x0c, 0xf2, 0xb1, 0x40,
+	0x13, 0x3b, 0x54, 0x9b, 0x9d, 0x3d, 0x9d, 0x43, 0x5d, 0x4a, 0xdf, 0xbe, 0xe1, 0xec, 0xb0, 0x48,
+	0xae, 0xeb, 0x3a, 0xfd, 0xa3, 0x04, 0xe3, 0x41, 0xe9, 0xf5, 0xae, 0xe1, 0xec, 0xac, 0x1b, 0x79,
+	0x47, 0x2f, 0x79, 0xa4, 0xaf, 0x42, 0x7f, 0xc1, 0x30, 0xd5, 0x70, 0x3a, 0x60, 0xc6, 0xe3, 0x47,
+	0xe5, 0xe4, 0xb0, 0x30, 0x5e, 0x31, 0x4d, 0x95, 0x53, 0x05, 0xc3, 0xf4, 0x33, 0x0a, 0x19, 0x0f,
+	0x17, 0x1e, 0x9c, 0x7f, 0x50, 0x62, 0x44, 0xca, 0xc7, 0x8e, 0xb6, 0xcb, 0xc7, 0x5f, 0x48, 0x30,
+	0x51, 0x9b, 0xc3, 0x17, 0xa4, 0x90, 0x54, 0x60, 0x24, 0xba, 0xa5, 0x10, 0xd9, 0x02, 0x80, 0x5d,
+	0xb4, 0x1c, 0xb5, 0xc8, 0x46, 0xd1, 0xb7, 0x67, 0x82, 0xe3, 0x11, 0xcc, 0x51, 0xa5, 0xd7, 0xf6,
+	0x56, 0xf3, 0x04, 0xf9, 0xfd, 0x18, 0x9c, 0x15, 0x4a, 0xf7, 0xb5, 0xe2, 0xda, 0x81, 0x96, 0xc1,
+	0x2a, 0x63, 0xc3, 0xf4, 0x42, 0xf7, 0x2a, 0x74, 0xdb, 0xba, 0x99, 0xd5, 0x4b, 0xa8, 0xf7, 0xf4,
+	0x51, 0x39, 0xd9, 0x8f, 0x7a, 0xf9, 0x38, 0x55, 0x50, 0x20, 0xbc, 0xb5, 0x63, 0x0d, 0xb7, 0x76,
+	0x0a, 0x44, 0x9e, 0x50, 0x0d, 0x11, 0xb4, 0xde, 0xe5, 0x97, 0x8e, 0xca, 0xc9, 0xc1, 0xd0, 0x81,
+	0x56, 0x0d, 0x93, 0x2a, 0x27, 0xf9, 0xbf, 0x1b, 0x26, 0xf9, 0x16, 0x74, 0xf3, 0xc7, 0x99, 0x1d,
+	0xef, 0xe4, 0xee, 0x4f, 0xa5, 0xbc, 0x77, 0x61, 0xe8, 0x31, 0xe7, 0x3b, 0x91, 0xd1, 0xf1, 0x99,
+	0xb0, 0x65, 0xcb, 0x67, 0x30, 0x65, 0x20, 0x76, 0xa1, 0x8b, 0x2a, 0xa8, 0x94, 0x3b, 0xe3, 0xa7,
+	0x5e, 0xb1, 0x5a, 0xc3, 0x19, 0x41, 0xc5, 0x27, 0xb0, 0x7d, 0x7e, 0x15, 0x5f, 0x54, 0x1f, 0x55,
+	0x06, 0xf8, 0x90, 0x5f, 0xf1, 0x71, 0x6c, 0x1f, 0xc5, 0x6a, 0x63, 0xbb, 0xe3, 0x3a, 0x2f, 0x3a,
+	0x52, 0xdf, 0xf6, 0x3d, 0xdf, 0xc1, 0x3d, 0x9f, 0x6e, 0xd2, 0xf3, 0x0c, 0x5a, 0x13, 0xae, 0x67,
+	0xcf, 0x0a, 0xdf, 0x07, 0xf1, 0xce, 0xe8, 0xb3, 0xc2, 0x9f, 0xa2, 0x78, 0xb1, 0xdc, 0x71, 0x85,
+	0x47, 0x7e, 0xe2, 0x95, 0x20, 0xb5, 0x3c, 0x82, 0xe1, 0x2a, 0xc2, 0xa0, 0xb7, 0x95, 0x2a, 0xa3,
+	0x75, 0xa3, 0xe5, 0x68, 0x8d, 0x54, 0xee, 0x4c, 0x3f, 0x58, 0xfd, 0xb8, 0x41, 0x43, 0xb1, 0x9a,
+	0x00, 0x39, 0xa8, 0x16, 0xa2, 0xb5, 0x16, 0xfd, 0xb9, 0x97, 0x2b, 0xa3, 0xd3, 0x5f, 0x88, 0xb2,
+	0x89, 0xe6, 0xe0, 0x82, 0xb8, 0xb2, 0x2d, 0x33, 0xa3, 0x9b, 0x4e, 0x49, 0x73, 0xf4, 0x2c, 0xcf,
+	0x67, 0xd9, 0x9b, 0x86, 0xb9, 0xcb, 0x2a, 0xeb, 0x95, 0xf5, 0x5b, 0xb7, 0xbc, 0x3d, 0xf7, 0x3a,
+	0x9c, 0xca, 0xdc, 0x2b, 0x14, 0x54, 0x6f, 0x37, 0x89, 0x2b, 0x6d, 0x34, 0xa8, 0x6e, 0xc2, 0xb3,
+	0x54, 0x01, 0xf6, 0x29, 0xb4, 0x51, 0x15, 0xa6, 0x9b, 0x32, 0x84, 0x6e, 0x99, 0x83, 0xe1, 0x4c,
+	0x48, 0xb2, 0xd2, 0xa2, 0x42, 0x32, 0x55, 0x5a, 0xe8, 0x27, 0x31, 0x2f, 0x61, 0xb2, 0x6b, 0xb9,
+	0xfd, 0x4b, 0xf8, 0x35, 0xe8, 0x13, 0x57, 0x63, 0xf8, 0xfe, 0x1d, 0x39, 0x2a, 0x27, 0x49, 0xf8,
+	0xde, 0xc4, 0x1b, 0x13, 0xf8, 0x97, 0xb8, 0x76, 0xaf, 0x40, 0x3f, 0xcf, 0xba, 0x3e, 0xd6, 0x0e,
+	0x6e, 0x2b, 0x74, 0xeb, 0x55, 0x4c, 0x53, 0xa5, 0x8f, 0x7f, 0x0b, 0xf8, 0xe4, 0x3d, 0xe8, 0x73,
+	0x58, 0x4b, 0x6a, 0xdf, 0x30, 0xb3, 0xd6, 0x3e, 0x3f, 0x18, 0x6c, 0x27, 0x44, 0x2f, 0x9d, 0x55,
+	0xec, 0x07, 0x2d, 0x27, 0x70, 0x27, 0x20, 0xaa, 0xd0, 0x5a, 0xfa, 0xc9, 0x93, 0xa4, 0xa4, 0x00,
+	0x1b, 0x79, 0x57, 0x0c, 0x7c, 0x47, 0x82, 0xd1, 0x2a, 0xd7, 0xa0, 0xa3, 0x75, 0xe8, 0x13, 0xcf,
+	0xb5, 0xf0, 0x6d, 0xb2, 0xda, 0xc2, 0x79, 0x59, 0xd5, 0x33, 0x01, 0x8c, 0x90, 0x2a, 0xaa, 0x80,
+	0xed, 0x9b, 0xa3, 0x7f, 0x8b, 0x61, 0x6d, 0xb8, 0x94, 0xc9, 0xb0, 0x93, 0xc3, 0x5f, 0x39, 0x96,
+	0x6d, 0x30, 0x2e, 0xf6, 0x3b, 0x5a, 0xde, 0xf5, 0x83, 0x35, 0x03, 0x27, 0xb5, 0x6c, 0xb6, 0xa4,
+	0xdb, 0x36, 0x82, 0x09, 0x05, 0x0b, 0x27, 0xa8, 0xe2, 0x89, 0xb4, 0x96, 0xda, 0x22, 0xa1, 0xed,
+	0x68, 0x3f, 0xb4, 0x9d, 0xcf, 0x11, 0xda, 0xae, 0xcf, 0x33, 0xb4, 0xff, 0xed, 0x82, 0xc9, 0x46,
+	0x7e, 0xc5, 0x48, 0xab, 0x30, 0xb0, 0xad, 0xe5, 0x35, 0x33, 0xa3, 0x37, 0xfd, 0x76, 0x39, 0x8b,
+	0x48, 0xce, 0x78, 0xa5, 0x67, 0x78, 0x39, 0x55, 0xfa, 0x71, 0x40, 0x54, 0xf7, 0x64, 0x17, 0xbc,
+	0x01, 0xf5, 0x3e, 0xb3, 0x8c, 0x67, 0x67, 0xbd, 0xe5, 0xcd, 0x34, 0x5c, 0x69, 0x8e, 0x2b, 0xa3,
+	0xca, 0x29, 0xfc, 0xe6, 0xac, 0xc8, 0x37, 0xa1, 0x3f, 0x6f, 0x65, 0x76, 0xf5, 0xac, 0x47, 0xa6,
+	0xa3, 0x11, 0x99, 0x09, 0x24, 0x83, 0xda, 0x2b, 0x56, 0x53, 0xe5, 0x94, 0xf8, 0x46, 0x2a, 0x3b,
+	0x80, 0xdf, 0xc8, 0x44, 0xdc, 0x53, 0x6b, 0x2d, 0x33, 0x79, 0xa9, 0xc2, 0x16, 0x12, 0xe9, 0x13,
+	0x9f, 0x82, 0x87, 0x0e, 0x43, 0xae, 0xc9, 0x06, 0x0c, 0x33, 0xe7, 0x51, 0xe9, 0x6a, 0x44, 0x25,
+	0x89, 0x54, 0xb0, 0xa6, 0x88, 0x2a, 0xa0, 0xca, 0xa0, 0x3f, 0x84, 0x84, 0xf6, 0x20, 0x18, 0x42,
+	0x4e, 0xdd, 0x2d, 0x5f, 0x8d, 0x82, 0xd3, 0x48, 0xd4, 0x28, 0xd2, 0x1a, 0xf0, 0x47, 0x3c, 0x66,
+	0xe2, 0x75, 0x8b, 0xe6, 0x4e, 0x3e, 0x5f, 0x66, 0x09, 0xa9, 0xa2, 0x0a, 0xf0, 0x2f, 0x6e, 0xe6,
+	0xe2, 0xef, 0xc7, 0xa0, 0x8b, 0x9f, 0x00, 0xf2, 0x01, 0xf0, 0x52, 0xdc, 0x26, 0x53, 0xa9, 0x5a,
+	0x3f, 0x22, 0xa4, 0xaa, 0x7a, 0xd1, 0xf2, 0xf9, 0xc6, 0x82, 0xe2, 0xf0, 0xd0, 0x2f, 0x7f, 0xf8,
+	0x97, 0x7f, 0xfd, 0x30, 0x76, 0x96, 0x8c, 0xa7, 0x6b, 0xfe, 0xe6, 0x20, 0x6a, 0xff, 0x8f, 0x24,
+	0xe8, 0xf1, 0x7a, 0xbb, 0xe4, 0x42, 0x1d, 0xdd, 0x91, 0xe6, 0xb0, 0x3c, 0xdd, 0x94, 0x2c, 0x42,
+	0xb9, 0xc0, 0xa1, 0x7c, 0x89, 0x24, 0x6b, 0x43, 0xf1, 0xbb, 0xc5, 0xdf, 0x8d, 0x49, 0xe4, 0x53,
+	0x09, 0x06, 0x2a, 0x0b, 0x0f, 0x32, 0x57, 0xc7, 0x56, 0xcd, 0x12, 0x46, 0x9e, 0x6f, 0x61, 0x05,
+	0x62, 0x9c, 0xe5, 0x18, 0xa7, 0xc8, 0x2b, 0xb5, 0x31, 0x8a, 0x60, 0xfa, 0x55, 0x08, 0xf9, 0x95,
+	0x04, 0x83, 0x91, 0x77, 0x18, 0x99, 0x6f, 0x14, 0x9b, 0xaa, 0x77, 0xa7, 0x7c, 0xb1, 0x95, 0x25,
+	0x88, 0x74, 0x86, 0x23, 0x9d, 0x24, 0x2f, 0xd7, 0x46, 0x7a, 0x8f, 0x4b, 0x63, 0x01, 0x62, 0x93,
+	0xef, 0x49, 0xd0, 0xc9, 0x34, 0x91, 0xc9, 0x06, 0xa6, 0x3c, 0x48, 0x53, 0x0d, 0xe5, 0x10, 0xc7,
+	0x5c, 0x7d, 0x8f, 0x71, 0xf3, 0xe9, 0x07, 0x78, 0xc1, 0x3c, 0x64, 0xb1, 0xfd, 0x58, 0x82, 0x1e,
+	0xaf, 0x69, 0x5f, 0x77, 0xb7, 0x45, 0x7e, 0x1e, 0x90, 0xa7, 0x9b, 0x92, 0x45, 0x5c, 0xf3, 0x1c,
+	0xd7, 0x34, 0x79, 0xf5, 0x78, 0x5c, 0xfc, 0xa1, 0x1e, 0x60, 0x23, 0x3f, 0x96, 0x20, 0x7e, 0x5c,
+	0x0b, 0x88, 0x2c, 0xd6, 0x31, 0xde, 0xa0, 0xef, 0x25, 0x7f, 0xad, 0xad, 0xb5, 0x48, 0xe4, 0x04,
+	0xf9, 0x93, 0x04, 0xa4, 0xba, 0xbd, 0x4f, 0x16, 0x9a, 0xd4, 0x5a, 0x89, 0xe5, 0x72, 0x8b, 0xab,
+	0x10, 0xc5, 0x35, 0xee, 0xce, 0x45, 0xf2, 0xd5, 0xa6, 0xc2, 0x9c, 0x7e, 0xdf, 0x32, 0x4c, 0x95,
+	0xff, 0x54, 0xa9, 0xb3, 0x27, 0x8f, 0x6a, 0x98, 0xe4, 0xdf, 0x12, 0x8c, 0xd7, 0x69, 0x81, 0x93,
+	0xab, 0x0d, 0x80, 0xd5, 0xef, 0xeb, 0xcb, 0x6f, 0xb4, 0xbb, 0x1c, 0x09, 0x5e, 0xe7, 0x04, 0x97,
+	0xc8, 0x9b, 0xcd, 0x11, 0xd4, 0x0f, 0x0c, 0x47, 0x10, 0x14, 0xa5, 0xa3, 0x78, 0x67, 0x31, 0x9e,
+	0xbf, 0x94, 0x00, 0x82, 0x5e, 0x38, 0x99, 0x69, 0xb0, 0x69, 0x2b, 0x3a, 0xef, 0xf2, 0x6c, 0x93,
+	0xd2, 0x08, 0x7a, 0x81, 0x83, 0x4e, 0x91, 0x99, 0xe6, 0x40, 0x8b, 0x46, 0x3b, 0x79, 0x24, 0x01,
+	0xa9, 0x6e, 0x88, 0xd7, 0xdd, 0x4f, 0xc7, 0xf6, 0xe4, 0xe5, 0xcb, 0x2d, 0xae, 0x42, 0xe4, 0x6b,
+	0x1c, 0xf9, 0x15, 0xb2, 0xd8, 0x1c, 0x72, 0x91, 0x78, 0xf9, 0xa7, 0x9f, 0x7d, 0x59, 0x2e, 0xf9,
+	0xb5, 0x04, 0x7d, 0xa1, 0x6e, 0x37, 0x99, 0x6d, 0x84, 0xa6, 0x72, 0xd3, 0xa4, 0x9a, 0x15, 0x47,
+	0xd4, 0x8b, 0x1c, 0xf5, 0x02, 0xb9, 0xd8, 0x0a, 0x6a, 0x51, 0xdb, 0xb0, 0x7d, 0xd1, 0xeb, 0xf7,
+	0xc4, 0x48, 0xbd, 0x5c, 0x16, 0x6d, 0xc6, 0xca, 0x33, 0xcd, 0x09, 0x23, 0xc8, 0xd7, 0x5a, 0xdc,
+	0x14, 0x6c, 0x31, 0xbf, 0x74, 0x1f, 0x4b, 0x30, 0xb6, 0x66, 0x3b, 0x46, 0x41, 0x73, 0xf4, 0xaa,
+	0xde, 0x12, 0xb9, 0x54, 0x0f, 0xc4, 0x31, 0x6d, 0x39, 0x79, 0xa1, 0xb5, 0x45, 0xc8, 0xe0, 0x06,
+	0x67, 0xf0, 0x26, 0xb9, 0x5a, 0x9b, 0x41, 0xe8, 0x14, 0x22, 0xda, 0x74, 0x28, 0xd5, 0xf8, 0x27,
+	0x91, 0x51, 0xfa, 0xab, 0x04, 0xf2, 0x31, 0x94, 0x58, 0x3b, 0xbd, 0x05, 0x78, 0x41, 0x07, 0x4b,
+	0xbe, 0xdc, 0xe2, 0x2a, 0x64, 0xb5, 0xc1, 0x59, 0x5d, 0x23, 0x6f, 0x3c, 0x07, 0x2b, 0xcb, 0x75,
+	0x18, 0xad, 0xcf, 0x24, 0x48, 0xd4, 0x6f, 0x48, 0x90, 0x6b, 0xf5, 0xf2, 0x61, 0x33, 0x4d, 0x13,
+	0x79, 0xe9, 0x39, 0x34, 0x20, 0xe5, 0x2d, 0x4e, 0x79, 0x93, 0xdc, 0xa8, 0x4d, 0xb9, 0x56, 0xa7,
+	0x44, 0xcd, 0x1b, 0xe6, 0xae, 0x7a, 0xaf, 0x64, 0x15, 0x54, 0xd6, 0x85, 0x49, 0x3f, 0x08, 0xb7,
+	0x66, 0x1e, 0xb2, 0xc2, 0x10, 0x82, 0x6e, 0x40, 0xdd, 0xec, 0x5a, 0xd5, 0x4f, 0x91, 0x67, 0x9b,
+	0x94, 0x46, 0xf4, 0xaf, 0x73, 0xf4, 0x97, 0xc8, 0x7c, 0x73, 0x07, 0x29, 0xd4, 0x43, 0x20, 0x4f,
+	0x24, 0x18, 0x3b, 0xf6, 0x65, 0x4b, 0xea, 0xd5, 0x03, 0x8d, 0xfa, 0x0c, 0xf2, 0x95, 0xf6, 0x16,
+	0xb7, 0x77, 0xcd, 0x15, 0x3d, 0x2d, 0xe2, 0x05, 0x93, 0x7e, 0x80, 0xfd, 0x8b, 0x87, 0xcb, 0x9b,
+	0x8f, 0x9e, 0x26, 0xa4, 0xc7, 0x4f, 0x13, 0xd2, 0x3f, 0x9f, 0x26, 0xa4, 0x1f, 0x3c, 0x4b, 0x9c,
+	0x78, 0xfc, 0x2c, 0x71, 0xe2, 0xef, 0xcf, 0x12, 0x27, 0xde, 0x9b, 0x0b, 0x3d, 0x91, 0xd0, 0xc8,
+	0x6c, 0x5e, 0xdb, 0xb6, 0x7d, 0x8b, 0xf7, 0xe7, 0xbf, 0x92, 0x3e, 0x10, 0x76, 0xf9, 0x83, 0x69,
+	0xbb, 0x9b, 0xf7, 0x12, 0x2e, 0xfd, 0x6f, 0x00, 0x84, 0x25, 0x64, 0xc8, 0x66, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1796,6 +2091,14 @@ type QueryClient interface {
 	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
 	// pool that is linked with the given CFMM pool.
 	ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *QueryConcentratedPoolIdLinkFromCFMMRequest, opts ...grpc.CallOption) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// SharePrice returns the value of one pool share in the given quote denom,
+	// priced with geometric TWAPs over the given window.
+	SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error)
+	// AccountPoolPositionsValue returns the value of the shares of a pool held
+	// by an account, split by shares in its balance, locked and unlocking
+	// shares, in the given quote denom priced with geometric TWAPs over the
+	// given window.
+	AccountPoolPositionsValue(ctx context.Context, in *QueryAccountPoolPositionsValueRequest, opts ...grpc.CallOption) (*QueryAccountPoolPositionsValueResponse, error)
 }
 
 type queryClient struct {
@@ -1956,6 +2259,24 @@ func (c *queryClient) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) SharePrice(ctx context.Context, in *QuerySharePriceRequest, opts ...grpc.CallOption) (*QuerySharePriceResponse, error) {
+	out := new(QuerySharePriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SharePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountPoolPositionsValue(ctx context.Context, in *QueryAccountPoolPositionsValueRequest, opts ...grpc.CallOption) (*QueryAccountPoolPositionsValueResponse, error) {
+	out := new(QueryAccountPoolPositionsValueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/AccountPoolPositionsValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -1990,6 +2311,14 @@ type QueryServer interface {
 	// ConcentratedPoolIdLinkFromBalancer returns the pool id of the concentrated
 	// pool that is linked with the given CFMM pool.
 	ConcentratedPoolIdLinkFromCFMM(context.Context, *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error)
+	// SharePrice returns the value of one pool share in the given quote denom,
+	// priced with geometric TWAPs over the given window.
+	SharePrice(context.Context, *QuerySharePriceRequest) (*QuerySharePriceResponse, error)
+	// AccountPoolPositionsValue returns the value of the shares of a pool held
+	// by an account, split by shares in its balance, locked and unlocking
+	// shares, in the given quote denom priced with geometric TWAPs over the
+	// given window.
+	AccountPoolPositionsValue(context.Context, *QueryAccountPoolPositionsValueRequest) (*QueryAccountPoolPositionsValueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2044,6 +2373,12 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context,
 func (*UnimplementedQueryServer) ConcentratedPoolIdLinkFromCFMM(ctx context.Context, req *QueryConcentratedPoolIdLinkFromCFMMRequest) (*QueryConcentratedPoolIdLinkFromCFMMResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConcentratedPoolIdLinkFromCFMM not implemented")
 }
+func (*UnimplementedQueryServer) SharePrice(ctx context.Context, req *QuerySharePriceRequest) (*QuerySharePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePrice not implemented")
+}
+func (*UnimplementedQueryServer) AccountPoolPositionsValue(ctx context.Context, req *QueryAccountPoolPositionsValueRequest) (*QueryAccountPoolPositionsValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPoolPositionsValue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2337,6 +2672,42 @@ func _Query_ConcentratedPoolIdLinkFromCFMM_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SharePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SharePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/SharePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SharePrice(ctx, req.(*QuerySharePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountPoolPositionsValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPoolPositionsValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountPoolPositionsValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/AccountPoolPositionsValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountPoolPositionsValue(ctx, req.(*QueryAccountPoolPositionsValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2405,6 +2776,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConcentratedPoolIdLinkFromCFMM",
 			Handler:    _Query_ConcentratedPoolIdLinkFromCFMM_Handler,
 		},
+		{
+			MethodName: "SharePrice",
+			Handler:    _Query_SharePrice_Handler,
+		},
+		{
+			MethodName: "AccountPoolPositionsValue",
+			Handler:    _Query_AccountPoolPositionsValue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -3572,46 +3951,275 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySharePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySharePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharePriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.PricePoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PricePoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySharePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySharePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	if m.PricePoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PricePoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalValue.Size()
+		i -= size
+		if _, err := m.TotalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnlockingValue.Size()
+		i -= size
+		if _, err := m.UnlockingValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.UnlockingShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LockedValue.Size()
+		i -= size
+		if _, err := m.LockedValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LockedShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BalanceValue.Size()
+		i -= size
+		if _, err := m.BalanceValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BalanceShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Pagination != nil {
@@ -4055,6 +4663,86 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySharePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PricePoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PricePoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySharePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SharePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountPoolPositionsValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PricePoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PricePoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountPoolPositionsValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BalanceShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BalanceValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnlockingShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UnlockingValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7040,6 +7728,713 @@ func (m *QueryConcentratedPoolIdLinkFromCFMMResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *QuerySharePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySharePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySharePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePoolId", wireType)
+			}
+			m.PricePoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricePoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySharePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySharePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySharePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPoolPositionsValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPoolPositionsValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPoolPositionsValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePoolId", wireType)
+			}
+			m.PricePoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricePoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPoolPositionsValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPoolPositionsValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPoolPositionsValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockingValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package poolmanager
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 )
 
@@ -62,3 +63,7 @@ func (k Keeper) CreateOsmoMultihopExpectedSwapOuts(
 ) ([]sdk.Int, error) {
 	return k.createOsmoMultihopExpectedSwapOuts(ctx, route, tokenOut, cumulativeRouteSwapFee, sumOfSwapFees)
 }
+
+func ConcentratedZapSwapAmount(pool cltypes.ConcentratedPoolExtension, tokenIn sdk.Coin, lowerTick, upperTick int64) (sdk.Int, error) {
+	return concentratedZapSwapAmount(pool, tokenIn, lowerTick, upperTick)
+}
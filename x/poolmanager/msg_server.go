@@ -119,3 +119,53 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 
 	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
+
+func (server msgServer) ZapIn(goCtx context.Context, msg *types.MsgZapIn) (*types.MsgZapInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, positionId, liquidityCreated, err := server.keeper.ZapIn(ctx, sender, msg.PoolId, msg.TokenIn, msg.Routes, msg.ShareOutMinAmount, msg.LiquidityMinAmount, msg.LowerTick, msg.UpperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap and join events are handled in each pool module
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgZapInResponse{ShareOutAmount: shareOutAmount, PositionId: positionId, LiquidityCreated: liquidityCreated}, nil
+}
+
+func (server msgServer) ZapOut(goCtx context.Context, msg *types.MsgZapOut) (*types.MsgZapOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.ZapOut(ctx, sender, msg.PoolId, msg.ShareInAmount, msg.PositionId, msg.TokenOutDenom, msg.TokenOutMinAmount, msg.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exit and swap events are handled in each pool module
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgZapOutResponse{TokenOutAmount: tokenOutAmount}, nil
+}
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/poolmanager/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/poolmanager/split-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountOut{}, "osmosis/poolmanager/split-amount-out", nil)
+	cdc.RegisterConcrete(&MsgZapIn{}, "osmosis/poolmanager/zap-in", nil)
+	cdc.RegisterConcrete(&MsgZapOut{}, "osmosis/poolmanager/zap-out", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +26,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapExactAmountOut{},
 		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSplitRouteSwapExactAmountOut{},
+		&MsgZapIn{},
+		&MsgZapOut{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
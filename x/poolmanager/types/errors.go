@@ -133,3 +133,84 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type UnsupportedZapPoolTypeError struct {
+	PoolType PoolType
+	PoolId   uint64
+}
+
+func (e UnsupportedZapPoolTypeError) Error() string {
+	return fmt.Sprintf("zapping is not supported for the given pool type (%s) and pool id (%d)", e.PoolType, e.PoolId)
+}
+
+type MissingZapRouteError struct {
+	TokenInDenom string
+	PoolId       uint64
+}
+
+func (e MissingZapRouteError) Error() string {
+	return fmt.Sprintf("routes must be given to swap (%s) into the assets of pool (%d)", e.TokenInDenom, e.PoolId)
+}
+
+type MissingZapOutRouteError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e MissingZapOutRouteError) Error() string {
+	return fmt.Sprintf("no route given from (%s) to (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type InvalidZapOutRouteError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e InvalidZapOutRouteError) Error() string {
+	return fmt.Sprintf("route from (%s) must end with the token out denom (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type InvalidZapRouteError struct {
+	PoolId        uint64
+	TokenOutDenom string
+}
+
+func (e InvalidZapRouteError) Error() string {
+	return fmt.Sprintf("route must end with one of the assets of pool (%d), ended with (%s)", e.PoolId, e.TokenOutDenom)
+}
+
+type InsufficientZapLiquidityError struct {
+	Actual  sdk.Dec
+	Minimum sdk.Dec
+}
+
+func (e InsufficientZapLiquidityError) Error() string {
+	return fmt.Sprintf("insufficient liquidity created: expected %s to be at least %s", e.Actual, e.Minimum)
+}
+
+type PositionNotInPoolError struct {
+	PositionId uint64
+	PoolId     uint64
+}
+
+func (e PositionNotInPoolError) Error() string {
+	return fmt.Sprintf("position (%d) does not belong to pool (%d)", e.PositionId, e.PoolId)
+}
+
+type InvalidZapTickRangeError struct {
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e InvalidZapTickRangeError) Error() string {
+	return fmt.Sprintf("lower tick (%d) must be less than upper tick (%d)", e.LowerTick, e.UpperTick)
+}
+
+type InvalidZapOutAmountsError struct {
+	ShareInAmount sdk.Int
+	PositionId    uint64
+}
+
+func (e InvalidZapOutAmountsError) Error() string {
+	return fmt.Sprintf("exactly one of share in amount (%s) and position id (%d) must be set", e.ShareInAmount, e.PositionId)
+}
@@ -12,6 +12,8 @@ const (
 	TypeMsgSwapExactAmountOut           = "swap_exact_amount_out"
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
+	TypeMsgZapIn                        = "zap_in"
+	TypeMsgZapOut                       = "zap_out"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -166,3 +168,113 @@ func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgZapIn{}
+
+func (msg MsgZapIn) Route() string { return RouterKey }
+func (msg MsgZapIn) Type() string  { return TypeMsgZapIn }
+func (msg MsgZapIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	// Routes are optional if the token in is one of the pool assets.
+	if len(msg.Routes) > 0 {
+		if err := SwapAmountInRoutes(msg.Routes).Validate(); err != nil {
+			return err
+		}
+	}
+
+	if msg.ShareOutMinAmount.IsNegative() {
+		return nonPositiveAmountError{msg.ShareOutMinAmount.String()}
+	}
+
+	if msg.LiquidityMinAmount.IsNegative() {
+		return nonPositiveAmountError{msg.LiquidityMinAmount.String()}
+	}
+
+	// Both ticks being zero denotes a full range position.
+	if (msg.LowerTick != 0 || msg.UpperTick != 0) && msg.LowerTick >= msg.UpperTick {
+		return InvalidZapTickRangeError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	return nil
+}
+
+func (msg MsgZapIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgZapOut{}
+
+func (msg MsgZapOut) Route() string { return RouterKey }
+func (msg MsgZapOut) Type() string  { return TypeMsgZapOut }
+func (msg MsgZapOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if msg.ShareInAmount.IsNegative() {
+		return nonPositiveAmountError{msg.ShareInAmount.String()}
+	}
+
+	// Balancer and stableswap pools are exited with shares, concentrated liquidity
+	// pools by withdrawing a position.
+	if msg.ShareInAmount.IsPositive() == (msg.PositionId != 0) {
+		return InvalidZapOutAmountsError{ShareInAmount: msg.ShareInAmount, PositionId: msg.PositionId}
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	tokenInDenoms := make(map[string]bool, len(msg.Routes))
+	for _, route := range msg.Routes {
+		if err := sdk.ValidateDenom(route.TokenInDenom); err != nil {
+			return err
+		}
+		if route.TokenInDenom == msg.TokenOutDenom || tokenInDenoms[route.TokenInDenom] {
+			return InvalidZapOutRouteError{TokenInDenom: route.TokenInDenom, TokenOutDenom: msg.TokenOutDenom}
+		}
+		tokenInDenoms[route.TokenInDenom] = true
+
+		if err := SwapAmountInRoutes(route.Routes).Validate(); err != nil {
+			return err
+		}
+		if route.Routes[len(route.Routes)-1].TokenOutDenom != msg.TokenOutDenom {
+			return InvalidZapOutRouteError{TokenInDenom: route.TokenInDenom, TokenOutDenom: msg.TokenOutDenom}
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgZapOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgZapOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -567,3 +567,252 @@ func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgZapIn(t *testing.T) {
+	properMsg := types.MsgZapIn{
+		Sender:             addr1,
+		PoolId:             1,
+		TokenIn:            sdk.NewCoin("test", sdk.NewInt(100)),
+		Routes:             validSwapExactAmountInRoutes,
+		ShareOutMinAmount:  sdk.NewInt(100),
+		LiquidityMinAmount: sdk.ZeroDec(),
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), "zap_in")
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgZapIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no routes",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "tick range",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.LowerTick = -100
+				msg.UpperTick = 100
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.Routes = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "1"}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.TokenIn.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative share out min amount",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.ShareOutMinAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative liquidity min amount",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.LiquidityMinAmount = sdk.NewDec(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "lower tick above upper tick",
+			msg: createMsg(properMsg, func(msg types.MsgZapIn) types.MsgZapIn {
+				msg.LowerTick = 100
+				msg.UpperTick = -100
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgZapOut(t *testing.T) {
+	properMsg := types.MsgZapOut{
+		Sender:            addr1,
+		PoolId:            1,
+		ShareInAmount:     sdk.NewInt(100),
+		TokenOutDenom:     "test",
+		TokenOutMinAmount: sdk.NewInt(100),
+		Routes: []types.ZapOutRoute{{
+			TokenInDenom: "test2",
+			Routes:       []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test"}},
+		}},
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), "zap_out")
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgZapOut
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "position instead of shares",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.ShareInAmount = sdk.ZeroInt()
+				msg.PositionId = 1
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "both shares and position",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.PositionId = 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "neither shares nor position",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.ShareInAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative shares",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.ShareInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid route token in denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Routes = []types.ZapOutRoute{{TokenInDenom: "1", Routes: msg.Routes[0].Routes}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "route token in denom is token out denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Routes = []types.ZapOutRoute{{TokenInDenom: "test", Routes: msg.Routes[0].Routes}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate route token in denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Routes = []types.ZapOutRoute{msg.Routes[0], msg.Routes[0]}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty route",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Routes = []types.ZapOutRoute{{TokenInDenom: "test2"}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "route does not end with token out denom",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.Routes = []types.ZapOutRoute{{
+					TokenInDenom: "test2",
+					Routes:       []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test3"}},
+				}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(properMsg, func(msg types.MsgZapOut) types.MsgZapOut {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
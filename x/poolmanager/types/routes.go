@@ -94,6 +94,63 @@ type PoolModuleI interface {
 	ValidatePermissionlessPoolCreationEnabled(ctx sdk.Context) error
 }
 
+// CFMMPoolModuleI is the interface that must be fulfilled by the module
+// storing and containing the balancer and stableswap pools.
+// In addition to swaps, it allows joining and exiting the pools.
+type CFMMPoolModuleI interface {
+	PoolModuleI
+
+	JoinSwapExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolId uint64,
+		tokensIn sdk.Coins,
+		shareOutMinAmount sdk.Int,
+	) (sharesOut sdk.Int, err error)
+
+	ExitPool(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolId uint64,
+		shareInAmount sdk.Int,
+		tokenOutMins sdk.Coins,
+	) (exitCoins sdk.Coins, err error)
+}
+
+// ConcentratedPoolModuleI is the interface that must be fulfilled by the module
+// storing and containing the concentrated liquidity pools.
+// In addition to swaps, it allows creating and withdrawing positions.
+type ConcentratedPoolModuleI interface {
+	PoolModuleI
+
+	CreatePosition(
+		ctx sdk.Context,
+		poolId uint64,
+		owner sdk.AccAddress,
+		tokensProvided sdk.Coins,
+		amount0Min, amount1Min sdk.Int,
+		lowerTick, upperTick int64,
+	) (positionId uint64, actualAmount0 sdk.Int, actualAmount1 sdk.Int, liquidityDelta sdk.Dec, lowerTickResult int64, upperTickResult int64, err error)
+
+	CreateFullRangePosition(
+		ctx sdk.Context,
+		poolId uint64,
+		owner sdk.AccAddress,
+		coins sdk.Coins,
+	) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, err error)
+
+	WithdrawPosition(
+		ctx sdk.Context,
+		owner sdk.AccAddress,
+		positionId uint64,
+		requestedLiquidityAmountToWithdraw sdk.Dec,
+	) (amtDenom0, amtDenom1 sdk.Int, err error)
+
+	GetPositionLiquidity(ctx sdk.Context, positionId uint64) (sdk.Dec, error)
+
+	GetPositionPoolId(ctx sdk.Context, positionId uint64) (uint64, error)
+}
+
 type PoolIncentivesKeeperI interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
 }
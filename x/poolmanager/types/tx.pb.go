@@ -430,6 +430,332 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgZapIn
+// MsgZapIn swaps an arbitrary token into the assets of the given pool and
+// joins the pool in a single transaction. For balancer and stableswap pools
+// the swapped token is joined single-sided. For concentrated liquidity pools
+// part of the swapped token is swapped into the other pool asset, in the ratio
+// of the assets of a position in the given tick range at the current price,
+// and a position is created in that range, or in the full range if no range is
+// given.
+type MsgZapIn struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId  uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn types.Coin `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// routes swap token_in into one of the pool assets. They must be given
+	// unless token_in is a pool asset.
+	Routes []SwapAmountInRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	// share_out_min_amount is the minimum amount of shares to receive when
+	// joining a balancer or stableswap pool.
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	// liquidity_min_amount is the minimum amount of liquidity to create when
+	// joining a concentrated liquidity pool.
+	LiquidityMinAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity_min_amount,json=liquidityMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_min_amount" yaml:"liquidity_min_amount"`
+	// lower_tick and upper_tick define the range of the concentrated liquidity
+	// position. If both are zero, a full range position is created.
+	LowerTick int64 `protobuf:"varint,7,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,8,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *MsgZapIn) Reset()         { *m = MsgZapIn{} }
+func (m *MsgZapIn) String() string { return proto.CompactTextString(m) }
+func (*MsgZapIn) ProtoMessage()    {}
+func (*MsgZapIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgZapIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapIn.Merge(m, src)
+}
+func (m *MsgZapIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapIn proto.InternalMessageInfo
+
+func (m *MsgZapIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgZapIn) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgZapIn) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgZapIn) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgZapIn) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgZapIn) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type MsgZapInResponse struct {
+	ShareOutAmount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	PositionId       uint64                                 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+}
+
+func (m *MsgZapInResponse) Reset()         { *m = MsgZapInResponse{} }
+func (m *MsgZapInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgZapInResponse) ProtoMessage()    {}
+func (*MsgZapInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *MsgZapInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapInResponse.Merge(m, src)
+}
+func (m *MsgZapInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapInResponse proto.InternalMessageInfo
+
+func (m *MsgZapInResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+// ===================== MsgZapOut
+// MsgZapOut exits a balancer or stableswap pool, or withdraws a concentrated
+// liquidity position, and swaps every withdrawn asset into token_out_denom
+// in a single transaction. Each asset is swapped using the given routes.
+type MsgZapOut struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// share_in_amount is the amount of shares to exit a balancer or stableswap
+	// pool with.
+	ShareInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	// position_id is the concentrated liquidity position to withdraw in full.
+	PositionId        uint64                                 `protobuf:"varint,4,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	TokenOutDenom     string                                 `protobuf:"bytes,5,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// routes swap each withdrawn asset that is not token_out_denom into
+	// token_out_denom.
+	Routes []ZapOutRoute `protobuf:"bytes,7,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *MsgZapOut) Reset()         { *m = MsgZapOut{} }
+func (m *MsgZapOut) String() string { return proto.CompactTextString(m) }
+func (*MsgZapOut) ProtoMessage()    {}
+func (*MsgZapOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{10}
+}
+func (m *MsgZapOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapOut.Merge(m, src)
+}
+func (m *MsgZapOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapOut proto.InternalMessageInfo
+
+func (m *MsgZapOut) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgZapOut) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgZapOut) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *MsgZapOut) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgZapOut) GetRoutes() []ZapOutRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// ZapOutRoute is the route a withdrawn asset is swapped through into the
+// token out denom of a MsgZapOut.
+type ZapOutRoute struct {
+	TokenInDenom string              `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	Routes       []SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *ZapOutRoute) Reset()         { *m = ZapOutRoute{} }
+func (m *ZapOutRoute) String() string { return proto.CompactTextString(m) }
+func (*ZapOutRoute) ProtoMessage()    {}
+func (*ZapOutRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *ZapOutRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZapOutRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZapOutRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZapOutRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZapOutRoute.Merge(m, src)
+}
+func (m *ZapOutRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZapOutRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZapOutRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZapOutRoute proto.InternalMessageInfo
+
+func (m *ZapOutRoute) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *ZapOutRoute) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type MsgZapOutResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgZapOutResponse) Reset()         { *m = MsgZapOutResponse{} }
+func (m *MsgZapOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgZapOutResponse) ProtoMessage()    {}
+func (*MsgZapOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{12}
+}
+func (m *MsgZapOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgZapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgZapOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgZapOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgZapOutResponse.Merge(m, src)
+}
+func (m *MsgZapOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgZapOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgZapOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgZapOutResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
@@ -439,6 +765,11 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgZapIn)(nil), "osmosis.poolmanager.v1beta1.MsgZapIn")
+	proto.RegisterType((*MsgZapInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgZapInResponse")
+	proto.RegisterType((*MsgZapOut)(nil), "osmosis.poolmanager.v1beta1.MsgZapOut")
+	proto.RegisterType((*ZapOutRoute)(nil), "osmosis.poolmanager.v1beta1.ZapOutRoute")
+	proto.RegisterType((*MsgZapOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgZapOutResponse")
 }
 
 func init() {
@@ -446,55 +777,77 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdf, 0x4e, 0xd3, 0x50,
-	0x1c, 0xde, 0x19, 0x0b, 0xc2, 0x41, 0xfe, 0x55, 0x90, 0x51, 0xb0, 0x25, 0x8d, 0x41, 0x4c, 0xa4,
-	0xcd, 0x86, 0xd1, 0x88, 0x26, 0xc6, 0x81, 0x89, 0x4b, 0x68, 0x26, 0xf5, 0xce, 0x9b, 0xa5, 0x1b,
-	0xcd, 0x6c, 0x58, 0xcf, 0x69, 0x76, 0x4e, 0x61, 0xc4, 0xc4, 0xc4, 0xc4, 0x07, 0xd0, 0x78, 0x69,
-	0x8c, 0x89, 0x4f, 0xc3, 0x25, 0x77, 0x1a, 0x2f, 0x2a, 0xc2, 0x1b, 0xec, 0x09, 0x4c, 0xdb, 0xd3,
-	0xb2, 0x95, 0x51, 0xa8, 0x24, 0x72, 0xd5, 0xf6, 0xf4, 0xf7, 0xe7, 0xfb, 0x7d, 0xdf, 0x77, 0x4e,
-	0x0b, 0x6f, 0x63, 0x62, 0x61, 0x62, 0x12, 0xc5, 0xc6, 0xb8, 0x69, 0xe9, 0x48, 0x6f, 0x18, 0x2d,
-	0x65, 0xa7, 0x50, 0x33, 0xa8, 0x5e, 0x50, 0x68, 0x5b, 0xb6, 0x5b, 0x98, 0x62, 0x6e, 0x8e, 0x45,
-	0xc9, 0x5d, 0x51, 0x32, 0x8b, 0xe2, 0xa7, 0x1a, 0xb8, 0x81, 0xfd, 0x38, 0xc5, 0xbb, 0x0b, 0x52,
-	0x78, 0xa1, 0xee, 0xe7, 0x28, 0x35, 0x9d, 0x18, 0x51, 0xc1, 0x3a, 0x36, 0x11, 0x7b, 0x7f, 0x2f,
-	0xa9, 0x31, 0xd9, 0xd5, 0xed, 0x6a, 0x0b, 0x3b, 0xd4, 0x08, 0xa2, 0x25, 0x37, 0x0b, 0xa7, 0x54,
-	0xd2, 0x78, 0xb5, 0xab, 0xdb, 0xcf, 0xdb, 0x7a, 0x9d, 0x3e, 0xb3, 0xb0, 0x83, 0x68, 0x19, 0x71,
-	0x77, 0xe1, 0x20, 0x31, 0xd0, 0x96, 0xd1, 0xca, 0x83, 0x05, 0xb0, 0x34, 0x5c, 0x9a, 0xec, 0xb8,
-	0xe2, 0xe8, 0x9e, 0x6e, 0x35, 0x57, 0xa5, 0x60, 0x5d, 0xd2, 0x58, 0x00, 0xb7, 0x01, 0x07, 0xfd,
-	0x92, 0x24, 0x9f, 0x5d, 0x18, 0x58, 0x1a, 0x29, 0xca, 0x72, 0xc2, 0x54, 0xb2, 0xd7, 0x2a, 0xec,
-	0xa2, 0x79, 0x69, 0xa5, 0xdc, 0xbe, 0x2b, 0x66, 0x34, 0x56, 0x83, 0x53, 0xe1, 0x10, 0xc5, 0xdb,
-	0x06, 0xaa, 0x9a, 0x28, 0x3f, 0xb0, 0x00, 0x96, 0x46, 0x8a, 0xb3, 0x72, 0x30, 0xb2, 0xec, 0x8d,
-	0x1c, 0xd5, 0x59, 0xc3, 0x26, 0x2a, 0xcd, 0x78, 0xa9, 0x1d, 0x57, 0x1c, 0x0f, 0x90, 0x85, 0x89,
-	0x92, 0x76, 0xcd, 0xbf, 0x2d, 0x23, 0xee, 0x1d, 0x9c, 0x0a, 0x56, 0xb1, 0x43, 0xab, 0x96, 0x89,
-	0xaa, 0xba, 0xdf, 0x3b, 0x9f, 0xf3, 0xa7, 0x52, 0xbd, 0xfc, 0x5f, 0xae, 0xb8, 0xd8, 0x30, 0xe9,
-	0x1b, 0xa7, 0x26, 0xd7, 0xb1, 0xa5, 0x30, 0x7e, 0x83, 0xcb, 0x32, 0xd9, 0xda, 0x56, 0xe8, 0x9e,
-	0x6d, 0x10, 0xb9, 0x8c, 0x68, 0xc7, 0x15, 0xe7, 0xba, 0x3b, 0xf5, 0xd6, 0x94, 0xb4, 0x49, 0x7f,
-	0xb9, 0xe2, 0x50, 0xd5, 0x44, 0xc1, 0x8c, 0xd2, 0x67, 0x00, 0xe7, 0xfb, 0x11, 0xac, 0x19, 0xc4,
-	0xc6, 0x88, 0x18, 0x1c, 0x81, 0x13, 0x27, 0xc5, 0x18, 0xb8, 0x80, 0xf2, 0x72, 0x6a, 0x70, 0x33,
-	0x71, 0x70, 0x21, 0xb0, 0xb1, 0x10, 0x18, 0x43, 0xf5, 0x3b, 0x0b, 0x05, 0x0f, 0x95, 0xdd, 0x34,
-	0xa9, 0x2f, 0xc2, 0xa5, 0x0c, 0xb0, 0x19, 0x33, 0xc0, 0xca, 0x85, 0x0d, 0x70, 0x02, 0x20, 0xe6,
-	0x82, 0xa7, 0x70, 0x2c, 0x14, 0xb3, 0xba, 0x65, 0x20, 0x6c, 0xf9, 0x5e, 0x18, 0x2e, 0xcd, 0x76,
-	0x5c, 0x71, 0xba, 0x57, 0xec, 0xe0, 0xbd, 0xa4, 0x5d, 0x67, 0x92, 0xaf, 0x7b, 0x8f, 0x57, 0xae,
-	0xfb, 0x57, 0x00, 0x17, 0x93, 0x19, 0xbe, 0x5a, 0x07, 0x1c, 0x66, 0xe1, 0xf4, 0x69, 0x5f, 0x56,
-	0x1c, 0x9a, 0x46, 0x78, 0x35, 0x26, 0xbc, 0x72, 0x41, 0xe1, 0x2b, 0x4e, 0x5f, 0xd1, 0xdf, 0xc2,
-	0x1b, 0x91, 0xa8, 0x96, 0xde, 0x0e, 0xb9, 0x08, 0x94, 0xdf, 0x48, 0xcd, 0x05, 0x1f, 0xf3, 0xc9,
-	0x49, 0x49, 0x49, 0x9b, 0x60, 0x66, 0x51, 0xf5, 0x76, 0x00, 0x89, 0x7b, 0x09, 0x87, 0x23, 0xd6,
-	0x7c, 0x97, 0x24, 0x1e, 0x3c, 0x79, 0x76, 0xf0, 0x4c, 0xc4, 0xf8, 0x96, 0xb4, 0xa1, 0x90, 0x68,
-	0xe9, 0x13, 0x80, 0xb7, 0xfa, 0x52, 0x1c, 0x29, 0x6f, 0xc3, 0xf1, 0x08, 0x5d, 0x8f, 0xf0, 0x2f,
-	0x52, 0x0f, 0x7b, 0x33, 0x36, 0x6c, 0x38, 0xe8, 0x28, 0x1b, 0x94, 0xc9, 0xfe, 0x27, 0x0b, 0xc5,
-	0x24, 0x5b, 0xa6, 0x34, 0x80, 0x16, 0x33, 0xc0, 0xfd, 0x8b, 0x1b, 0xe0, 0xcc, 0xad, 0x5f, 0x0a,
-	0x49, 0xf1, 0xec, 0xdb, 0xbd, 0xf7, 0xf9, 0xf8, 0x98, 0x51, 0x40, 0x38, 0x66, 0xc5, 0xa1, 0xc1,
-	0xee, 0x3f, 0xc3, 0x49, 0xb9, 0xff, 0xe1, 0x24, 0xe9, 0x0b, 0x80, 0x77, 0xce, 0xe1, 0xf8, 0xea,
-	0x1c, 0x50, 0xfc, 0x91, 0x83, 0x03, 0x2a, 0x69, 0x70, 0xef, 0x01, 0x9c, 0x3c, 0x7d, 0xea, 0x17,
-	0x12, 0x05, 0xec, 0xf7, 0x21, 0xe3, 0x1f, 0xa5, 0x4e, 0x89, 0xa6, 0xff, 0x00, 0x20, 0xd7, 0xc7,
-	0x80, 0xc5, 0x94, 0x15, 0x2b, 0x0e, 0xe5, 0x57, 0xd3, 0xe7, 0x44, 0x30, 0xbe, 0x01, 0x38, 0x97,
-	0xf4, 0x29, 0x7c, 0x7c, 0x6e, 0xed, 0xb3, 0x93, 0xf9, 0xb5, 0x4b, 0x24, 0x47, 0x08, 0xbf, 0x03,
-	0x38, 0x9f, 0xb8, 0x67, 0x9f, 0xfc, 0x73, 0x17, 0x8f, 0xbc, 0xf5, 0xcb, 0x64, 0x87, 0x20, 0x4b,
-	0x9b, 0xfb, 0x47, 0x02, 0x38, 0x38, 0x12, 0xc0, 0xe1, 0x91, 0x00, 0x3e, 0x1e, 0x0b, 0x99, 0x83,
-	0x63, 0x21, 0xf3, 0xf3, 0x58, 0xc8, 0xbc, 0x7e, 0xd8, 0x65, 0x62, 0xd6, 0x69, 0xb9, 0xa9, 0xd7,
-	0x48, 0xf8, 0xa0, 0xec, 0x14, 0x1e, 0x28, 0xed, 0x9e, 0x3f, 0x56, 0xdf, 0xd9, 0xb5, 0x41, 0xff,
-	0x2f, 0x75, 0xe5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x61, 0x95, 0x33, 0xba, 0x4e, 0x0b, 0x00,
-	0x00,
+	// 1112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xef, 0x4d, 0xb3, 0xb4, 0x39, 0xa5, 0x6d, 0x62, 0xd2, 0x2d, 0x4b, 0x47, 0x1c, 0x5d, 0x41,
+	0x09, 0x82, 0xda, 0x6a, 0x37, 0x31, 0x31, 0x90, 0x10, 0x69, 0x41, 0x04, 0x2d, 0x0a, 0xbb, 0xf0,
+	0x34, 0x09, 0x05, 0x27, 0xb1, 0x32, 0xab, 0x89, 0xaf, 0x89, 0xaf, 0xd7, 0x54, 0x48, 0x43, 0x48,
+	0x3c, 0xf0, 0x08, 0xe2, 0x05, 0xf1, 0x47, 0x48, 0x7c, 0x0b, 0xbe, 0xc1, 0x1e, 0xf7, 0x88, 0x78,
+	0x30, 0xa3, 0x7d, 0xe5, 0x29, 0x9f, 0x00, 0xd9, 0xbe, 0xb6, 0x53, 0xcf, 0x4d, 0x63, 0x52, 0xad,
+	0x4f, 0xb1, 0xef, 0x3d, 0xff, 0x7f, 0xbf, 0x7b, 0xee, 0x71, 0xe0, 0x65, 0x6a, 0x0e, 0xa8, 0xa9,
+	0x99, 0xb2, 0x41, 0x69, 0x7f, 0xa0, 0xe8, 0x4a, 0x4f, 0x1d, 0xca, 0x0f, 0x77, 0xda, 0x2a, 0x53,
+	0x76, 0x64, 0x36, 0x92, 0x8c, 0x21, 0x65, 0x54, 0xd8, 0xe4, 0x52, 0xd2, 0x84, 0x94, 0xc4, 0xa5,
+	0x4a, 0x85, 0x1e, 0xed, 0x51, 0x57, 0x4e, 0x76, 0x9e, 0x3c, 0x95, 0x52, 0xb9, 0xe3, 0xea, 0xc8,
+	0x6d, 0xc5, 0x54, 0x03, 0x83, 0x1d, 0xaa, 0xe9, 0x7c, 0xff, 0x8d, 0x69, 0x8e, 0xcd, 0x43, 0xc5,
+	0x68, 0x0d, 0xa9, 0xc5, 0x54, 0x4f, 0x1a, 0xdb, 0x29, 0x28, 0x34, 0xcc, 0xde, 0x27, 0x87, 0x8a,
+	0xf1, 0xfe, 0x48, 0xe9, 0xb0, 0xf7, 0x06, 0xd4, 0xd2, 0x59, 0x5d, 0x17, 0x5e, 0x83, 0x8c, 0xa9,
+	0xea, 0x5d, 0x75, 0x58, 0x44, 0x15, 0x54, 0xcd, 0xd6, 0xf2, 0x63, 0x5b, 0x5c, 0x3d, 0x52, 0x06,
+	0xfd, 0x3b, 0xd8, 0x5b, 0xc7, 0x84, 0x0b, 0x08, 0x77, 0x21, 0xe3, 0x9a, 0x34, 0x8b, 0xa9, 0xca,
+	0x62, 0x75, 0x65, 0x57, 0x92, 0xa6, 0x64, 0x25, 0x39, 0xae, 0x7c, 0x2f, 0xc4, 0x51, 0xab, 0xa5,
+	0x1f, 0xdb, 0xe2, 0x02, 0xe1, 0x36, 0x84, 0x06, 0x2c, 0x33, 0x7a, 0xa0, 0xea, 0x2d, 0x4d, 0x2f,
+	0x2e, 0x56, 0x50, 0x75, 0x65, 0xf7, 0xba, 0xe4, 0xa5, 0x2c, 0x39, 0x29, 0x07, 0x76, 0xf6, 0xa8,
+	0xa6, 0xd7, 0xae, 0x39, 0xaa, 0x63, 0x5b, 0x5c, 0xf7, 0x22, 0xf3, 0x15, 0x31, 0x59, 0x72, 0x1f,
+	0xeb, 0xba, 0xf0, 0x08, 0x0a, 0xde, 0x2a, 0xb5, 0x58, 0x6b, 0xa0, 0xe9, 0x2d, 0xc5, 0xf5, 0x5d,
+	0x4c, 0xbb, 0x59, 0x35, 0x1c, 0xfd, 0xbf, 0x6c, 0x71, 0xab, 0xa7, 0xb1, 0x07, 0x56, 0x5b, 0xea,
+	0xd0, 0x81, 0xcc, 0xeb, 0xeb, 0xfd, 0x6c, 0x9b, 0xdd, 0x03, 0x99, 0x1d, 0x19, 0xaa, 0x29, 0xd5,
+	0x75, 0x36, 0xb6, 0xc5, 0xcd, 0x49, 0x4f, 0xa7, 0x6d, 0x62, 0x92, 0x77, 0x97, 0x9b, 0x16, 0x6b,
+	0x68, 0xba, 0x97, 0x23, 0xfe, 0x01, 0xc1, 0x8d, 0xb8, 0x02, 0x13, 0xd5, 0x34, 0xa8, 0x6e, 0xaa,
+	0x82, 0x09, 0xb9, 0xd0, 0x18, 0x0f, 0xce, 0x2b, 0x79, 0x3d, 0x71, 0x70, 0xd7, 0xa2, 0xc1, 0xf9,
+	0x81, 0xad, 0xf9, 0x81, 0xf1, 0xa8, 0xfe, 0x4e, 0x41, 0xd9, 0x89, 0xca, 0xe8, 0x6b, 0xcc, 0x05,
+	0x61, 0x2e, 0x02, 0xdc, 0x8b, 0x10, 0xe0, 0xe6, 0xcc, 0x04, 0x08, 0x03, 0x88, 0xb0, 0xe0, 0x5d,
+	0x58, 0xf3, 0xc1, 0x6c, 0x75, 0x55, 0x9d, 0x0e, 0x5c, 0x2e, 0x64, 0x6b, 0xd7, 0xc7, 0xb6, 0xb8,
+	0x71, 0x1a, 0x6c, 0x6f, 0x1f, 0x93, 0x17, 0x38, 0xe4, 0xfb, 0xce, 0xeb, 0xa5, 0xe3, 0xfe, 0x2b,
+	0x82, 0xad, 0xe9, 0x15, 0xbe, 0x5c, 0x06, 0x3c, 0x4d, 0xc1, 0xc6, 0xb3, 0xbc, 0x6c, 0x5a, 0x2c,
+	0x09, 0xf0, 0x8d, 0x08, 0xf0, 0xf2, 0x8c, 0xc0, 0x37, 0xad, 0x58, 0xd0, 0xbf, 0x84, 0x17, 0x03,
+	0x50, 0x07, 0xca, 0xc8, 0xaf, 0x85, 0x87, 0xfc, 0xdd, 0xc4, 0xb5, 0x28, 0x45, 0x78, 0x12, 0x9a,
+	0xc4, 0x24, 0xc7, 0xc9, 0xd2, 0x50, 0x46, 0x5e, 0x48, 0xc2, 0xc7, 0x90, 0x0d, 0xaa, 0x56, 0x4c,
+	0x9f, 0xd7, 0x78, 0x8a, 0xbc, 0xf1, 0xe4, 0x22, 0xf5, 0xc6, 0x64, 0xd9, 0x2f, 0x34, 0xfe, 0x1e,
+	0xc1, 0x4b, 0xb1, 0x25, 0x0e, 0x90, 0x37, 0x60, 0x3d, 0x88, 0xee, 0x14, 0xf0, 0x1f, 0x26, 0x4e,
+	0xf6, 0x6a, 0x24, 0x59, 0x3f, 0xd1, 0x55, 0x9e, 0x28, 0x87, 0xfd, 0x9f, 0x14, 0x88, 0xd3, 0x68,
+	0x99, 0x90, 0x00, 0x24, 0x42, 0x80, 0x5b, 0xb3, 0x13, 0xe0, 0xcc, 0xa3, 0x5f, 0x83, 0xf5, 0x90,
+	0xbe, 0x93, 0x67, 0xbf, 0x14, 0x4d, 0x33, 0x10, 0xf0, 0xd3, 0x6c, 0x5a, 0xcc, 0x3b, 0xfd, 0x67,
+	0x30, 0x29, 0xfd, 0x3c, 0x98, 0x84, 0x7f, 0x46, 0xf0, 0xea, 0x39, 0x35, 0xbe, 0x44, 0x06, 0xfc,
+	0x9b, 0x86, 0xe5, 0x86, 0xd9, 0xbb, 0xaf, 0x18, 0xc9, 0x9a, 0xfc, 0xeb, 0xb0, 0xe4, 0x60, 0xda,
+	0xd2, 0xba, 0xc5, 0x54, 0x05, 0x55, 0xd3, 0x35, 0x61, 0x6c, 0x8b, 0x6b, 0x9e, 0x2c, 0xdf, 0xc0,
+	0x24, 0xe3, 0x3c, 0xd5, 0xbb, 0x17, 0x7d, 0x89, 0x87, 0x13, 0x46, 0xfa, 0x02, 0x26, 0x8c, 0x47,
+	0x50, 0x30, 0x1f, 0x28, 0x43, 0x35, 0x7a, 0x35, 0x5c, 0x99, 0xef, 0x6a, 0x88, 0xb3, 0x89, 0x49,
+	0xde, 0x5d, 0x9e, 0xbc, 0x1a, 0x84, 0xaf, 0xa0, 0xd0, 0xd7, 0xbe, 0xb0, 0xb4, 0xae, 0xc6, 0x8e,
+	0x26, 0xfd, 0x67, 0x12, 0xfb, 0xdf, 0x57, 0x3b, 0xa1, 0xff, 0x38, 0x9b, 0x98, 0x08, 0xc1, 0x72,
+	0x18, 0xc0, 0x2d, 0x80, 0x3e, 0x3d, 0x54, 0x87, 0x2d, 0xa6, 0x75, 0x0e, 0x8a, 0x4b, 0x15, 0x54,
+	0x5d, 0xac, 0x6d, 0x8c, 0x6d, 0x31, 0xcf, 0x0d, 0x05, 0x7b, 0x98, 0x64, 0xdd, 0x97, 0x4f, 0xb5,
+	0xce, 0x81, 0xa3, 0x65, 0x19, 0x86, 0xaf, 0xb5, 0x1c, 0xd5, 0x0a, 0xf7, 0x30, 0xc9, 0xba, 0x2f,
+	0x8e, 0x16, 0xfe, 0x23, 0x05, 0x39, 0x9f, 0x6e, 0x93, 0x37, 0x5e, 0x58, 0xad, 0x8b, 0xb9, 0xf1,
+	0xa2, 0xf6, 0x30, 0x59, 0xf3, 0x2b, 0xcf, 0xb3, 0xbe, 0x0d, 0x2b, 0x06, 0x35, 0x35, 0xa6, 0x51,
+	0x3d, 0x24, 0xf1, 0xd5, 0xb1, 0x2d, 0x0a, 0x3e, 0x89, 0x83, 0x4d, 0x4c, 0xc0, 0x7f, 0xab, 0x77,
+	0x85, 0x43, 0xc8, 0x87, 0xb5, 0xed, 0x0c, 0x55, 0x85, 0xa9, 0x5d, 0xde, 0x92, 0x3e, 0x4a, 0x0c,
+	0x56, 0x31, 0x0a, 0x16, 0x37, 0x88, 0x49, 0x2e, 0x58, 0xdb, 0xe3, 0x4b, 0x3f, 0xa5, 0x21, 0xeb,
+	0xd5, 0x2e, 0x61, 0x5b, 0x4e, 0x74, 0x56, 0x0d, 0x58, 0xf7, 0x8a, 0x17, 0x32, 0x71, 0x71, 0xbe,
+	0x16, 0x14, 0x31, 0x87, 0xc9, 0xaa, 0xbb, 0x52, 0xd7, 0xe3, 0x91, 0x48, 0xcf, 0x8c, 0x44, 0xcc,
+	0xd5, 0x70, 0x25, 0xe9, 0xd5, 0x70, 0xd6, 0x60, 0x98, 0x79, 0x3e, 0x83, 0xa1, 0xf0, 0x41, 0xd0,
+	0xcb, 0x96, 0xdc, 0x5e, 0x56, 0x9d, 0xda, 0xcb, 0x3c, 0xec, 0x63, 0xba, 0x18, 0xfe, 0x05, 0xc1,
+	0xca, 0xc4, 0x6e, 0xcc, 0xc4, 0x8c, 0x92, 0x4d, 0xcc, 0x17, 0xfa, 0x19, 0x87, 0xbf, 0x45, 0x90,
+	0x0f, 0xb8, 0x7b, 0xa9, 0xa3, 0xee, 0xee, 0x8f, 0x19, 0x58, 0x6c, 0x98, 0x3d, 0xe1, 0x6b, 0x04,
+	0xf9, 0x67, 0xbf, 0x73, 0x76, 0xa6, 0xa6, 0x19, 0xf7, 0xe9, 0x56, 0x7a, 0x2b, 0xb1, 0x4a, 0x50,
+	0x80, 0x6f, 0x10, 0x08, 0x31, 0x23, 0xd7, 0x6e, 0x42, 0x8b, 0x4d, 0x8b, 0x95, 0xee, 0x24, 0xd7,
+	0x09, 0xc2, 0xf8, 0x0d, 0xc1, 0xe6, 0xb4, 0x8f, 0xbf, 0xb7, 0xcf, 0xb5, 0x7d, 0xb6, 0x72, 0x69,
+	0x6f, 0x0e, 0xe5, 0x20, 0xc2, 0xdf, 0x11, 0xdc, 0x98, 0x3a, 0xa5, 0xbe, 0xf3, 0xbf, 0xbd, 0x38,
+	0xc5, 0xdb, 0x9f, 0x47, 0x3b, 0x08, 0xf2, 0x33, 0xb8, 0xe2, 0xcd, 0x51, 0xaf, 0x9c, 0x67, 0xce,
+	0x15, 0x2b, 0x6d, 0xcf, 0x24, 0x16, 0x98, 0xff, 0x1c, 0x32, 0xbc, 0xf7, 0x6f, 0xcd, 0xa0, 0xe8,
+	0xa4, 0x25, 0xcd, 0x26, 0xe7, 0x7b, 0xa8, 0xdd, 0x7b, 0x7c, 0x5c, 0x46, 0x4f, 0x8e, 0xcb, 0xe8,
+	0xe9, 0x71, 0x19, 0x7d, 0x77, 0x52, 0x5e, 0x78, 0x72, 0x52, 0x5e, 0xf8, 0xf3, 0xa4, 0xbc, 0x70,
+	0xff, 0xf6, 0xc4, 0x39, 0xe4, 0x36, 0xb7, 0xfb, 0x4a, 0xdb, 0xf4, 0x5f, 0xe4, 0x87, 0x3b, 0x6f,
+	0xca, 0xa3, 0x53, 0x7f, 0x32, 0xb9, 0x87, 0xb3, 0x9d, 0x71, 0xff, 0x58, 0xba, 0xf9, 0xdf, 0x00,
+	0x9e, 0xe8, 0xbb, 0x1d, 0x01, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -513,6 +866,8 @@ type MsgClient interface {
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	ZapIn(ctx context.Context, in *MsgZapIn, opts ...grpc.CallOption) (*MsgZapInResponse, error)
+	ZapOut(ctx context.Context, in *MsgZapOut, opts ...grpc.CallOption) (*MsgZapOutResponse, error)
 }
 
 type msgClient struct {
@@ -559,12 +914,32 @@ func (c *msgClient) SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSpl
 	return out, nil
 }
 
+func (c *msgClient) ZapIn(ctx context.Context, in *MsgZapIn, opts ...grpc.CallOption) (*MsgZapInResponse, error) {
+	out := new(MsgZapInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/ZapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ZapOut(ctx context.Context, in *MsgZapOut, opts ...grpc.CallOption) (*MsgZapOutResponse, error) {
+	out := new(MsgZapOutResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/ZapOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	ZapIn(context.Context, *MsgZapIn) (*MsgZapInResponse, error)
+	ZapOut(context.Context, *MsgZapOut) (*MsgZapOutResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +958,12 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context, req *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) ZapIn(ctx context.Context, req *MsgZapIn) (*MsgZapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZapIn not implemented")
+}
+func (*UnimplementedMsgServer) ZapOut(ctx context.Context, req *MsgZapOut) (*MsgZapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZapOut not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -660,6 +1041,42 @@ func _Msg_SplitRouteSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ZapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgZapIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ZapIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/ZapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ZapIn(ctx, req.(*MsgZapIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ZapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgZapOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ZapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/ZapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ZapOut(ctx, req.(*MsgZapOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -680,6 +1097,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitRouteSwapExactAmountOut",
 			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "ZapIn",
+			Handler:    _Msg_ZapIn_Handler,
+		},
+		{
+			MethodName: "ZapOut",
+			Handler:    _Msg_ZapOut_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1067,36 +1492,331 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgZapIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgZapIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LiquidityMinAmount.Size()
+		i -= size
+		if _, err := m.LiquidityMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.TokenIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOutMinAmount.Size()
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZapOutRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZapOutRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZapOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgZapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgZapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgZapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
@@ -1198,33 +1918,946 @@ func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TokenInMaxAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgZapIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *MsgZapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgZapOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ZapOutRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgZapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1247,10 +2880,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1314,16 +2947,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1333,28 +2966,27 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1382,7 +3014,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1407,7 +3039,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1430,15 +3062,15 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1466,7 +3098,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1491,7 +3123,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgZapIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1514,10 +3146,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgZapIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgZapIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1553,6 +3185,58 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
@@ -1581,14 +3265,14 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1616,11 +3300,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1648,10 +3334,48 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1673,7 +3397,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgZapInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,28 +3407,81 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgZapInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgZapInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1732,7 +3509,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1757,7 +3534,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgZapOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +3557,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgZapOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgZapOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1819,10 +3596,29 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1832,29 +3628,48 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1882,15 +3697,13 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1900,80 +3713,31 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1983,23 +3747,23 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, ZapOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2024,7 +3788,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *ZapOutRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2047,15 +3811,15 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZapOutRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZapOutRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2083,7 +3847,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2114,77 +3878,11 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2206,7 +3904,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgZapOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,15 +3927,15 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgZapOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgZapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2265,7 +3963,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,299 @@
+package poolmanager
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/math"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// ZapIn swaps tokenIn into one of the assets of the pool with the given id and joins the pool with
+// the swapped amount in a single step.
+// Routes must be given unless tokenIn is one of the pool assets, and must end with one of the pool assets.
+// Intermediary swaps are only guarded against returning zero. Price impact protection is performed
+// on the final join:
+//   - balancer and stableswap pools are joined single-sided, returning at least shareOutMinAmount shares.
+//   - concentrated liquidity pools have part of the swapped amount swapped into the other pool asset,
+//     in the ratio of the assets of a position in the given tick range at the current price,
+//     creating a position with at least liquidityMinAmount liquidity in that range.
+//     If both ticks are zero, a full range position is created.
+//
+// Any amount not consumed by the concentrated liquidity position remains in the sender's account.
+// Returns error if:
+//   - the pool does not exist or is not a balancer, stableswap or concentrated liquidity pool.
+//   - the routes do not end with one of the pool assets.
+//   - no routes are given and tokenIn is not one of the pool assets.
+//   - any of the swaps or the join fail.
+//   - the shares or liquidity created are smaller than the given minimums.
+func (k Keeper) ZapIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	routes []types.SwapAmountInRoute,
+	shareOutMinAmount sdk.Int,
+	liquidityMinAmount sdk.Dec,
+	lowerTick, upperTick int64,
+) (shareOutAmount sdk.Int, positionId uint64, liquidityCreated sdk.Dec, err error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, 0, sdk.Dec{}, err
+	}
+
+	poolType := pool.GetType()
+	if poolType != types.Balancer && poolType != types.Stableswap && poolType != types.Concentrated {
+		return sdk.Int{}, 0, sdk.Dec{}, types.UnsupportedZapPoolTypeError{PoolType: poolType, PoolId: poolId}
+	}
+
+	poolDenoms, err := k.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, 0, sdk.Dec{}, err
+	}
+
+	poolAssetIn, err := k.swapIntoPoolAsset(ctx, sender, poolId, poolDenoms, tokenIn, routes)
+	if err != nil {
+		return sdk.Int{}, 0, sdk.Dec{}, err
+	}
+
+	if poolType == types.Concentrated {
+		positionId, liquidityCreated, err = k.zapIntoConcentratedPosition(ctx, sender, poolId, poolDenoms, poolAssetIn, liquidityMinAmount, lowerTick, upperTick)
+		if err != nil {
+			return sdk.Int{}, 0, sdk.Dec{}, err
+		}
+		return sdk.ZeroInt(), positionId, liquidityCreated, nil
+	}
+
+	shareOutAmount, err = k.gammKeeper.JoinSwapExactAmountIn(ctx, sender, poolId, sdk.NewCoins(poolAssetIn), shareOutMinAmount)
+	if err != nil {
+		return sdk.Int{}, 0, sdk.Dec{}, err
+	}
+	return shareOutAmount, 0, sdk.ZeroDec(), nil
+}
+
+// ZapOut exits the pool with the given id and swaps every withdrawn asset into tokenOutDenom in a single step.
+// Balancer and stableswap pools are exited with shareInAmount shares. For concentrated liquidity pools,
+// all the liquidity of the position with the given id is withdrawn.
+// Every withdrawn asset other than tokenOutDenom is swapped using the given route with its denom as token in denom.
+// Intermediary swaps are only guarded against returning zero. Price impact protection is performed
+// on the total amount of tokenOutDenom received.
+// Returns error if:
+//   - the pool does not exist or is not a balancer, stableswap or concentrated liquidity pool.
+//   - the position does not belong to the pool.
+//   - no route is given from a withdrawn asset into tokenOutDenom.
+//   - the exit or any of the swaps fail.
+//   - the total amount out is smaller than tokenOutMinAmount.
+func (k Keeper) ZapOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	positionId uint64,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	routes []types.ZapOutRoute,
+) (tokenOutAmount sdk.Int, err error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	var exitCoins sdk.Coins
+	switch poolType := pool.GetType(); poolType {
+	case types.Balancer, types.Stableswap:
+		exitCoins, err = k.gammKeeper.ExitPool(ctx, sender, poolId, shareInAmount, sdk.Coins{})
+	case types.Concentrated:
+		exitCoins, err = k.withdrawConcentratedPosition(ctx, sender, poolId, positionId)
+	default:
+		return sdk.Int{}, types.UnsupportedZapPoolTypeError{PoolType: poolType, PoolId: poolId}
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, exitCoin := range exitCoins {
+		if exitCoin.Denom == tokenOutDenom {
+			tokenOutAmount = tokenOutAmount.Add(exitCoin.Amount)
+			continue
+		}
+
+		route, err := getZapOutRoute(routes, exitCoin.Denom, tokenOutDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swappedAmount, err := k.RouteExactAmountIn(ctx, sender, route, exitCoin, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(swappedAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.PriceImpactProtectionExactInError{Actual: tokenOutAmount, MinAmount: tokenOutMinAmount}
+	}
+
+	return tokenOutAmount, nil
+}
+
+// swapIntoPoolAsset swaps tokenIn into one of the given pool denoms through the given routes and returns
+// the swapped coin. If no routes are given, tokenIn is returned as is if it is already a pool asset.
+func (k Keeper) swapIntoPoolAsset(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolDenoms []string, tokenIn sdk.Coin, routes []types.SwapAmountInRoute) (sdk.Coin, error) {
+	if len(routes) == 0 {
+		if osmoutils.Contains(poolDenoms, tokenIn.Denom) {
+			return tokenIn, nil
+		}
+		return sdk.Coin{}, types.MissingZapRouteError{TokenInDenom: tokenIn.Denom, PoolId: poolId}
+	}
+
+	tokenOutDenom := routes[len(routes)-1].TokenOutDenom
+	if !osmoutils.Contains(poolDenoms, tokenOutDenom) {
+		return sdk.Coin{}, types.InvalidZapRouteError{PoolId: poolId, TokenOutDenom: tokenOutDenom}
+	}
+
+	tokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, routes, tokenIn, sdk.OneInt())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenOutAmount), nil
+}
+
+// getZapOutRoute returns the routes of the zap out route with the given token in denom.
+// Returns error if there is no such route.
+func getZapOutRoute(routes []types.ZapOutRoute, tokenInDenom, tokenOutDenom string) ([]types.SwapAmountInRoute, error) {
+	for _, route := range routes {
+		if route.TokenInDenom == tokenInDenom {
+			return route.Routes, nil
+		}
+	}
+	return nil, types.MissingZapOutRouteError{TokenInDenom: tokenInDenom, TokenOutDenom: tokenOutDenom}
+}
+
+// zapIntoConcentratedPosition swaps part of tokenIn into the other asset of the concentrated liquidity pool
+// with the given id, as given by concentratedZapSwapAmount, and creates a position with both assets, or with
+// a single one if the tick range is entirely above or below the current tick. If both ticks are zero, a full
+// range position is created. Returns error if the liquidity created is smaller than liquidityMinAmount.
+func (k Keeper) zapIntoConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, poolDenoms []string, tokenIn sdk.Coin, liquidityMinAmount sdk.Dec, lowerTick, upperTick int64) (positionId uint64, liquidityCreated sdk.Dec, err error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+	concentratedPool, ok := pool.(cltypes.ConcentratedPoolExtension)
+	if !ok {
+		return 0, sdk.Dec{}, fmt.Errorf("pool %d is not a concentrated liquidity pool", poolId)
+	}
+
+	otherDenom := poolDenoms[0]
+	if otherDenom == tokenIn.Denom {
+		otherDenom = poolDenoms[1]
+	}
+
+	positionLowerTick, positionUpperTick := lowerTick, upperTick
+	if lowerTick == 0 && upperTick == 0 {
+		positionLowerTick, positionUpperTick = cltypes.MinTick, cltypes.MaxTick
+	}
+	swapAmount, err := concentratedZapSwapAmount(concentratedPool, tokenIn, positionLowerTick, positionUpperTick)
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+
+	otherAmount := sdk.ZeroInt()
+	if swapAmount.IsPositive() {
+		otherAmount, err = k.RouteExactAmountIn(ctx, sender, []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: otherDenom}}, sdk.NewCoin(tokenIn.Denom, swapAmount), sdk.OneInt())
+		if err != nil {
+			return 0, sdk.Dec{}, err
+		}
+	}
+
+	// The amounts transferred into a position are rounded up, so one unit of each asset is
+	// held back to guarantee that the position can be funded with the swapped amounts only.
+	tokensProvided := sdk.NewCoins()
+	for _, coin := range []sdk.Coin{sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.Sub(swapAmount)), sdk.NewCoin(otherDenom, otherAmount)} {
+		if coin.Amount.GT(sdk.OneInt()) {
+			tokensProvided = tokensProvided.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(sdk.OneInt())))
+		}
+	}
+	if lowerTick == 0 && upperTick == 0 {
+		positionId, _, _, liquidityCreated, err = k.concentratedKeeper.CreateFullRangePosition(ctx, poolId, sender, tokensProvided)
+	} else {
+		positionId, _, _, liquidityCreated, _, _, err = k.concentratedKeeper.CreatePosition(ctx, poolId, sender, tokensProvided, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+	}
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+
+	if liquidityCreated.LT(liquidityMinAmount) {
+		return 0, sdk.Dec{}, types.InsufficientZapLiquidityError{Actual: liquidityCreated, Minimum: liquidityMinAmount}
+	}
+
+	return positionId, liquidityCreated, nil
+}
+
+// concentratedZapSwapAmount returns the amount of tokenIn to swap into the other asset of the given concentrated
+// liquidity pool so that both assets are in the ratio of the assets of a position between the given ticks at the
+// current sqrt price. The ratio ignores the price impact and spread factor of the swap itself.
+// If the range is entirely above the current price, the position only holds token0, and if it is entirely below,
+// only token1, so either none or all of tokenIn is swapped.
+func concentratedZapSwapAmount(pool cltypes.ConcentratedPoolExtension, tokenIn sdk.Coin, lowerTick, upperTick int64) (sdk.Int, error) {
+	_, _, sqrtPriceLower, sqrtPriceUpper, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+
+	// The fraction of the value of the position, in token1, that is held in token0.
+	var token0Fraction sdk.Dec
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		token0Fraction = sdk.OneDec()
+	case sqrtPrice.GTE(sqrtPriceUpper):
+		token0Fraction = sdk.ZeroDec()
+	default:
+		// Per unit of liquidity, the position holds (1/sqrtPrice - 1/sqrtPriceUpper) token0, worth
+		// sqrtPrice^2 token1 each, and (sqrtPrice - sqrtPriceLower) token1.
+		token0Value := sqrtPriceUpper.Sub(sqrtPrice).Mul(sqrtPrice).Quo(sqrtPriceUpper)
+		token1Value := sqrtPrice.Sub(sqrtPriceLower)
+		token0Fraction = token0Value.Quo(token0Value.Add(token1Value))
+	}
+
+	swapFraction := token0Fraction
+	if tokenIn.Denom == pool.GetToken0() {
+		swapFraction = sdk.OneDec().Sub(token0Fraction)
+	}
+	return swapFraction.MulInt(tokenIn.Amount).TruncateInt(), nil
+}
+
+// withdrawConcentratedPosition withdraws all the liquidity of the position with the given id,
+// which must belong to the concentrated liquidity pool with the given id.
+// Returns the withdrawn coins.
+func (k Keeper) withdrawConcentratedPosition(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64) (sdk.Coins, error) {
+	positionPoolId, err := k.concentratedKeeper.GetPositionPoolId(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+	if positionPoolId != poolId {
+		return nil, types.PositionNotInPoolError{PositionId: positionId, PoolId: poolId}
+	}
+
+	liquidity, err := k.concentratedKeeper.GetPositionLiquidity(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	poolDenoms, err := k.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := k.concentratedKeeper.WithdrawPosition(ctx, sender, positionId, liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(poolDenoms[0], amount0), sdk.NewCoin(poolDenoms[1], amount1)), nil
+}
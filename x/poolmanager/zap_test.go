@@ -0,0 +1,347 @@
+package poolmanager_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v16/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+var (
+	zapPoolCoins  = sdk.NewCoins(sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000_000)), sdk.NewCoin(apptesting.USDC, sdk.NewInt(1_000_000_000)))
+	zapRouteCoins = sdk.NewCoins(sdk.NewCoin(apptesting.FOO, sdk.NewInt(1_000_000_000)), sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000_000)))
+	zapExitCoins  = sdk.NewCoins(sdk.NewCoin(apptesting.FOO, sdk.NewInt(1_000_000_000)), sdk.NewCoin(apptesting.USDC, sdk.NewInt(1_000_000_000)))
+)
+
+// setupZapPools creates the target pool of the given type with ETH and USDC, followed by
+// a FOO/ETH and a FOO/USDC balancer pool used to route into and out of the target pool.
+// Returns the target pool id, the FOO/ETH pool id and the FOO/USDC pool id.
+func (s *KeeperTestSuite) setupZapPools(poolType types.PoolType) (targetPoolId, routePoolId, exitRoutePoolId uint64) {
+	switch poolType {
+	case types.Balancer:
+		targetPoolId = s.PrepareBalancerPoolWithCoins(zapPoolCoins...)
+	case types.Concentrated:
+		targetPoolId = s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(apptesting.ETH, apptesting.USDC).GetId()
+	default:
+		s.FailNow("unsupported pool type")
+	}
+	routePoolId = s.PrepareBalancerPoolWithCoins(zapRouteCoins...)
+	exitRoutePoolId = s.PrepareBalancerPoolWithCoins(zapExitCoins...)
+	return targetPoolId, routePoolId, exitRoutePoolId
+}
+
+func (s *KeeperTestSuite) TestZapIn() {
+	tokenIn := sdk.NewCoin(apptesting.FOO, sdk.NewInt(1_000_000))
+	ethRoute := func(routePoolId uint64) []types.SwapAmountInRoute {
+		return []types.SwapAmountInRoute{{PoolId: routePoolId, TokenOutDenom: apptesting.ETH}}
+	}
+
+	tests := map[string]struct {
+		poolType           types.PoolType
+		tokenIn            sdk.Coin
+		routes             func(routePoolId uint64) []types.SwapAmountInRoute
+		shareOutMinAmount  sdk.Int
+		liquidityMinAmount sdk.Dec
+		lowerTick          int64
+		upperTick          int64
+
+		expectedError error
+	}{
+		"balancer: token in is a pool asset": {
+			poolType: types.Balancer,
+			tokenIn:  sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000)),
+		},
+		"balancer: given route": {
+			poolType: types.Balancer,
+			tokenIn:  tokenIn,
+			routes:   ethRoute,
+		},
+		"concentrated: full range position": {
+			poolType: types.Concentrated,
+			tokenIn:  tokenIn,
+			routes:   ethRoute,
+		},
+		"concentrated: position in tick range": {
+			poolType:  types.Concentrated,
+			tokenIn:   tokenIn,
+			routes:    ethRoute,
+			lowerTick: -500_000,
+			upperTick: 500_000,
+		},
+		"concentrated: position in asymmetric tick range": {
+			poolType:  types.Concentrated,
+			tokenIn:   tokenIn,
+			routes:    ethRoute,
+			lowerTick: -100_000,
+			upperTick: 9_000_000,
+		},
+		"concentrated: single-sided position above the current price": {
+			poolType:  types.Concentrated,
+			tokenIn:   tokenIn,
+			routes:    ethRoute,
+			lowerTick: 100,
+			upperTick: 500_000,
+		},
+		"concentrated: single-sided position below the current price": {
+			poolType:  types.Concentrated,
+			tokenIn:   tokenIn,
+			routes:    ethRoute,
+			lowerTick: -500_000,
+			upperTick: -100,
+		},
+		"error: route does not end with a pool asset": {
+			poolType: types.Balancer,
+			tokenIn:  sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000)),
+			routes: func(routePoolId uint64) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: routePoolId, TokenOutDenom: apptesting.FOO}}
+			},
+			expectedError: types.InvalidZapRouteError{TokenOutDenom: apptesting.FOO},
+		},
+		"error: no route given": {
+			poolType:      types.Balancer,
+			tokenIn:       tokenIn,
+			expectedError: types.MissingZapRouteError{TokenInDenom: apptesting.FOO, PoolId: 1},
+		},
+		"error: shares out below minimum": {
+			poolType:          types.Balancer,
+			tokenIn:           tokenIn,
+			routes:            ethRoute,
+			shareOutMinAmount: sdk.NewIntWithDecimal(1, 30),
+			expectedError:     gammtypes.ErrLimitMinAmount,
+		},
+		"error: liquidity created below minimum": {
+			poolType:           types.Concentrated,
+			tokenIn:            tokenIn,
+			routes:             ethRoute,
+			liquidityMinAmount: sdk.NewDec(1_000_000_000),
+			expectedError:      types.InsufficientZapLiquidityError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			targetPoolId, routePoolId, _ := s.setupZapPools(tc.poolType)
+
+			sender := s.TestAccs[1]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+
+			var routes []types.SwapAmountInRoute
+			if tc.routes != nil {
+				routes = tc.routes(routePoolId)
+			}
+			shareOutMinAmount := sdk.ZeroInt()
+			if !tc.shareOutMinAmount.IsNil() {
+				shareOutMinAmount = tc.shareOutMinAmount
+			}
+			liquidityMinAmount := sdk.ZeroDec()
+			if !tc.liquidityMinAmount.IsNil() {
+				liquidityMinAmount = tc.liquidityMinAmount
+			}
+
+			shareOutAmount, positionId, liquidityCreated, err := s.App.PoolManagerKeeper.ZapIn(s.Ctx, sender, targetPoolId, tc.tokenIn, routes, shareOutMinAmount, liquidityMinAmount, tc.lowerTick, tc.upperTick)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				if !errors.Is(err, tc.expectedError) {
+					s.Require().IsType(tc.expectedError, err)
+				}
+				return
+			}
+			s.Require().NoError(err)
+
+			if tc.poolType == types.Concentrated {
+				s.Require().True(shareOutAmount.IsZero())
+				s.Require().True(liquidityCreated.IsPositive())
+
+				positionLiquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(liquidityCreated, positionLiquidity)
+
+				// Almost all of the input is used by the position, whatever the range.
+				for _, denom := range []string{apptesting.ETH, apptesting.USDC} {
+					s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, denom).Amount.LT(sdk.NewInt(10_000)), denom)
+				}
+				return
+			}
+
+			s.Require().Equal(uint64(0), positionId)
+			s.Require().True(shareOutAmount.IsPositive())
+			s.Require().Equal(shareOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, sender, gammtypes.GetPoolShareDenom(targetPoolId)).Amount)
+			// The input token is fully consumed.
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenIn.Denom).Amount.IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConcentratedZapSwapAmount() {
+	tokenIn := sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000))
+
+	tests := map[string]struct {
+		tokenIn              sdk.Coin
+		lowerTick, upperTick int64
+
+		expectedSwapAmount sdk.Int
+	}{
+		// the min and max sqrt prices are not exactly zero and infinite, so slightly less than half is swapped.
+		"full range: about half is swapped": {
+			tokenIn:            tokenIn,
+			lowerTick:          cltypes.MinTick,
+			upperTick:          cltypes.MaxTick,
+			expectedSwapAmount: sdk.NewInt(499_999),
+		},
+		"range above the current price, token in is token0: nothing is swapped": {
+			tokenIn:            tokenIn,
+			lowerTick:          100,
+			upperTick:          500_000,
+			expectedSwapAmount: sdk.ZeroInt(),
+		},
+		"range above the current price, token in is token1: everything is swapped": {
+			tokenIn:            sdk.NewCoin(apptesting.USDC, sdk.NewInt(1_000_000)),
+			lowerTick:          100,
+			upperTick:          500_000,
+			expectedSwapAmount: sdk.NewInt(1_000_000),
+		},
+		"range below the current price, token in is token0: everything is swapped": {
+			tokenIn:            tokenIn,
+			lowerTick:          -500_000,
+			upperTick:          -100,
+			expectedSwapAmount: sdk.NewInt(1_000_000),
+		},
+		"range below the current price, token in is token1: nothing is swapped": {
+			tokenIn:            sdk.NewCoin(apptesting.USDC, sdk.NewInt(1_000_000)),
+			lowerTick:          -500_000,
+			upperTick:          -100,
+			expectedSwapAmount: sdk.ZeroInt(),
+		},
+		// sqrt prices of 0.5 and 2 around a sqrt price of 1, so the position holds 0.5 token0 and 0.5 token1 per unit of liquidity.
+		"asymmetric range in price, symmetric in sqrt price": {
+			tokenIn:            tokenIn,
+			lowerTick:          -7_500_000,
+			upperTick:          3_000_000,
+			expectedSwapAmount: sdk.NewInt(500_000),
+		},
+		// sqrt prices of 0.5 and sqrt(10) around a sqrt price of 1: token0 is worth 0.6838 and token1 0.5 per unit of liquidity.
+		"asymmetric range": {
+			tokenIn:            tokenIn,
+			lowerTick:          -7_500_000,
+			upperTick:          9_000_000,
+			expectedSwapAmount: sdk.NewInt(422_378),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(apptesting.ETH, apptesting.USDC).GetId()
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+
+			swapAmount, err := poolmanager.ConcentratedZapSwapAmount(pool, tc.tokenIn, tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSwapAmount, swapAmount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestZapOut() {
+	usdcRoutes := func(targetPoolId, _, _ uint64) []types.ZapOutRoute {
+		return []types.ZapOutRoute{{TokenInDenom: apptesting.ETH, Routes: []types.SwapAmountInRoute{{PoolId: targetPoolId, TokenOutDenom: apptesting.USDC}}}}
+	}
+	fooRoutes := func(_, routePoolId, exitRoutePoolId uint64) []types.ZapOutRoute {
+		return []types.ZapOutRoute{
+			{TokenInDenom: apptesting.ETH, Routes: []types.SwapAmountInRoute{{PoolId: routePoolId, TokenOutDenom: apptesting.FOO}}},
+			{TokenInDenom: apptesting.USDC, Routes: []types.SwapAmountInRoute{{PoolId: exitRoutePoolId, TokenOutDenom: apptesting.FOO}}},
+		}
+	}
+
+	tests := map[string]struct {
+		poolType          types.PoolType
+		tokenOutDenom     string
+		tokenOutMinAmount sdk.Int
+		routes            func(targetPoolId, routePoolId, exitRoutePoolId uint64) []types.ZapOutRoute
+		positionInPool    bool
+
+		expectedError error
+	}{
+		"balancer: token out is a pool asset": {
+			poolType:          types.Balancer,
+			tokenOutDenom:     apptesting.USDC,
+			tokenOutMinAmount: sdk.OneInt(),
+			routes:            usdcRoutes,
+		},
+		"balancer: token out is routed": {
+			poolType:          types.Balancer,
+			tokenOutDenom:     apptesting.FOO,
+			tokenOutMinAmount: sdk.OneInt(),
+			routes:            fooRoutes,
+		},
+		"concentrated: token out is routed": {
+			poolType:          types.Concentrated,
+			tokenOutDenom:     apptesting.FOO,
+			tokenOutMinAmount: sdk.OneInt(),
+			routes:            fooRoutes,
+			positionInPool:    true,
+		},
+		"error: position does not belong to pool": {
+			poolType:          types.Concentrated,
+			tokenOutDenom:     apptesting.FOO,
+			tokenOutMinAmount: sdk.OneInt(),
+			routes:            fooRoutes,
+			expectedError:     types.PositionNotInPoolError{},
+		},
+		"error: no route given for a withdrawn asset": {
+			poolType:          types.Balancer,
+			tokenOutDenom:     apptesting.FOO,
+			tokenOutMinAmount: sdk.OneInt(),
+			routes: func(targetPoolId, routePoolId, exitRoutePoolId uint64) []types.ZapOutRoute {
+				return fooRoutes(targetPoolId, routePoolId, exitRoutePoolId)[:1]
+			},
+			expectedError: types.MissingZapOutRouteError{},
+		},
+		"error: token out below minimum": {
+			poolType:          types.Balancer,
+			tokenOutDenom:     apptesting.USDC,
+			tokenOutMinAmount: sdk.NewIntWithDecimal(1, 30),
+			routes:            usdcRoutes,
+			expectedError:     types.PriceImpactProtectionExactInError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			targetPoolId, routePoolId, exitRoutePoolId := s.setupZapPools(tc.poolType)
+
+			// Join the target pool with the sender.
+			sender := s.TestAccs[1]
+			tokenIn := sdk.NewCoin(apptesting.ETH, sdk.NewInt(1_000_000))
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+			shareInAmount, positionId, _, err := s.App.PoolManagerKeeper.ZapIn(s.Ctx, sender, targetPoolId, tokenIn, nil, sdk.ZeroInt(), sdk.ZeroDec(), 0, 0)
+			s.Require().NoError(err)
+
+			poolId := targetPoolId
+			if tc.poolType == types.Concentrated && !tc.positionInPool {
+				poolId = s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(apptesting.ETH, apptesting.USDC).GetId()
+			}
+
+			tokenOutBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenOutDenom)
+
+			tokenOutAmount, err := s.App.PoolManagerKeeper.ZapOut(s.Ctx, sender, poolId, shareInAmount, positionId, tc.tokenOutDenom, tc.tokenOutMinAmount, tc.routes(targetPoolId, routePoolId, exitRoutePoolId))
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.IsPositive())
+
+			tokenOutBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, sender, tc.tokenOutDenom)
+			s.Require().Equal(tokenOutAmount, tokenOutBalanceAfter.Amount.Sub(tokenOutBalanceBefore.Amount))
+		})
+	}
+}
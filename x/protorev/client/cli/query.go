@@ -30,6 +30,10 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolWeightsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryDistributedProfitsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEpochStatisticsByDenomCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEpochStatisticsByRouteCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQuerySimulateBackrunCmd)
 
 	return cmd
 }
@@ -157,6 +161,42 @@ func NewQueryPoolCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevPoolReq
 	}, &types.QueryGetProtoRevPoolRequest{}
 }
 
+// NewQueryDistributedProfitsCmd returns the command to query the profits protorev has distributed to each destination
+func NewQueryDistributedProfitsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevDistributedProfitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "distributed-profits",
+		Short: "Query the profits protorev has distributed to each destination",
+	}, &types.QueryGetProtoRevDistributedProfitsRequest{}
+}
+
+// NewQueryEpochStatisticsByDenomCmd returns the command to query the per epoch statistics of protorev by base denom
+func NewQueryEpochStatisticsByDenomCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevEpochStatisticsByDenomRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "epoch-statistics-by-denom [denom] [from_epoch] [to_epoch]",
+		Short: "Query the per epoch statistics of a base denom (or all base denoms if empty) within a range of epochs (to_epoch 0 is the current epoch)",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} epoch-statistics-by-denom uosmo 100 0`,
+	}, &types.QueryGetProtoRevEpochStatisticsByDenomRequest{}
+}
+
+// NewQueryEpochStatisticsByRouteCmd returns the command to query the per epoch statistics of protorev by route
+func NewQueryEpochStatisticsByRouteCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevEpochStatisticsByRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:                "epoch-statistics-by-route [route] [from_epoch] [to_epoch]",
+		Short:              "Query the per epoch statistics of a route (or all routes if empty) within a range of epochs (to_epoch 0 is the current epoch)",
+		Long:               `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} epoch-statistics-by-route [1,2,3] 100 0`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Route": parseRoute},
+	}, &types.QueryGetProtoRevEpochStatisticsByRouteRequest{}
+}
+
+// NewQuerySimulateBackrunCmd returns the command to simulate the backrun of a hypothetical swap
+func NewQuerySimulateBackrunCmd() (*osmocli.QueryDescriptor, *types.QuerySimulateBackrunRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-backrun [pool_id] [token_in] [token_out_denom]",
+		Short: "Simulate the backrun ProtoRev would execute after a hypothetical swap, without changing any state",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} simulate-backrun 1 1000000uosmo uatom`,
+	}, &types.QuerySimulateBackrunRequest{}
+}
+
 // convert a string array "[1,2,3]" to []uint64
 func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
 	var route []uint64
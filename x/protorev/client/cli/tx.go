@@ -151,7 +151,8 @@ func CmdSetPoolWeights() *osmocli.TxCliDesc {
 		{
 			"stable_weight" : 1,
 			"balancer_weight" : 1,
-			"concentrated_weight" : 1
+			"concentrated_weight" : 1,
+			"cosmwasm_weight" : 1
 		}
 		`,
 		Example:          fmt.Sprintf(`$ %s tx protorev set-pool-weights weights.json --from mykey`, version.AppName),
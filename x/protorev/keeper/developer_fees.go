@@ -65,18 +65,18 @@ func (k Keeper) UpdateDeveloperFees(ctx sdk.Context, denom string, profit sdk.In
 	return nil
 }
 
-// SendDeveloperFee sends the developer fee from the module account to the developer account
-func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
+// SendDeveloperFee sends the developer fee from the module account to the developer account and returns it
+func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) (sdk.Coin, error) {
 	// Developer account must be set in order to be able to withdraw developer fees
 	developerAccount, err := k.GetDeveloperAccount(ctx)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	// Get the days since genesis
 	daysSinceGenesis, err := k.GetDaysSinceModuleGenesis(ctx)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	// Initialize the developer profit to 0
@@ -93,8 +93,8 @@ func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
 
 	// Send the developer profit to the developer account
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerAccount, sdk.NewCoins(devProfit)); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
-	return nil
+	return devProfit, nil
 }
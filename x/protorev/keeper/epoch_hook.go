@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -16,6 +19,12 @@ type LiquidityPoolStruct struct {
 	PoolId    uint64
 }
 
+// Struct used to represent an edge of the pool graph, connecting a denom to a neighbour denom through a pool
+type PoolGraphEdge struct {
+	Denom  string
+	PoolId uint64
+}
+
 var _ epochstypes.EpochHooks = EpochHooks{}
 
 func (k Keeper) EpochHooks() epochstypes.EpochHooks {
@@ -31,7 +40,7 @@ func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, ep
 func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if h.k.GetProtoRevEnabled(ctx) {
 		switch epochIdentifier {
-		case "day":
+		case types.EpochIdentifier:
 			// Increment number of days since module genesis to properly calculate developer fees after cyclic arbitrage trades
 			if daysSinceGenesis, err := h.k.GetDaysSinceModuleGenesis(ctx); err != nil {
 				h.k.SetDaysSinceModuleGenesis(ctx, 1)
@@ -39,8 +48,19 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 				h.k.SetDaysSinceModuleGenesis(ctx, daysSinceGenesis+1)
 			}
 
+			// Distribute the profits accumulated in the module account
+			h.k.DistributeProfits(ctx)
+
+			// Delete the per epoch statistics that are no longer retained, as of the epoch that is starting
+			h.k.PruneEpochStatistics(ctx, uint64(epochNumber)+1)
+
 			// Update the pools in the store
-			return h.k.UpdatePools(ctx)
+			if err := h.k.UpdatePools(ctx); err != nil {
+				return err
+			}
+
+			// Update the pool graph used to build graph routes
+			return h.k.UpdatePoolGraph(ctx)
 		}
 	}
 
@@ -126,3 +146,66 @@ func (k Keeper) compareAndStoreHighestLiquidityPool(denom string, pools map[stri
 		}
 	}
 }
+
+// UpdatePoolGraph deletes the pool graph and rebuilds it from all of the active two asset pools. Each denom is connected to at most
+// MaxPoolGraphDegree neighbour denoms, those with which it has the highest liquidity pools, through the highest liquidity pool of each pair.
+func (k Keeper) UpdatePoolGraph(ctx sdk.Context) error {
+	k.DeleteAllPoolGraphEdges(ctx)
+
+	pools, err := k.poolmanagerKeeper.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	// denomPools maps each denom to a map of the highest liquidity pool with each of its neighbour denoms
+	// ex. {osmo -> {atom : 100, weth : 200}, atom -> {osmo : 100}, weth -> {osmo : 200}}
+	denomPools := make(map[string]map[string]LiquidityPoolStruct)
+	for _, pool := range pools {
+		coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+
+		// Pool must be active and the number of coins must be 2
+		if !pool.IsActive(ctx) || len(coins) != 2 {
+			continue
+		}
+
+		newPool := LiquidityPoolStruct{
+			PoolId:    pool.GetId(),
+			Liquidity: coins[0].Amount.Mul(coins[1].Amount),
+		}
+
+		for i, coin := range coins {
+			if _, ok := denomPools[coin.Denom]; !ok {
+				denomPools[coin.Denom] = make(map[string]LiquidityPoolStruct)
+			}
+			k.compareAndStoreHighestLiquidityPool(coins[1-i].Denom, denomPools[coin.Denom], newPool)
+		}
+	}
+
+	for denom, neighbourPools := range denomPools {
+		neighbours := make([]string, 0, len(neighbourPools))
+		for neighbour := range neighbourPools {
+			neighbours = append(neighbours, neighbour)
+		}
+
+		// Keep the neighbours with the highest liquidity pools, breaking ties by denom to be deterministic
+		sort.Slice(neighbours, func(i, j int) bool {
+			liquidityI, liquidityJ := neighbourPools[neighbours[i]].Liquidity, neighbourPools[neighbours[j]].Liquidity
+			if !liquidityI.Equal(liquidityJ) {
+				return liquidityI.GT(liquidityJ)
+			}
+			return neighbours[i] < neighbours[j]
+		})
+		if len(neighbours) > types.MaxPoolGraphDegree {
+			neighbours = neighbours[:types.MaxPoolGraphDegree]
+		}
+
+		for _, neighbour := range neighbours {
+			k.SetPoolGraphEdge(ctx, denom, neighbour, neighbourPools[neighbour].PoolId)
+		}
+	}
+
+	return nil
+}
@@ -182,6 +182,45 @@ func (s *KeeperTestSuite) TestUpdateHighestLiquidityPools() {
 	}
 }
 
+// TestUpdatePoolGraph tests that each denom is connected to its neighbours with the highest liquidity pools in the pool graph.
+func (s *KeeperTestSuite) TestUpdatePoolGraph() {
+	err := s.App.ProtoRevKeeper.UpdatePoolGraph(s.Ctx)
+	s.Require().NoError(err)
+
+	// akash is paired with fewer denoms than the max degree, so all of them are neighbours
+	s.Require().Equal([]keeper.PoolGraphEdge{
+		{Denom: "Atom", PoolId: 1},
+		{Denom: "bitcoin", PoolId: 14},
+		{Denom: "canto", PoolId: 15},
+		{Denom: "ethereum", PoolId: 13},
+		{Denom: "juno", PoolId: 12},
+		{Denom: types.OsmosisDenomination, PoolId: 7},
+	}, s.App.ProtoRevKeeper.GetPoolGraphEdges(s.Ctx, "akash"))
+
+	poolId, err := s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "bitcoin")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(14), poolId)
+
+	_, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "usdc")
+	s.Require().Error(err)
+
+	// osmo is paired with more denoms than the max degree
+	osmoEdges := s.App.ProtoRevKeeper.GetPoolGraphEdges(s.Ctx, types.OsmosisDenomination)
+	s.Require().Len(osmoEdges, types.MaxPoolGraphDegree)
+	for _, edge := range osmoEdges {
+		pool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, edge.PoolId)
+		s.Require().NoError(err)
+		s.Require().True(pool.IsActive(s.Ctx))
+	}
+
+	// Updating the pool graph again deletes the previous edges
+	s.App.ProtoRevKeeper.SetPoolGraphEdge(s.Ctx, "akash", "usdc", 1)
+	err = s.App.ProtoRevKeeper.UpdatePoolGraph(s.Ctx)
+	s.Require().NoError(err)
+	_, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "usdc")
+	s.Require().Error(err)
+}
+
 func contains(baseDenoms []types.BaseDenom, denomToMatch string) bool {
 	for _, baseDenom := range baseDenoms {
 		if baseDenom.Denom == denomToMatch {
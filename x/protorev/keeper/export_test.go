@@ -0,0 +1,9 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) BuildGraphRoutesWithMaxSearchNodes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, maxSearchNodes int) ([]RouteMetaData, error) {
+	return k.buildGraphRoutes(ctx, tokenIn, tokenOut, poolId, maxSearchNodes)
+}
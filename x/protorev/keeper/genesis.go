@@ -37,6 +37,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	// Build the pool graph on genesis.
+	if err := k.UpdatePoolGraph(ctx); err != nil {
+		panic(err)
+	}
+
 	// --------------- Developer set up ----------------- //
 	// Set the developer address if it exists.
 	if genState.DeveloperAddress != "" {
@@ -87,6 +92,40 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	// Set the profits made on each pool since the last distribution to liquidity providers.
+	for _, poolProfit := range genState.PoolProfitsForDistribution {
+		if err := k.UpdatePoolProfitsForDistribution(ctx, []uint64{poolProfit.PoolId}, poolProfit.Profit.Denom, poolProfit.Profit.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the profits that have been distributed to each destination, alongside their value in uosmo.
+	for _, distributedProfits := range genState.DistributedProfits {
+		if err := k.SetDistributedProfits(ctx, distributedProfits); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the per epoch statistics.
+	for _, statistics := range genState.EpochDenomStatistics {
+		if err := k.SetEpochStatisticsByDenom(ctx, statistics); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, statistics := range genState.EpochRouteStatistics {
+		if err := k.SetEpochStatisticsByRoute(ctx, statistics); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the profits that are yet to be distributed.
+	for _, profits := range genState.ProfitsForDistribution {
+		if err := k.SetProfitsForDistribution(ctx, profits); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis. ExportGenesis intentionally ignores a few of the errors thrown
@@ -156,5 +195,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// Export the profits that have been collected by Protorev.
 	genesis.Profits = k.GetAllProfits(ctx)
 
+	// Export the profits made on each pool since the last distribution to liquidity providers.
+	genesis.PoolProfitsForDistribution = k.GetPoolProfitsForDistributionOfAllDenoms(ctx)
+
+	// Export the profits that have been distributed to each destination, alongside their value in uosmo.
+	genesis.DistributedProfits = k.GetAllDistributedProfits(ctx)
+
+	// Export the per epoch statistics of all of the epochs that are retained.
+	epochDenomStatistics, err := k.GetEpochStatisticsByDenomInRange(ctx, "", 0, ^uint64(0))
+	if err != nil {
+		panic(err)
+	}
+	genesis.EpochDenomStatistics = epochDenomStatistics
+
+	epochRouteStatistics, err := k.GetEpochStatisticsByRouteInRange(ctx, nil, 0, ^uint64(0))
+	if err != nil {
+		panic(err)
+	}
+	genesis.EpochRouteStatistics = epochRouteStatistics
+
+	// Export the profits that are yet to be distributed.
+	genesis.ProfitsForDistribution = k.GetAllProfitsForDistribution(ctx)
+
 	return genesis
 }
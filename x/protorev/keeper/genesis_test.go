@@ -1,5 +1,12 @@
 package keeper_test
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
 // TestInitGenesis tests the initialization and export of the module's genesis state.
 func (s *KeeperTestSuite) TestInitGenesis() {
 	// Export the genesis state
@@ -61,3 +68,82 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(len(profits), len(exportedGenesis.Profits))
 	s.Require().Equal(profits, exportedGenesis.Profits)
 }
+
+// TestExportInitGenesisRoundTrip tests that the profits pending distribution, the distributed profits and the per epoch
+// statistics are exported, and that initializing the module with the exported genesis state restores them.
+func (s *KeeperTestSuite) TestExportInitGenesisRoundTrip() {
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitDistributions = []types.ProfitDistribution{
+		{
+			Denom:              types.OsmosisDenomination,
+			Burn:               sdk.ZeroDec(),
+			CommunityPool:      sdk.ZeroDec(),
+			Stakers:            sdk.ZeroDec(),
+			LiquidityProviders: sdk.OneDec(),
+		},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// Pseudo execute trades across two epochs and distribute profits
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}
+	for epoch := int64(1); epoch <= 2; epoch++ {
+		s.setStatisticsEpoch(epoch)
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateEpochPointsUsed(s.Ctx, route.PoolIds(), types.OsmosisDenomination, 6))
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, sdk.NewInt(epoch*100)))
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, "Atom", sdk.NewInt(epoch*10)))
+	}
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDistributedProfits(s.Ctx, types.DistributionDestinationBurn, sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100)), sdk.NewInt(100)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDistributedProfits(s.Ctx, types.DistributionDestinationStakers, sdk.NewCoin("Atom", sdk.NewInt(100)), sdk.NewInt(50)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDistributedProfits(s.Ctx, types.DistributionDestinationStakers, sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20)), sdk.NewInt(20)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateProfitsForDistribution(s.Ctx, types.OsmosisDenomination, sdk.NewInt(80)))
+
+	exportedGenesis := s.App.ProtoRevKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(exportedGenesis.Validate())
+
+	s.Require().Equal([]types.PoolProfitsForDistribution{
+		{PoolId: 1, Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(300))},
+		{PoolId: 2, Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(300))},
+	}, exportedGenesis.PoolProfitsForDistribution)
+	s.Require().Equal([]types.DistributedProfits{
+		{
+			Destination: types.DistributionDestinationBurn,
+			Amounts:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))),
+			OsmoValue:   sdk.NewInt(100),
+		},
+		{
+			Destination: types.DistributionDestinationStakers,
+			Amounts:     sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(100)), sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(20))),
+			OsmoValue:   sdk.NewInt(70),
+		},
+	}, exportedGenesis.DistributedProfits)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(80))}, exportedGenesis.ProfitsForDistribution)
+	s.Require().Len(exportedGenesis.EpochDenomStatistics, 4)
+	s.Require().Len(exportedGenesis.EpochRouteStatistics, 2)
+	s.Require().Equal(types.EpochRouteStatistics{
+		Epoch:          2,
+		Route:          route.PoolIds(),
+		Profits:        sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(20)), sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200))),
+		NumberOfTrades: sdk.NewInt(2),
+		PointsUsed:     6,
+	}, exportedGenesis.EpochRouteStatistics[1])
+
+	// Clear the module state that is accumulated on initialization and initialize it with the exported genesis state
+	for _, keyPrefix := range [][]byte{
+		types.KeyPrefixProfitByDenom,
+		types.KeyPrefixPoolProfitsForDistribution,
+		types.KeyPrefixDistributedProfits,
+		types.KeyPrefixDistributedProfitsInOsmo,
+		types.KeyPrefixEpochStatisticsByDenom,
+		types.KeyPrefixEpochStatisticsByRoute,
+		types.KeyPrefixProfitsForDistribution,
+	} {
+		s.App.ProtoRevKeeper.DeleteAllEntriesForKeyPrefix(s.Ctx, keyPrefix)
+	}
+	s.Require().Empty(s.App.ProtoRevKeeper.GetPoolProfitsForDistributionOfAllDenoms(s.Ctx))
+	s.Require().Empty(s.App.ProtoRevKeeper.GetAllDistributedProfits(s.Ctx))
+	s.Require().Empty(s.App.ProtoRevKeeper.GetAllProfitsForDistribution(s.Ctx))
+
+	s.App.ProtoRevKeeper.InitGenesis(s.Ctx, *exportedGenesis)
+
+	s.Require().Equal(exportedGenesis, s.App.ProtoRevKeeper.ExportGenesis(s.Ctx))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -246,3 +247,87 @@ func (q Querier) GetProtoRevPool(c context.Context, req *types.QueryGetProtoRevP
 
 	return &types.QueryGetProtoRevPoolResponse{PoolId: poolId}, nil
 }
+
+// GetProtoRevDistributedProfits queries the profits the module has distributed to each destination
+func (q Querier) GetProtoRevDistributedProfits(c context.Context, req *types.QueryGetProtoRevDistributedProfitsRequest) (*types.QueryGetProtoRevDistributedProfitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevDistributedProfitsResponse{DistributedProfits: q.Keeper.GetAllDistributedProfits(ctx)}, nil
+}
+
+// GetProtoRevEpochStatisticsByDenom queries the per epoch statistics of the trades executed with a base denom within a range of epochs
+func (q Querier) GetProtoRevEpochStatisticsByDenom(c context.Context, req *types.QueryGetProtoRevEpochStatisticsByDenomRequest) (*types.QueryGetProtoRevEpochStatisticsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	toEpoch, err := q.epochRangeEnd(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	statistics, err := q.Keeper.GetEpochStatisticsByDenomInRange(ctx, req.Denom, req.FromEpoch, toEpoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevEpochStatisticsByDenomResponse{Statistics: statistics}, nil
+}
+
+// GetProtoRevEpochStatisticsByRoute queries the per epoch statistics of the trades executed on a route within a range of epochs
+func (q Querier) GetProtoRevEpochStatisticsByRoute(c context.Context, req *types.QueryGetProtoRevEpochStatisticsByRouteRequest) (*types.QueryGetProtoRevEpochStatisticsByRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	toEpoch, err := q.epochRangeEnd(ctx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	statistics, err := q.Keeper.GetEpochStatisticsByRouteInRange(ctx, req.Route, req.FromEpoch, toEpoch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevEpochStatisticsByRouteResponse{Statistics: statistics}, nil
+}
+
+// epochRangeEnd returns the last epoch of a range of epochs, which defaults to the current epoch
+func (q Querier) epochRangeEnd(ctx sdk.Context, fromEpoch, toEpoch uint64) (uint64, error) {
+	if toEpoch == 0 {
+		toEpoch = q.Keeper.GetCurrentStatisticsEpoch(ctx)
+	}
+
+	if fromEpoch > toEpoch {
+		return 0, fmt.Errorf("from epoch %d is after to epoch %d", fromEpoch, toEpoch)
+	}
+
+	return toEpoch, nil
+}
+
+// SimulateBackrun simulates the backrun of a hypothetical swap without changing any state
+func (q Querier) SimulateBackrun(c context.Context, req *types.QuerySimulateBackrunRequest) (*types.QuerySimulateBackrunResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.TokenIn.Amount.IsNil() || req.TokenIn.Validate() != nil || !req.TokenIn.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in")
+	}
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res, err := q.Keeper.SimulateBackrun(ctx, req.PoolId, req.TokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
@@ -376,3 +376,66 @@ func (s *KeeperTestSuite) TestGetProtoRevPool() {
 	s.Require().NoError(err)
 	s.Require().Equal(res.PoolId, uint64(1))
 }
+
+// TestGetProtoRevDistributedProfits tests the query for getting the profits distributed to each destination
+func (s *KeeperTestSuite) TestGetProtoRevDistributedProfits() {
+	// Request before any profits are distributed should return an empty list
+	req := &types.QueryGetProtoRevDistributedProfitsRequest{}
+	res, err := s.queryClient.GetProtoRevDistributedProfits(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.DistributedProfits)
+
+	// Request after distributing profits should return the distributed profits
+	err = s.App.ProtoRevKeeper.UpdateDistributedProfits(s.Ctx, types.DistributionDestinationStakers, sdk.NewCoin("Atom", sdk.NewInt(100)), sdk.NewInt(50))
+	s.Require().NoError(err)
+	res, err = s.queryClient.GetProtoRevDistributedProfits(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DistributedProfits{{
+		Destination: types.DistributionDestinationStakers,
+		Amounts:     sdk.NewCoins(sdk.NewCoin("Atom", sdk.NewInt(100))),
+		OsmoValue:   sdk.NewInt(50),
+	}}, res.DistributedProfits)
+}
+
+// TestGetProtoRevEpochStatistics tests the queries for getting the per epoch statistics by denom and by route
+func (s *KeeperTestSuite) TestGetProtoRevEpochStatistics() {
+	s.setStatisticsEpoch(5)
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}
+	err := s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, sdk.NewInt(100))
+	s.Require().NoError(err)
+
+	// The range defaults to ending at the current epoch
+	denomRes, err := s.queryClient.GetProtoRevEpochStatisticsByDenom(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevEpochStatisticsByDenomRequest{Denom: types.OsmosisDenomination})
+	s.Require().NoError(err)
+	s.Require().Len(denomRes.Statistics, 1)
+	s.Require().Equal(uint64(5), denomRes.Statistics[0].Epoch)
+	s.Require().Equal(sdk.NewInt(100), denomRes.Statistics[0].Profit)
+
+	routeRes, err := s.queryClient.GetProtoRevEpochStatisticsByRoute(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevEpochStatisticsByRouteRequest{Route: []uint64{1, 2}, FromEpoch: 5, ToEpoch: 5})
+	s.Require().NoError(err)
+	s.Require().Len(routeRes.Statistics, 1)
+	s.Require().Equal(sdk.OneInt(), routeRes.Statistics[0].NumberOfTrades)
+
+	// Epochs outside of the range are not returned
+	routeRes, err = s.queryClient.GetProtoRevEpochStatisticsByRoute(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevEpochStatisticsByRouteRequest{Route: []uint64{1, 2}, FromEpoch: 1, ToEpoch: 4})
+	s.Require().NoError(err)
+	s.Require().Empty(routeRes.Statistics)
+
+	// A range that ends before it starts is invalid
+	_, err = s.queryClient.GetProtoRevEpochStatisticsByDenom(sdk.WrapSDKContext(s.Ctx), &types.QueryGetProtoRevEpochStatisticsByDenomRequest{FromEpoch: 4, ToEpoch: 3})
+	s.Require().Error(err)
+}
+
+// TestSimulateBackrunQuery tests the query for simulating the backrun of a hypothetical swap
+func (s *KeeperTestSuite) TestSimulateBackrunQuery() {
+	// Request with an invalid token in should return an error
+	req := &types.QuerySimulateBackrunRequest{PoolId: 12, TokenOutDenom: "akash"}
+	_, err := s.queryClient.SimulateBackrun(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().Error(err)
+
+	// Request with a valid swap should return the considered routes
+	req.TokenIn = sdk.NewCoin("juno", sdk.NewInt(100))
+	res, err := s.queryClient.SimulateBackrun(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Routes)
+}
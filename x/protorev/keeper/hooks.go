@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v16/x/cosmwasmpool/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
 )
@@ -14,7 +15,8 @@ type Hooks struct {
 }
 
 var (
-	_ gammtypes.GammHooks = Hooks{}
+	_ gammtypes.GammHooks                    = Hooks{}
+	_ cosmwasmpooltypes.CosmwasmPoolListener = Hooks{}
 )
 
 // Create new ProtoRev hooks.
@@ -89,6 +91,20 @@ func (h Hooks) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress,
 	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
 }
 
+// ----------------------------------------------------------------------------
+// COSMWASM POOL HOOKS
+// ----------------------------------------------------------------------------
+
+// AfterCosmwasmPoolSwap stores swaps to be checked by protorev given the coins swapped in the pool.
+func (h Hooks) AfterCosmwasmPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	// Checked to avoid future unintended behavior based on how the hook is called
+	if len(input) != 1 || len(output) != 1 {
+		return
+	}
+
+	h.k.StoreSwap(ctx, poolId, input[0].Denom, output[0].Denom)
+}
+
 // ----------------------------------------------------------------------------
 // HELPER METHODS
 // ----------------------------------------------------------------------------
@@ -24,6 +24,10 @@ type (
 		gammKeeper        types.GAMMKeeper
 		epochKeeper       types.EpochKeeper
 		poolmanagerKeeper types.PoolManagerKeeper
+		distrKeeper       types.DistributionKeeper
+
+		incentivesKeeper     types.IncentivesKeeper
+		poolIncentivesKeeper types.PoolIncentivesKeeper
 	}
 )
 
@@ -36,6 +40,7 @@ func NewKeeper(
 	gammKeeper types.GAMMKeeper,
 	epochKeeper types.EpochKeeper,
 	poolmanagerKeeper types.PoolManagerKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -51,9 +56,20 @@ func NewKeeper(
 		gammKeeper:        gammKeeper,
 		epochKeeper:       epochKeeper,
 		poolmanagerKeeper: poolmanagerKeeper,
+		distrKeeper:       distrKeeper,
 	}
 }
 
+// SetIncentivesKeeper sets the incentives keeper used to distribute profits to liquidity providers.
+func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
+	k.incentivesKeeper = incentivesKeeper
+}
+
+// SetPoolIncentivesKeeper sets the pool incentives keeper used to distribute profits to liquidity providers.
+func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentivesKeeper) {
+	k.poolIncentivesKeeper = poolIncentivesKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -82,6 +82,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		StableWeight:       5, // it takes around 5 ms to simulate and execute a stable swap
 		BalancerWeight:     2, // it takes around 2 ms to simulate and execute a balancer swap
 		ConcentratedWeight: 2, // it takes around 2 ms to simulate and execute a concentrated swap
+		CosmwasmWeight:     5, // it takes around 5 ms to simulate and execute a cosmwasm swap
 	}
 	s.App.ProtoRevKeeper.SetPoolWeights(s.Ctx, poolWeights)
 
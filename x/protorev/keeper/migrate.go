@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+// MigrateParamsAndPoolGraph sets the params introduced after genesis, for the graph route search, the profit
+// distributions, the per epoch statistics and the golden section search, to their default values. It then tracks
+// the balance of the module account, which only holds profits, as the profits that are yet to be distributed, and
+// builds the pool graph, so that graph routes are searched right away rather than after the next day epoch.
+func (k Keeper) MigrateParamsAndPoolGraph(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	k.paramstore.Set(ctx, types.ParamStoreKeyMaxGraphRouteHops, params.MaxGraphRouteHops)
+	k.paramstore.Set(ctx, types.ParamStoreKeyProfitDistributions, params.ProfitDistributions)
+	k.paramstore.Set(ctx, types.ParamStoreKeyEpochStatisticsRetention, params.EpochStatisticsRetention)
+	k.paramstore.Set(ctx, types.ParamStoreKeyProfitSearchMode, params.ProfitSearchMode)
+	k.paramstore.Set(ctx, types.ParamStoreKeyGoldenSectionPrecision, params.GoldenSectionSearchPrecision)
+
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		if err := k.SetProfitsForDistribution(ctx, balance); err != nil {
+			return err
+		}
+	}
+
+	return k.UpdatePoolGraph(ctx)
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateParamsAndPoolGraph() {
+	// remove the params and the pool graph, as they are absent from the state of chains started before they were introduced.
+	paramsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.ParamStoreKeyMaxGraphRouteHops,
+		types.ParamStoreKeyProfitDistributions,
+		types.ParamStoreKeyEpochStatisticsRetention,
+		types.ParamStoreKeyProfitSearchMode,
+		types.ParamStoreKeyGoldenSectionPrecision,
+	} {
+		paramsStore.Delete(key)
+	}
+	s.App.ProtoRevKeeper.DeleteAllPoolGraphEdges(s.Ctx)
+	// the module account holds the profits made before the profit distributions were introduced.
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000)))))
+	s.Require().Panics(func() { s.App.ProtoRevKeeper.GetParams(s.Ctx) })
+
+	err := s.App.ProtoRevKeeper.MigrateParamsAndPoolGraph(s.Ctx)
+	s.Require().NoError(err)
+
+	// the params set at genesis are kept.
+	expectedParams := types.DefaultParams()
+	expectedParams.Admin = s.adminAccount.String()
+	s.Require().Equal(expectedParams, s.App.ProtoRevKeeper.GetParams(s.Ctx))
+
+	s.Require().True(s.App.ProtoRevKeeper.GetProtoRevEnabled(s.Ctx))
+
+	moduleBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.AccountKeeper.GetModuleAddress(types.ModuleName))
+	s.Require().Equal([]sdk.Coin(moduleBalance), s.App.ProtoRevKeeper.GetAllProfitsForDistribution(s.Ctx))
+
+	poolId, err := s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "bitcoin")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(14), poolId)
+}
@@ -481,6 +481,7 @@ func (s *KeeperTestSuite) TestMsgSetPoolWeights() {
 				StableWeight:       1,
 				BalancerWeight:     2,
 				ConcentratedWeight: 3,
+				CosmwasmWeight:     4,
 			},
 			false,
 			false,
@@ -492,6 +493,7 @@ func (s *KeeperTestSuite) TestMsgSetPoolWeights() {
 				StableWeight:       0,
 				BalancerWeight:     2,
 				ConcentratedWeight: 1,
+				CosmwasmWeight:     4,
 			},
 			false,
 			false,
@@ -512,6 +514,7 @@ func (s *KeeperTestSuite) TestMsgSetPoolWeights() {
 				StableWeight:       1,
 				BalancerWeight:     2,
 				ConcentratedWeight: 3,
+				CosmwasmWeight:     4,
 			},
 			true,
 			false,
@@ -523,6 +526,7 @@ func (s *KeeperTestSuite) TestMsgSetPoolWeights() {
 				StableWeight:       1,
 				BalancerWeight:     2,
 				ConcentratedWeight: 3,
+				CosmwasmWeight:     4,
 			},
 			true,
 			true,
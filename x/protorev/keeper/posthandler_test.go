@@ -687,6 +687,47 @@ func benchmarkWrapper(b *testing.B, msgs []sdk.Msg, expectedTrades int) {
 	}
 }
 
+// TestCosmwasmPoolBackrun tests that a swap on a cosmwasm pool is backrun through a route that includes the cosmwasm pool.
+func (s *KeeperTestSuite) TestCosmwasmPoolBackrun() {
+	// usdy is cheaper against osmo than usdx, while the transmuter pool swaps them 1:1
+	transmuterPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{"usdx", "usdy"})
+	s.JoinTransmuterPool(s.TestAccs[0], transmuterPool.GetId(), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1_000_000_000_000)), sdk.NewCoin("usdy", sdk.NewInt(1_000_000_000_000))))
+	usdxPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1_000_000_000_000)), sdk.NewCoin("usdx", sdk.NewInt(1_000_000_000_000)))
+	usdyPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1_000_000_000_000)), sdk.NewCoin("usdy", sdk.NewInt(1_100_000_000_000)))
+
+	// The swap on the cosmwasm pool is stored to be backrun
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], transmuterPool.GetId(), sdk.NewCoin("usdx", sdk.NewInt(1_000_000)), "usdy", sdk.NewInt(1))
+	s.Require().NoError(err)
+
+	swappedPools := s.App.ProtoRevKeeper.ExtractSwappedPools(s.Ctx)
+	s.Require().Equal([]keeper.SwapToBackrun{{PoolId: transmuterPool.GetId(), TokenInDenom: "usdx", TokenOutDenom: "usdy"}}, swappedPools)
+
+	// The route through the cosmwasm pool consumes the cosmwasm pool weight
+	routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, "usdx", "usdy", transmuterPool.GetId())
+	s.Require().Len(routes, 1)
+	s.Require().Equal([]uint64{usdyPoolId, transmuterPool.GetId(), usdxPoolId}, routes[0].Route.PoolIds())
+	s.Require().Equal(uint64(9), routes[0].PoolPoints)
+
+	err = s.App.ProtoRevKeeper.ProtoRevTrade(s.Ctx, swappedPools)
+	s.Require().NoError(err)
+
+	profit, err := s.App.ProtoRevKeeper.GetProfitsByDenom(s.Ctx, types.OsmosisDenomination)
+	s.Require().NoError(err)
+	s.Require().True(profit.Amount.IsPositive())
+
+	trades, err := s.App.ProtoRevKeeper.GetTradesByRoute(s.Ctx, []uint64{usdyPoolId, transmuterPool.GetId(), usdxPoolId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneInt(), trades)
+
+	// Cosmwasm pools are skipped if their weight is not set
+	poolWeights := s.App.ProtoRevKeeper.GetPoolWeights(s.Ctx)
+	poolWeights.CosmwasmWeight = 0
+	s.App.ProtoRevKeeper.SetPoolWeights(s.Ctx, poolWeights)
+
+	_, err = s.App.ProtoRevKeeper.CalculateRoutePoolPoints(s.Ctx, routes[0].Route)
+	s.Require().Error(err)
+}
+
 // setUpBenchmarkSuite sets up a app test suite, tx, and post handler for benchmark tests.
 // It returns the app configured to the correct state, a valid tx, and the protorev post handler.
 func setUpBenchmarkSuite(msgs []sdk.Msg) (*KeeperTestSuite, authsigning.Tx, sdk.AnteHandler) {
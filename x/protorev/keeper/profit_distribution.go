@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+// Struct used to track the profits made on a pool since the last distribution
+type PoolProfit struct {
+	PoolId uint64
+	Profit sdk.Int
+}
+
+// GetProfitDistribution returns the profit distribution set by governance for the given denom
+func (k Keeper) GetProfitDistribution(ctx sdk.Context, denom string) (types.ProfitDistribution, bool) {
+	for _, distribution := range k.GetParams(ctx).ProfitDistributions {
+		if distribution.Denom == denom {
+			return distribution, true
+		}
+	}
+
+	return types.ProfitDistribution{}, false
+}
+
+// DistributeProfits distributes the profits made by the module that are yet to be distributed according to the
+// profit distributions set by governance. Only the profits tracked in state are distributed, rather than the
+// balance of the module account, which anyone can send funds to. Profits in denoms without a distribution, and
+// the remainder of a distribution, are kept for the next one. A failed distribution for one denom is reverted
+// and does not prevent the others.
+func (k Keeper) DistributeProfits(ctx sdk.Context) {
+	for _, distribution := range k.GetParams(ctx).ProfitDistributions {
+		profits, _ := k.GetProfitsForDistribution(ctx, distribution.Denom)
+
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			distributed, err := k.DistributeProfitsForDenom(cacheCtx, distribution, profits)
+			if err != nil {
+				return err
+			}
+			return k.SetProfitsForDistribution(cacheCtx, profits.Sub(distributed))
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to distribute profits", "denom", distribution.Denom, "error", err)
+		}
+	}
+}
+
+// DistributeProfitsForDenom burns and sends the given profits to the community pool, stakers and
+// liquidity providers according to the fractions of the distribution. Any remainder is kept in the module account.
+// Returns the amount that was distributed.
+func (k Keeper) DistributeProfitsForDenom(ctx sdk.Context, distribution types.ProfitDistribution, profits sdk.Coin) (sdk.Coin, error) {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	distributed := sdk.NewCoin(profits.Denom, sdk.ZeroInt())
+
+	// Burn
+	burnCoins := sdk.NewCoins(sdk.NewCoin(profits.Denom, distribution.Burn.MulInt(profits.Amount).TruncateInt()))
+	if !burnCoins.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.updateDistributedProfits(ctx, types.DistributionDestinationBurn, burnCoins[0]); err != nil {
+			return sdk.Coin{}, err
+		}
+		distributed = distributed.Add(burnCoins[0])
+	}
+
+	// Community pool
+	communityPoolCoins := sdk.NewCoins(sdk.NewCoin(profits.Denom, distribution.CommunityPool.MulInt(profits.Amount).TruncateInt()))
+	if !communityPoolCoins.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, moduleAddress); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.updateDistributedProfits(ctx, types.DistributionDestinationCommunityPool, communityPoolCoins[0]); err != nil {
+			return sdk.Coin{}, err
+		}
+		distributed = distributed.Add(communityPoolCoins[0])
+	}
+
+	// Stakers
+	stakersCoins := sdk.NewCoins(sdk.NewCoin(profits.Denom, distribution.Stakers.MulInt(profits.Amount).TruncateInt()))
+	if !stakersCoins.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, stakersCoins); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.updateDistributedProfits(ctx, types.DistributionDestinationStakers, stakersCoins[0]); err != nil {
+			return sdk.Coin{}, err
+		}
+		distributed = distributed.Add(stakersCoins[0])
+	}
+
+	// Liquidity providers
+	lpProfits := sdk.NewCoin(profits.Denom, distribution.LiquidityProviders.MulInt(profits.Amount).TruncateInt())
+	lpDistributed, err := k.DistributeProfitsToLiquidityProviders(ctx, lpProfits)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if lpDistributed.IsPositive() {
+		if err := k.updateDistributedProfits(ctx, types.DistributionDestinationLiquidityProviders, lpDistributed); err != nil {
+			return sdk.Coin{}, err
+		}
+		distributed = distributed.Add(lpDistributed)
+	}
+
+	return distributed, nil
+}
+
+// DistributeProfitsToLiquidityProviders splits the given profits between the pools that were arbitraged since
+// the last distribution, in proportion to the profits made on each pool, and adds each share to the rewards of
+// the pool's internal incentives gauge. Shares of pools without such a gauge are kept in the module account.
+// Returns the amount that was distributed.
+func (k Keeper) DistributeProfitsToLiquidityProviders(ctx sdk.Context, profits sdk.Coin) (sdk.Coin, error) {
+	distributed := sdk.NewCoin(profits.Denom, sdk.ZeroInt())
+
+	poolProfits := k.GetAllPoolProfitsForDistribution(ctx, profits.Denom)
+	k.DeleteAllPoolProfitsForDistribution(ctx, profits.Denom)
+
+	totalPoolProfits := sdk.ZeroInt()
+	for _, poolProfit := range poolProfits {
+		totalPoolProfits = totalPoolProfits.Add(poolProfit.Profit)
+	}
+
+	if !profits.IsPositive() || !totalPoolProfits.IsPositive() {
+		return distributed, nil
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	for _, poolProfit := range poolProfits {
+		share := sdk.NewCoin(profits.Denom, profits.Amount.Mul(poolProfit.Profit).Quo(totalPoolProfits))
+		if !share.IsPositive() {
+			continue
+		}
+
+		gaugeId, err := k.GetPoolGaugeIdForDistribution(ctx, poolProfit.PoolId)
+		if err != nil {
+			k.Logger(ctx).Info("keeping liquidity provider profits in the module account", "pool_id", poolProfit.PoolId, "reason", err)
+			continue
+		}
+
+		if err := k.incentivesKeeper.AddToGaugeRewards(ctx, moduleAddress, sdk.NewCoins(share), gaugeId); err != nil {
+			return distributed, err
+		}
+		distributed = distributed.Add(share)
+	}
+
+	return distributed, nil
+}
+
+// GetPoolGaugeIdForDistribution returns the internal incentives gauge that rewards the liquidity providers of the
+// given pool. For CFMM pools this is the gauge of the longest lockable duration, and for concentrated liquidity
+// pools it is the gauge of the incentives epoch duration. Cosmwasm pools do not have gauges.
+func (k Keeper) GetPoolGaugeIdForDistribution(ctx sdk.Context, poolId uint64) (uint64, error) {
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	var duration time.Duration
+	switch pool.GetType() {
+	case poolmanagertypes.Balancer, poolmanagertypes.Stableswap:
+		duration, err = k.poolIncentivesKeeper.GetLongestLockableDuration(ctx)
+		if err != nil {
+			return 0, err
+		}
+	case poolmanagertypes.Concentrated:
+		duration = k.incentivesKeeper.GetEpochInfo(ctx).Duration
+	default:
+		return 0, fmt.Errorf("pool %d of type %s has no incentives gauge", poolId, pool.GetType())
+	}
+
+	return k.poolIncentivesKeeper.GetPoolGaugeId(ctx, poolId, duration)
+}
+
+// updateDistributedProfits records the distributed profits alongside their value in uosmo
+func (k Keeper) updateDistributedProfits(ctx sdk.Context, destination string, amount sdk.Coin) error {
+	osmoValue := amount.Amount
+	if amount.Denom != types.OsmosisDenomination {
+		var err error
+		if osmoValue, err = k.ConvertProfits(ctx, amount, amount.Amount); err != nil {
+			// The profits can still be distributed if there is no uosmo pool to value them with
+			osmoValue = sdk.ZeroInt()
+		}
+	}
+
+	return k.UpdateDistributedProfits(ctx, destination, amount, osmoValue)
+}
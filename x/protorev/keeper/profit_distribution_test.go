@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+// TestDistributeProfits tests that the profits yet to be distributed are burned and sent to the community pool,
+// stakers and the gauges of the arbitraged pools according to the profit distributions set by governance, and
+// that funds sent to the module account by others are not distributed.
+func (s *KeeperTestSuite) TestDistributeProfits() {
+	clPool := s.PrepareConcentratedPool()
+	nonExistentPoolId := uint64(9999)
+
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitDistributions = []types.ProfitDistribution{
+		{
+			Denom:              types.OsmosisDenomination,
+			Burn:               sdk.NewDecWithPrec(1, 1),
+			CommunityPool:      sdk.NewDecWithPrec(2, 1),
+			Stakers:            sdk.NewDecWithPrec(3, 1),
+			LiquidityProviders: sdk.NewDecWithPrec(4, 1),
+		},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// Fund the module account with profits in a denom with a distribution and one without
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000)),
+		sdk.NewCoin("Atom", sdk.NewInt(1000)),
+	)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateProfitsForDistribution(s.Ctx, types.OsmosisDenomination, sdk.NewInt(1000)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateProfitsForDistribution(s.Ctx, "Atom", sdk.NewInt(1000)))
+
+	// Funds sent to the module account that are not profits
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(5000))))
+	s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, s.TestAccs[0], moduleAddress, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(5000)))))
+
+	// Pool 1 made 100 profit, pool 2 made 300, the cl pool made 200 and a pool without a gauge made 200
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePoolProfitsForDistribution(s.Ctx, []uint64{1, 2}, types.OsmosisDenomination, sdk.NewInt(100)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePoolProfitsForDistribution(s.Ctx, []uint64{2, clPool.GetId()}, types.OsmosisDenomination, sdk.NewInt(200)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePoolProfitsForDistribution(s.Ctx, []uint64{nonExistentPoolId}, types.OsmosisDenomination, sdk.NewInt(200)))
+
+	moduleBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress)
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, types.OsmosisDenomination)
+	communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	feeCollectorBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), types.OsmosisDenomination)
+
+	s.App.ProtoRevKeeper.DistributeProfits(s.Ctx)
+
+	// 100 uosmo is burned
+	supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, types.OsmosisDenomination)
+	s.Require().Equal(supplyBefore.Amount.SubRaw(100), supplyAfter.Amount)
+
+	// 200 uosmo is sent to the community pool
+	communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(communityPoolBefore.AmountOf(types.OsmosisDenomination).Add(sdk.NewDec(200)), communityPoolAfter.AmountOf(types.OsmosisDenomination))
+
+	// 300 uosmo is sent to stakers
+	feeCollectorAfter := s.App.BankKeeper.GetBalance(s.Ctx, s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), types.OsmosisDenomination)
+	s.Require().Equal(feeCollectorBefore.Amount.AddRaw(300), feeCollectorAfter.Amount)
+
+	// 400 uosmo is split between the pools in proportion to their profits (100, 300, 200 and 200 out of 800)
+	for _, tc := range []struct {
+		poolId   uint64
+		expected sdk.Int
+	}{
+		{poolId: 1, expected: sdk.NewInt(50)},
+		{poolId: 2, expected: sdk.NewInt(150)},
+		{poolId: clPool.GetId(), expected: sdk.NewInt(100)},
+	} {
+		gaugeId, err := s.App.ProtoRevKeeper.GetPoolGaugeIdForDistribution(s.Ctx, tc.poolId)
+		s.Require().NoError(err)
+		gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, gauge.Coins.AmountOf(types.OsmosisDenomination))
+	}
+
+	// CFMM pools are rewarded through the gauge of the longest lockable duration
+	longestDuration, err := s.App.PoolIncentivesKeeper.GetLongestLockableDuration(s.Ctx)
+	s.Require().NoError(err)
+	expectedGaugeId, err := s.App.PoolIncentivesKeeper.GetPoolGaugeId(s.Ctx, 1, longestDuration)
+	s.Require().NoError(err)
+	gaugeId, err := s.App.ProtoRevKeeper.GetPoolGaugeIdForDistribution(s.Ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedGaugeId, gaugeId)
+
+	// The share of the pool without a gauge and the profits without a distribution are kept in the module account
+	// for the next distribution
+	moduleBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress)
+	s.Require().Equal(moduleBalanceBefore.AmountOf(types.OsmosisDenomination).SubRaw(900), moduleBalanceAfter.AmountOf(types.OsmosisDenomination))
+	s.Require().Equal(moduleBalanceBefore.AmountOf("Atom"), moduleBalanceAfter.AmountOf("Atom"))
+	s.Require().Equal([]sdk.Coin{
+		sdk.NewCoin("Atom", sdk.NewInt(1000)),
+		sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100)),
+	}, s.App.ProtoRevKeeper.GetAllProfitsForDistribution(s.Ctx))
+
+	// The pool profits are reset after the distribution
+	s.Require().Empty(s.App.ProtoRevKeeper.GetAllPoolProfitsForDistribution(s.Ctx, types.OsmosisDenomination))
+
+	// The distributed profits are tracked
+	s.Require().Equal([]types.DistributedProfits{
+		{
+			Destination: types.DistributionDestinationBurn,
+			Amounts:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))),
+			OsmoValue:   sdk.NewInt(100),
+		},
+		{
+			Destination: types.DistributionDestinationCommunityPool,
+			Amounts:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200))),
+			OsmoValue:   sdk.NewInt(200),
+		},
+		{
+			Destination: types.DistributionDestinationStakers,
+			Amounts:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(300))),
+			OsmoValue:   sdk.NewInt(300),
+		},
+		{
+			Destination: types.DistributionDestinationLiquidityProviders,
+			Amounts:     sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(300))),
+			OsmoValue:   sdk.NewInt(300),
+		},
+	}, s.App.ProtoRevKeeper.GetAllDistributedProfits(s.Ctx))
+}
+
+// TestUpdateStatisticsTracksPoolProfits tests that the profits made on each pool are only tracked for denoms
+// whose profits are partly distributed to liquidity providers.
+func (s *KeeperTestSuite) TestUpdateStatisticsTracksPoolProfits() {
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitDistributions = []types.ProfitDistribution{
+		{
+			Denom:              types.OsmosisDenomination,
+			Burn:               sdk.ZeroDec(),
+			CommunityPool:      sdk.ZeroDec(),
+			Stakers:            sdk.ZeroDec(),
+			LiquidityProviders: sdk.OneDec(),
+		},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}}
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, sdk.NewInt(100)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, "Atom", sdk.NewInt(100)))
+
+	s.Require().Equal(
+		[]keeper.PoolProfit{{PoolId: 1, Profit: sdk.NewInt(100)}, {PoolId: 2, Profit: sdk.NewInt(100)}},
+		s.App.ProtoRevKeeper.GetAllPoolProfitsForDistribution(s.Ctx, types.OsmosisDenomination),
+	)
+	s.Require().Empty(s.App.ProtoRevKeeper.GetAllPoolProfitsForDistribution(s.Ctx, "Atom"))
+}
@@ -146,6 +146,44 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// GetPoolGraphEdge returns the id of the pool connecting the denom to the neighbour denom in the pool graph
+func (k Keeper) GetPoolGraphEdge(ctx sdk.Context, denom, neighbourDenom string) (uint64, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetKeyPrefixPoolGraphEdge(denom, neighbourDenom))
+	if len(bz) == 0 {
+		return 0, fmt.Errorf("no pool graph edge found between %s and %s", denom, neighbourDenom)
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// GetPoolGraphEdges returns the neighbour denoms of the denom in the pool graph, with the ids of the pools connecting them
+func (k Keeper) GetPoolGraphEdges(ctx sdk.Context, denom string) []PoolGraphEdge {
+	edges := make([]PoolGraphEdge, 0)
+
+	keyPrefix := types.GetKeyPrefixPoolGraphEdge(denom, "")
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		edges = append(edges, PoolGraphEdge{
+			Denom:  string(iterator.Key()[len(keyPrefix):]),
+			PoolId: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return edges
+}
+
+// SetPoolGraphEdge sets the id of the pool connecting the denom to the neighbour denom in the pool graph
+func (k Keeper) SetPoolGraphEdge(ctx sdk.Context, denom, neighbourDenom string, poolId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetKeyPrefixPoolGraphEdge(denom, neighbourDenom), sdk.Uint64ToBigEndian(poolId))
+}
+
+// DeleteAllPoolGraphEdges deletes all the edges of the pool graph
+func (k Keeper) DeleteAllPoolGraphEdges(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixPoolGraph)
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSwapsToBackrun)
@@ -309,7 +309,7 @@ func (s *KeeperTestSuite) TestGetMaxPointsPerBlock() {
 func (s *KeeperTestSuite) TestGetPoolWeights() {
 	// Should be initialized on genesis
 	poolWeights := s.App.ProtoRevKeeper.GetPoolWeights(s.Ctx)
-	s.Require().Equal(types.PoolWeights{StableWeight: 5, BalancerWeight: 2, ConcentratedWeight: 2}, poolWeights)
+	s.Require().Equal(types.PoolWeights{StableWeight: 5, BalancerWeight: 2, ConcentratedWeight: 2, CosmwasmWeight: 5}, poolWeights)
 
 	// Should be able to set the PoolWeights
 	newRouteWeights := types.PoolWeights{
@@ -100,7 +100,8 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 	return tokenIn, profit, nil
 }
 
-// FindMaxProfitRoute runs a binary search to find the max profit for a given route
+// FindMaxProfitRoute runs a binary search or a golden section search (depending on the profit search mode param)
+// to find the max profit for a given route
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, sdk.Int, error) {
 	// Track the tokenIn amount/denom and the profit
 	tokenIn := sdk.Coin{}
@@ -132,9 +133,24 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, sdk.ZeroInt(), err
 	}
 
+	// Track the pool points consumed on the route in the statistics of the current epoch
+	if err := k.UpdateEpochPointsUsed(ctx, route.Route.PoolIds(), inputDenom, route.PoolPoints); err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
 	// Extend the search range if the max input amount is too small
 	curLeft, curRight = k.ExtendSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
 
+	// Search the amounts in between the bounds directly rather than only the multiples of the step size. The search
+	// is bounded to as many estimates as the binary search makes over the same range, so that the route costs about
+	// the same gas in both modes for the pool points it is charged
+	params := k.GetParams(ctx)
+	if params.ProfitSearchMode == types.GoldenSectionSearch {
+		precision := sdk.MaxInt(params.GoldenSectionSearchPrecision.MulInt(route.StepSize).TruncateInt(), sdk.OneInt())
+		maxIterations := GoldenSectionSearchMaxIterations(curRight.Sub(curLeft))
+		return k.GoldenSectionSearchMaxProfit(ctx, route, inputDenom, curLeft.Mul(route.StepSize), curRight.Mul(route.StepSize), precision, maxIterations)
+	}
+
 	// Binary search to find the max profit
 	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
 		curMid := (curLeft.Add(curRight)).Quo(sdk.NewInt(2))
@@ -167,6 +183,101 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	return tokenIn, profit, nil
 }
 
+// GoldenSectionSearchMaxProfit finds the max profit for a given route by running a golden section search over the
+// amounts in between left and right. The profit of a cyclic arbitrage is concave in the amount in, so every iteration
+// the range is shrunk around the larger of the two profits at its golden section points. Only one new estimate is
+// needed per iteration since the other point is reused. The search stops once the range is at most precision wide,
+// or after maxIterations iterations, and returns the most profitable amount in that was estimated, including the
+// bounds of the final range.
+func (k Keeper) GoldenSectionSearchMaxProfit(ctx sdk.Context, route RouteMetaData, inputDenom string, left, right, precision sdk.Int, maxIterations int) (sdk.Coin, sdk.Int, error) {
+	// Track the tokenIn amount/denom and the profit of the most profitable estimate
+	tokenIn := sdk.Coin{}
+	profit := sdk.ZeroInt()
+
+	estimate := func(amount sdk.Int) (sdk.Int, error) {
+		tokenInEstimate, profitEstimate, err := k.EstimateMultihopProfit(ctx, inputDenom, amount, route.Route)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		if profitEstimate.GT(profit) {
+			tokenIn = tokenInEstimate
+			profit = profitEstimate
+		}
+
+		return profitEstimate, nil
+	}
+
+	// Set the two golden section points of the initial range
+	curLeftPoint := right.Sub(types.InvGoldenRatio.MulInt(right.Sub(left)).TruncateInt())
+	curRightPoint := left.Add(types.InvGoldenRatio.MulInt(right.Sub(left)).TruncateInt())
+
+	// Short circuit profit searching if there is an error in the GAMM module
+	profitLeftPoint, err := estimate(curLeftPoint)
+	if err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
+	profitRightPoint, err := estimate(curRightPoint)
+	if err != nil {
+		return sdk.Coin{}, sdk.ZeroInt(), err
+	}
+
+	for iteration := 0; right.Sub(left).GT(precision) && iteration < maxIterations; iteration++ {
+		// Reduce subspace to search for max profit, reusing the inner point that stays in the range
+		if profitLeftPoint.LT(profitRightPoint) {
+			left = curLeftPoint
+			curLeftPoint, profitLeftPoint = curRightPoint, profitRightPoint
+			curRightPoint = left.Add(types.InvGoldenRatio.MulInt(right.Sub(left)).TruncateInt())
+
+			if profitRightPoint, err = estimate(curRightPoint); err != nil {
+				return sdk.Coin{}, sdk.ZeroInt(), err
+			}
+		} else {
+			right = curRightPoint
+			curRightPoint, profitRightPoint = curLeftPoint, profitLeftPoint
+			curLeftPoint = right.Sub(types.InvGoldenRatio.MulInt(right.Sub(left)).TruncateInt())
+
+			if profitLeftPoint, err = estimate(curLeftPoint); err != nil {
+				return sdk.Coin{}, sdk.ZeroInt(), err
+			}
+		}
+
+		// Rounding the golden section points down can swap them once the range is small
+		if curLeftPoint.GT(curRightPoint) {
+			curLeftPoint, curRightPoint = curRightPoint, curLeftPoint
+			profitLeftPoint, profitRightPoint = profitRightPoint, profitLeftPoint
+		}
+	}
+
+	// The golden section points never reach the bounds of the range, so estimate the bounds of the final range
+	// as well in case the profit is maximised at one of the bounds of the search
+	for _, bound := range []sdk.Int{left, right} {
+		if _, err := estimate(bound); err != nil {
+			return sdk.Coin{}, sdk.ZeroInt(), err
+		}
+	}
+
+	return tokenIn, profit, nil
+}
+
+// GoldenSectionSearchMaxIterations returns the number of iterations after which the golden section search has made as
+// many profit estimates as the binary search makes over a range of the given number of step sizes. The binary search
+// makes two estimates per iteration, whereas the golden section search makes two estimates before its first iteration,
+// one per iteration and two for the bounds of its final range.
+func GoldenSectionSearchMaxIterations(stepSizes sdk.Int) int {
+	binarySearchIterations := stepSizes.BigInt().BitLen()
+	if binarySearchIterations > types.MaxIterations {
+		binarySearchIterations = types.MaxIterations
+	}
+
+	if binarySearchIterations < 2 {
+		return 0
+	}
+
+	return 2*binarySearchIterations - 4
+}
+
 // Determine if the binary search range needs to be extended
 func (k Keeper) ExtendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight sdk.Int) (sdk.Int, sdk.Int) {
 	// Get the profit for the maximum amount in
@@ -223,8 +334,16 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	}
 
 	// Send the developer fee to the developer address
-	if err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit)); err != nil {
+	devProfit, err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit))
+	if err != nil {
 		ctx.Logger().Error("failed to send developer fee", "error", err)
+		devProfit = sdk.NewCoin(inputCoin.Denom, sdk.ZeroInt())
+	}
+
+	// Track the rest of the profit as distributable, so that funds sent to the module account by others are not
+	// distributed as profits
+	if err := k.UpdateProfitsForDistribution(ctx, inputCoin.Denom, profit.Sub(devProfit.Amount)); err != nil {
+		return err
 	}
 
 	// Create and emit the backrun event and add it to the context
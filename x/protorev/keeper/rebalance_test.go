@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
+	"testing"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
@@ -324,6 +327,148 @@ func (s *KeeperTestSuite) TestFindMaxProfitRoute() {
 	}
 }
 
+// findMaxProfitRoutes are the routes the search for the optimal amount in is tested and benchmarked against
+// alongside the profit the binary search finds on each of them with a step size of 1_000_000
+var findMaxProfitRoutes = []struct {
+	name               string
+	route              poolmanagertypes.SwapAmountInRoutes
+	poolPoints         uint64
+	binarySearchProfit sdk.Int
+}{
+	{"Two Asset Same Weights", routeTwoAssetSameWeight, 6, sdk.NewInt(24848)},
+	{"Multi Asset Same Weights", routeMultiAssetSameWeight, 6, sdk.NewInt(4538)},
+	{"Most Profitable", routeMostProfitable, 6, sdk.NewInt(67511675)},
+	{"Multi Asset Different Weights", routeDiffDenom, 6, sdk.NewInt(5826)},
+	{"StableSwap", routeStableSwap, 9, sdk.NewInt(56585052)},
+	{"No Arbitrage Opportunity", routeNoArb, 0, sdk.NewInt(0)},
+	{"Four Pool", fourPoolRoute, 8, sdk.NewInt(15_761_405)},
+	{"Two Pool", twoPoolRoute, 4, sdk.NewInt(218_149_058)},
+	{"Extended Range", extendedRangeRoute, 10, sdk.NewInt(20_900_656_975)},
+}
+
+// BenchmarkFindMaxProfitForRoute benchmarks the binary search and the golden section search for the optimal amount
+// in on every route, reporting the gas used and the profit found by each search
+func BenchmarkFindMaxProfitForRoute(b *testing.B) {
+	// Setup the test suite
+	s := new(KeeperTestSuite)
+	s.SetT(&testing.T{})
+	s.SetupTest()
+
+	for _, mode := range []types.ProfitSearchMode{types.BinarySearch, types.GoldenSectionSearch} {
+		params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+		params.ProfitSearchMode = mode
+		s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+		for _, test := range findMaxProfitRoutes {
+			b.Run(fmt.Sprintf("%s/%s", mode, test.name), func(b *testing.B) {
+				route := protorevtypes.RouteMetaData{
+					Route:      test.route,
+					PoolPoints: test.poolPoints,
+					StepSize:   sdk.NewInt(1_000_000),
+				}
+
+				var gasUsed uint64
+				var profit sdk.Int
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					cacheCtx, _ := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+					remainingPoolPoints := uint64(1000)
+					remainingBlockPoolPoints := uint64(1000)
+					b.StartTimer()
+
+					var err error
+					_, profit, err = s.App.ProtoRevKeeper.FindMaxProfitForRoute(cacheCtx, route, &remainingPoolPoints, &remainingBlockPoolPoints)
+					if err != nil {
+						panic(fmt.Sprintf("error finding max profit for route in protorev benchmark: %s", err))
+					}
+					gasUsed = cacheCtx.GasMeter().GasConsumed()
+				}
+
+				b.ReportMetric(float64(gasUsed), "gas/op")
+				b.ReportMetric(float64(profit.Int64()), "profit/op")
+			})
+		}
+	}
+}
+
+// TestFindMaxProfitRouteGoldenSectionSearch tests that the golden section search finds at least as much profit as the
+// binary search on every route, that the profit it returns is the profit of the amount in it returns, and that it
+// uses about as much gas as the binary search since the route is charged the same pool points in both modes
+func (s *KeeperTestSuite) TestFindMaxProfitRouteGoldenSectionSearch() {
+	setProfitSearchMode := func(mode types.ProfitSearchMode) {
+		params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+		params.ProfitSearchMode = mode
+		s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+	}
+
+	for _, test := range findMaxProfitRoutes {
+		s.Run(test.name, func() {
+			remainingPoolPoints := uint64(1000)
+			remainingBlockPoolPoints := uint64(1000)
+			route := protorevtypes.RouteMetaData{
+				Route:      test.route,
+				PoolPoints: test.poolPoints,
+				StepSize:   sdk.NewInt(1_000_000),
+			}
+
+			setProfitSearchMode(types.BinarySearch)
+			binarySearchCtx, _ := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+			_, _, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(binarySearchCtx, route, &remainingPoolPoints, &remainingBlockPoolPoints)
+			s.Require().NoError(err)
+			remainingPoolPoints, remainingBlockPoolPoints = 1000, 1000
+
+			setProfitSearchMode(types.GoldenSectionSearch)
+			goldenSectionSearchCtx := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitForRoute(
+				goldenSectionSearchCtx,
+				route,
+				&remainingPoolPoints,
+				&remainingBlockPoolPoints,
+			)
+			s.Require().NoError(err)
+
+			// The golden section search makes at most as many estimates as the binary search does in the worst case,
+			// which may be one iteration more than the binary search makes on the route
+			binarySearchGas := binarySearchCtx.GasMeter().GasConsumed()
+			s.Require().LessOrEqual(goldenSectionSearchCtx.GasMeter().GasConsumed(), binarySearchGas+binarySearchGas/10)
+			s.Require().True(profit.GTE(test.binarySearchProfit), "golden section search profit %s is less than binary search profit %s", profit, test.binarySearchProfit)
+
+			if test.binarySearchProfit.IsPositive() {
+				_, expectedProfit, err := s.App.ProtoRevKeeper.EstimateMultihopProfit(s.Ctx, amtIn.Denom, amtIn.Amount, test.route)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedProfit, profit)
+			}
+
+			s.Require().Equal(uint64(1000), remainingPoolPoints+test.poolPoints)
+		})
+	}
+}
+
+// TestGoldenSectionSearchMaxIterations tests that the golden section search is bounded to as many estimates as the
+// binary search makes over the same range
+func (s *KeeperTestSuite) TestGoldenSectionSearchMaxIterations() {
+	tests := map[string]struct {
+		stepSizes             sdk.Int
+		expectedMaxIterations int
+	}{
+		"empty range":                          {stepSizes: sdk.ZeroInt(), expectedMaxIterations: 0},
+		"binary search of one iteration":       {stepSizes: sdk.OneInt(), expectedMaxIterations: 0},
+		"binary search of two iterations":      {stepSizes: sdk.NewInt(3), expectedMaxIterations: 0},
+		"binary search of three iterations":    {stepSizes: sdk.NewInt(4), expectedMaxIterations: 2},
+		"default range":                        {stepSizes: types.MaxInputAmount, expectedMaxIterations: 26},
+		"extended range is bounded by the max": {stepSizes: types.ExtendedMaxInputAmount, expectedMaxIterations: 2*types.MaxIterations - 4},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Require().Equal(tc.expectedMaxIterations, protorevtypes.GoldenSectionSearchMaxIterations(tc.stepSizes))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestExecuteTrade() {
 	type param struct {
 		route          poolmanagertypes.SwapAmountInRoutes
@@ -436,6 +581,11 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			developerAccBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, devAccount, test.arbDenom)
 			s.Require().Equal(test.param.expectedProfit.MulRaw(types.ProfitSplitPhase1).QuoRaw(100), developerAccBalance.Amount)
 
+			// Check the rest of the profit is tracked as distributable
+			profitsForDistribution, err := s.App.ProtoRevKeeper.GetProfitsForDistribution(s.Ctx, test.arbDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(test.param.expectedProfit.Sub(developerAccBalance.Amount), profitsForDistribution.Amount)
+
 		} else {
 			s.Require().Error(err)
 		}
@@ -32,6 +32,15 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append graph routes that were not already built by the methods above
+	if graphRoutes, err := k.BuildGraphRoutes(ctx, tokenIn, tokenOut, poolId); err == nil {
+		for _, graphRoute := range graphRoutes {
+			if !containsRoute(routes, graphRoute.Route) {
+				routes = append(routes, graphRoute)
+			}
+		}
+	}
+
 	return routes
 }
 
@@ -149,6 +158,195 @@ func (k Keeper) BuildHighestLiquidityRoute(ctx sdk.Context, swapDenom types.Base
 	}, nil
 }
 
+// BuildGraphRoutes builds cyclic arbitrage routes of up to MaxGraphRouteHops hops by searching the pool graph for paths from tokenIn back to
+// tokenOut that do not go through the pool that was swapped on. Paths are pruned once they consume more pool points than are remaining for the tx,
+// and the search stops after exploring MaxGraphSearchNodes paths. The pool points of each pool and the edges of each denom are read once per search.
+// Each cycle is rotated to start and end with the highest priority base denom it goes through, and is skipped if there is none.
+func (k Keeper) BuildGraphRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) ([]RouteMetaData, error) {
+	return k.buildGraphRoutes(ctx, tokenIn, tokenOut, poolId, types.MaxGraphSearchNodes)
+}
+
+// buildGraphRoutes builds the graph routes of the swap as described in BuildGraphRoutes, exploring at most maxSearchNodes paths.
+func (k Keeper) buildGraphRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, maxSearchNodes int) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+
+	// A cycle needs at least two hops, the swapped pool and a pool back to tokenOut
+	maxHops := int(k.GetParams(ctx).MaxGraphRouteHops)
+	if maxHops < 2 {
+		return routes, nil
+	}
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	poolWeights := k.GetPoolWeights(ctx)
+	poolPointsCache := make(map[uint64]poolPointsResult)
+	getPoolPoints := func(poolId uint64) (uint64, error) {
+		if result, ok := poolPointsCache[poolId]; ok {
+			return result.poolPoints, result.err
+		}
+		poolPoints, err := k.getPoolPoints(ctx, poolWeights, poolId)
+		poolPointsCache[poolId] = poolPointsResult{poolPoints: poolPoints, err: err}
+		return poolPoints, err
+	}
+
+	edgesCache := make(map[string][]PoolGraphEdge)
+	getEdges := func(denom string) []PoolGraphEdge {
+		if edges, ok := edgesCache[denom]; ok {
+			return edges
+		}
+		edges := k.GetPoolGraphEdges(ctx, denom)
+		edgesCache[denom] = edges
+		return edges
+	}
+
+	swappedPoolPoints, err := getPoolPoints(poolId)
+	if err != nil {
+		return routes, err
+	}
+
+	// The cycle being built, which starts by swapping tokenOut for tokenIn on the swapped pool
+	hops := poolmanagertypes.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: tokenIn}}
+	visitedDenoms := map[string]bool{tokenIn: true, tokenOut: true}
+	visitedPools := map[uint64]bool{poolId: true}
+	exploredNodes := 0
+
+	// Depth first search from denom, the token out of the last hop, for cycles of routeHops hops given the pool points consumed by the hops so far
+	var search func(denom string, poolPoints uint64, routeHops int)
+	search = func(denom string, poolPoints uint64, routeHops int) {
+		if len(routes) >= types.MaxGraphRoutes || exploredNodes >= maxSearchNodes {
+			return
+		}
+		exploredNodes++
+
+		// Close the cycle if the denom is connected to tokenOut, which may only be one of the neighbours of the other
+		if len(hops)+1 == routeHops {
+			exitPoolId, found := findPoolGraphEdge(getEdges(denom), tokenOut)
+			if !found {
+				exitPoolId, found = findPoolGraphEdge(getEdges(tokenOut), denom)
+			}
+			if !found || visitedPools[exitPoolId] {
+				return
+			}
+
+			exitPoolPoints, err := getPoolPoints(exitPoolId)
+			if err != nil || poolPoints+exitPoolPoints > remainingPoolPoints {
+				return
+			}
+
+			cycle := make(poolmanagertypes.SwapAmountInRoutes, 0, routeHops)
+			cycle = append(cycle, hops...)
+			cycle = append(cycle, poolmanagertypes.SwapAmountInRoute{PoolId: exitPoolId, TokenOutDenom: tokenOut})
+
+			if newRoute, err := buildGraphRoute(cycle, baseDenoms, poolPoints+exitPoolPoints); err == nil {
+				routes = append(routes, newRoute)
+			}
+
+			return
+		}
+
+		for _, edge := range getEdges(denom) {
+			if visitedDenoms[edge.Denom] || visitedPools[edge.PoolId] {
+				continue
+			}
+
+			edgePoolPoints, err := getPoolPoints(edge.PoolId)
+			if err != nil || poolPoints+edgePoolPoints > remainingPoolPoints {
+				continue
+			}
+
+			hops = append(hops, poolmanagertypes.SwapAmountInRoute{PoolId: edge.PoolId, TokenOutDenom: edge.Denom})
+			visitedDenoms[edge.Denom] = true
+			visitedPools[edge.PoolId] = true
+
+			search(edge.Denom, poolPoints+edgePoolPoints, routeHops)
+
+			hops = hops[:len(hops)-1]
+			delete(visitedDenoms, edge.Denom)
+			delete(visitedPools, edge.PoolId)
+		}
+	}
+
+	// Search for shorter cycles first, as they consume fewer pool points
+	for routeHops := 2; routeHops <= maxHops; routeHops++ {
+		search(tokenIn, swappedPoolPoints, routeHops)
+	}
+
+	return routes, nil
+}
+
+// poolPointsResult is the number of pool points a pool consumes, or the error returned when getting them
+type poolPointsResult struct {
+	poolPoints uint64
+	err        error
+}
+
+// findPoolGraphEdge returns the id of the pool connecting to the neighbour denom among the given edges
+func findPoolGraphEdge(edges []PoolGraphEdge, neighbourDenom string) (uint64, bool) {
+	for _, edge := range edges {
+		if edge.Denom == neighbourDenom {
+			return edge.PoolId, true
+		}
+	}
+
+	return 0, false
+}
+
+// buildGraphRoute constructs a cyclic arbitrage route from a cycle found in the pool graph, by rotating it to start and end with the
+// highest priority base denom it goes through. The route consumes the given pool points, which do not depend on the rotation.
+func buildGraphRoute(cycle poolmanagertypes.SwapAmountInRoutes, baseDenoms []types.BaseDenom, routePoolPoints uint64) (RouteMetaData, error) {
+	for _, baseDenom := range baseDenoms {
+		for index, hop := range cycle {
+			if hop.TokenOutDenom != baseDenom.Denom {
+				continue
+			}
+
+			// The route starts with the hop after the one swapping into the base denom
+			newRoute := make(poolmanagertypes.SwapAmountInRoutes, 0, len(cycle))
+			newRoute = append(newRoute, cycle[index+1:]...)
+			newRoute = append(newRoute, cycle[:index+1]...)
+
+			return RouteMetaData{
+				Route:      newRoute,
+				PoolPoints: routePoolPoints,
+				StepSize:   baseDenom.StepSize,
+			}, nil
+		}
+	}
+
+	return RouteMetaData{}, fmt.Errorf("cycle through pools %v does not go through any base denom", cycle.PoolIds())
+}
+
+// containsRoute returns true if a route going through the same pools in the same order as the given route has been built
+func containsRoute(routes []RouteMetaData, route poolmanagertypes.SwapAmountInRoutes) bool {
+	for _, existingRoute := range routes {
+		if existingRoute.Route.Length() != route.Length() {
+			continue
+		}
+
+		samePools := true
+		for index, hop := range existingRoute.Route {
+			if hop.PoolId != route[index].PoolId {
+				samePools = false
+				break
+			}
+		}
+
+		if samePools {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CalculateRoutePoolPoints calculates the number of pool points that will be consumed by a route when simulating and executing trades. This
 // is only added to the global pool point counter if the route simulated is minimally profitable i.e. it will make a profit.
 func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (uint64, error) {
@@ -157,26 +355,12 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	totalWeight := uint64(0)
 
 	for _, poolId := range route.PoolIds() {
-		// Ensure that all of the pools in the route exist and are active
-		if err := k.IsValidPool(ctx, poolId); err != nil {
-			return 0, err
-		}
-
-		pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+		poolPoints, err := k.getPoolPoints(ctx, poolWeights, poolId)
 		if err != nil {
 			return 0, err
 		}
 
-		switch pool.GetType() {
-		case poolmanagertypes.Balancer:
-			totalWeight += poolWeights.BalancerWeight
-		case poolmanagertypes.Stableswap:
-			totalWeight += poolWeights.StableWeight
-		case poolmanagertypes.Concentrated:
-			totalWeight += poolWeights.ConcentratedWeight
-		default:
-			return 0, fmt.Errorf("invalid pool type")
-		}
+		totalWeight += poolPoints
 	}
 
 	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
@@ -192,6 +376,36 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	return totalWeight, nil
 }
 
+// getPoolPoints returns the number of pool points that a pool will consume given the weights of the pool types
+func (k Keeper) getPoolPoints(ctx sdk.Context, poolWeights types.PoolWeights, poolId uint64) (uint64, error) {
+	// Ensure that the pool exists and is active
+	if err := k.IsValidPool(ctx, poolId); err != nil {
+		return 0, err
+	}
+
+	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	switch pool.GetType() {
+	case poolmanagertypes.Balancer:
+		return poolWeights.BalancerWeight, nil
+	case poolmanagertypes.Stableswap:
+		return poolWeights.StableWeight, nil
+	case poolmanagertypes.Concentrated:
+		return poolWeights.ConcentratedWeight, nil
+	case poolmanagertypes.CosmWasm:
+		// The weight is unset if the pool weights were set before cosmwasm pools were supported
+		if poolWeights.CosmwasmWeight == 0 {
+			return 0, fmt.Errorf("cosmwasm pool weight is not set")
+		}
+		return poolWeights.CosmwasmWeight, nil
+	default:
+		return 0, fmt.Errorf("invalid pool type")
+	}
+}
+
 // IsValidPool checks if the pool is active and exists
 func (k Keeper) IsValidPool(ctx sdk.Context, poolID uint64) error {
 	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolID)
@@ -309,6 +309,169 @@ func (s *KeeperTestSuite) TestBuildHotRoutes() {
 	}
 }
 
+// TestBuildGraphRoutes tests the BuildGraphRoutes function
+func (s *KeeperTestSuite) TestBuildGraphRoutes() {
+	cases := []struct {
+		description       string
+		inputDenom        string
+		outputDenom       string
+		poolID            uint64
+		maxGraphRouteHops uint64
+		maxPointsPerTx    uint64
+		expectedRoutes    [][]TestRoute
+	}{
+		{
+			description:       "Three hop routes for swap in Bitcoin and swap out ethereum",
+			inputDenom:        "bitcoin",
+			outputDenom:       "ethereum",
+			poolID:            19,
+			maxGraphRouteHops: 3,
+			maxPointsPerTx:    18,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 3, InputDenom: "Atom", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 9, InputDenom: types.OsmosisDenomination, OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+				},
+			},
+		},
+		{
+			description:       "Shorter routes are built first for swap in Bitcoin and swap out ethereum",
+			inputDenom:        "bitcoin",
+			outputDenom:       "ethereum",
+			poolID:            19,
+			maxGraphRouteHops: 4,
+			maxPointsPerTx:    18,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 3, InputDenom: "Atom", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 9, InputDenom: types.OsmosisDenomination, OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+				},
+				{
+					{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+					{PoolId: 13, InputDenom: "akash", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+			},
+		},
+		{
+			description:       "Four hop routes consume more pool points than are remaining",
+			inputDenom:        "bitcoin",
+			outputDenom:       "ethereum",
+			poolID:            19,
+			maxGraphRouteHops: 4,
+			maxPointsPerTx:    6,
+			expectedRoutes: [][]TestRoute{
+				{
+					{PoolId: 3, InputDenom: "Atom", OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 4, InputDenom: "bitcoin", OutputDenom: "Atom"},
+				},
+				{
+					{PoolId: 9, InputDenom: types.OsmosisDenomination, OutputDenom: "ethereum"},
+					{PoolId: 19, InputDenom: "ethereum", OutputDenom: "bitcoin"},
+					{PoolId: 10, InputDenom: "bitcoin", OutputDenom: types.OsmosisDenomination},
+				},
+			},
+		},
+		{
+			description:       "Graph routes disabled",
+			inputDenom:        "bitcoin",
+			outputDenom:       "ethereum",
+			poolID:            19,
+			maxGraphRouteHops: 0,
+			maxPointsPerTx:    18,
+			expectedRoutes:    [][]TestRoute{},
+		},
+		{
+			description:       "No route goes through a base denom for swap on stable pool",
+			inputDenom:        "usdc",
+			outputDenom:       types.OsmosisDenomination,
+			poolID:            29,
+			maxGraphRouteHops: 4,
+			maxPointsPerTx:    18,
+			expectedRoutes:    [][]TestRoute{},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			s.SetupTest()
+			s.Require().NoError(s.App.ProtoRevKeeper.UpdatePoolGraph(s.Ctx))
+			s.Require().NoError(s.App.ProtoRevKeeper.SetMaxPointsPerTx(s.Ctx, tc.maxPointsPerTx))
+
+			params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+			params.MaxGraphRouteHops = tc.maxGraphRouteHops
+			s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+			routes, err := s.App.ProtoRevKeeper.BuildGraphRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID)
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(tc.expectedRoutes), len(routes))
+			s.Require().LessOrEqual(len(routes), types.MaxGraphRoutes)
+			if len(tc.expectedRoutes) == 0 {
+				s.Require().Empty(routes)
+			}
+
+			for routeIndex, expectedRoute := range tc.expectedRoutes {
+				s.Require().Equal(len(expectedRoute), routes[routeIndex].Route.Length())
+				for tradeIndex, trade := range expectedRoute {
+					s.Require().Equal(trade.PoolId, routes[routeIndex].Route[tradeIndex].PoolId)
+					s.Require().Equal(trade.OutputDenom, routes[routeIndex].Route[tradeIndex].TokenOutDenom)
+				}
+			}
+
+			for _, route := range routes {
+				s.Require().LessOrEqual(uint64(route.Route.Length()), tc.maxGraphRouteHops)
+				s.Require().LessOrEqual(route.PoolPoints, tc.maxPointsPerTx)
+
+				// the pool points read once per search match those of the route
+				routePoolPoints, err := s.App.ProtoRevKeeper.CalculateRoutePoolPoints(s.Ctx, route.Route)
+				s.Require().NoError(err)
+				s.Require().Equal(routePoolPoints, route.PoolPoints)
+			}
+		})
+	}
+}
+
+// TestBuildGraphRoutesMaxSearchNodes tests that the pool graph search stops after exploring the max number of paths
+func (s *KeeperTestSuite) TestBuildGraphRoutesMaxSearchNodes() {
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePoolGraph(s.Ctx))
+	s.Require().NoError(s.App.ProtoRevKeeper.SetMaxPointsPerTx(s.Ctx, 18))
+
+	routes, err := s.App.ProtoRevKeeper.BuildGraphRoutes(s.Ctx, "bitcoin", "ethereum", 19)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(routes)
+
+	// the first path explored is the swapped pool alone, which cannot close a cycle
+	boundedRoutes, err := s.App.ProtoRevKeeper.BuildGraphRoutesWithMaxSearchNodes(s.Ctx, "bitcoin", "ethereum", 19, 1)
+	s.Require().NoError(err)
+	s.Require().Empty(boundedRoutes)
+
+	// a bounded search finds the routes of the full search in the same order, up to the bound
+	prevRoutes := 0
+	for maxSearchNodes := 2; maxSearchNodes <= types.MaxGraphSearchNodes; maxSearchNodes++ {
+		boundedRoutes, err := s.App.ProtoRevKeeper.BuildGraphRoutesWithMaxSearchNodes(s.Ctx, "bitcoin", "ethereum", 19, maxSearchNodes)
+		s.Require().NoError(err)
+		s.Require().GreaterOrEqual(len(boundedRoutes), prevRoutes)
+		s.Require().LessOrEqual(len(boundedRoutes), len(routes))
+		s.Require().Equal(routes[:len(boundedRoutes)], boundedRoutes)
+		prevRoutes = len(boundedRoutes)
+	}
+	s.Require().Equal(len(routes), prevRoutes)
+}
+
 // TestCalculateRoutePoolPoints tests the CalculateRoutePoolPoints function
 func (s *KeeperTestSuite) TestCalculateRoutePoolPoints() {
 	cases := []struct {
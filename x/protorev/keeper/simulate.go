@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+// SimulateBackrun simulates the backrun of a hypothetical swap of tokenIn for tokenOutDenom on the given pool. The swap is executed
+// in a cached context by the module account, after which the routes are built and iterated as in the posthandler with the pool
+// points of a fresh block. None of the state changes are written. It returns the routes that were considered, the most profitable
+// route, its optimal amount in, and its expected profit in the input denom and in uosmo.
+func (k Keeper) SimulateBackrun(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (res *types.QuerySimulateBackrunResponse, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, fmt.Errorf("failed to simulate backrun: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	// Execute the hypothetical swap with newly minted tokens
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return nil, err
+	}
+	swapRoute := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	if _, err := k.poolmanagerKeeper.RouteExactAmountIn(cacheCtx, k.accountKeeper.GetModuleAddress(types.ModuleName), swapRoute, tokenIn, sdk.OneInt()); err != nil {
+		return nil, err
+	}
+
+	// Simulate the backrun with all of the pool points of a new block
+	k.SetPointCountForBlock(cacheCtx, 0)
+	remainingTxPoolPoints, remainingBlockPoolPoints, err := k.GetRemainingPoolPoints(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := k.BuildRoutes(cacheCtx, tokenIn.Denom, tokenOutDenom, poolId)
+	maxProfitInputCoin, osmoProfit, optimalRoute := k.IterateRoutes(cacheCtx, routes, &remainingTxPoolPoints, &remainingBlockPoolPoints)
+
+	res = &types.QuerySimulateBackrunResponse{
+		Routes:       make([]types.SimulatedRoute, 0, len(routes)),
+		OptimalInput: sdk.Coin{Amount: sdk.ZeroInt()},
+		Profit:       sdk.Coin{Amount: sdk.ZeroInt()},
+		OsmoProfit:   osmoProfit,
+	}
+	for _, route := range routes {
+		res.Routes = append(res.Routes, types.SimulatedRoute{
+			Route:      route.Route,
+			PoolPoints: route.PoolPoints,
+			StepSize:   route.StepSize,
+		})
+	}
+
+	if osmoProfit.IsPositive() {
+		// Estimate the profit in the input denom, since IterateRoutes returns it in uosmo
+		_, profit, err := k.EstimateMultihopProfit(cacheCtx, maxProfitInputCoin.Denom, maxProfitInputCoin.Amount, optimalRoute)
+		if err != nil {
+			return nil, err
+		}
+
+		res.BestRoute = optimalRoute
+		res.OptimalInput = maxProfitInputCoin
+		res.Profit = sdk.NewCoin(maxProfitInputCoin.Denom, profit)
+	}
+
+	return res, nil
+}
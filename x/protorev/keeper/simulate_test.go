@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+// TestSimulateBackrun tests that SimulateBackrun returns the backrun that the posthandler executes after the same swap,
+// without changing any state.
+func (s *KeeperTestSuite) TestSimulateBackrun() {
+	// Mainnet Arb (Block: 5905150) - Highest Liquidity Pool Build
+	poolId := uint64(23)
+	tokenIn := sdk.NewCoin("ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0", sdk.NewInt(1000))
+	tokenOutDenom := "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC"
+
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	liquidityBefore, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, poolId)
+	s.Require().NoError(err)
+	moduleBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress)
+	pointCountBefore, err := s.App.ProtoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+
+	res, err := s.App.ProtoRevKeeper.SimulateBackrun(s.Ctx, poolId, tokenIn, tokenOutDenom)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Routes)
+	s.Require().NotEmpty(res.BestRoute)
+	s.Require().True(res.Profit.IsPositive())
+	s.Require().True(res.OsmoProfit.IsPositive())
+	s.Require().Equal(res.OptimalInput.Denom, res.BestRoute[len(res.BestRoute)-1].TokenOutDenom)
+
+	// No state is changed by the simulation
+	liquidityAfter, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(liquidityBefore, liquidityAfter)
+	s.Require().Equal(moduleBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddress))
+	pointCountAfter, err := s.App.ProtoRevKeeper.GetPointCountForBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(pointCountBefore, pointCountAfter)
+	_, err = s.App.ProtoRevKeeper.GetNumberOfTrades(s.Ctx)
+	s.Require().Error(err)
+
+	// Executing the same swap and backrun results in the simulated profit
+	_, err = s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.App.ProtoRevKeeper.DeleteSwapsToBackrun(s.Ctx)
+	err = s.App.ProtoRevKeeper.ProtoRevTrade(s.Ctx, []keeper.SwapToBackrun{{PoolId: poolId, TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}})
+	s.Require().NoError(err)
+
+	profit, err := s.App.ProtoRevKeeper.GetProfitsByDenom(s.Ctx, res.Profit.Denom)
+	s.Require().NoError(err)
+	s.Require().Equal(res.Profit, profit)
+	trades, err := s.App.ProtoRevKeeper.GetTradesByRoute(s.Ctx, poolmanagertypes.SwapAmountInRoutes(res.BestRoute).PoolIds())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.OneInt(), trades)
+}
+
+// TestSimulateBackrunNoArb tests that SimulateBackrun returns the considered routes without a best route when no route is profitable
+func (s *KeeperTestSuite) TestSimulateBackrunNoArb() {
+	res, err := s.App.ProtoRevKeeper.SimulateBackrun(s.Ctx, 12, sdk.NewCoin("juno", sdk.NewInt(100)), "akash")
+	s.Require().NoError(err)
+	s.Require().Empty(res.BestRoute)
+	s.Require().True(res.Profit.Amount.IsZero())
+	s.Require().True(res.OsmoProfit.IsZero())
+
+	// Swaps that cannot be executed return an error
+	_, err = s.App.ProtoRevKeeper.SimulateBackrun(s.Ctx, 12, sdk.NewCoin("juno", sdk.NewInt(100)), "notadenom")
+	s.Require().Error(err)
+}
@@ -231,5 +231,465 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 		return err
 	}
 
+	// Update the statistics of the current epoch for the given route and denom
+	if err := k.UpdateEpochStatistics(ctx, route.PoolIds(), denom, profit); err != nil {
+		return err
+	}
+
+	// Track the profits made on each pool of the route if part of the profits are distributed to liquidity providers
+	if distribution, ok := k.GetProfitDistribution(ctx, denom); ok && distribution.LiquidityProviders.IsPositive() {
+		if err := k.UpdatePoolProfitsForDistribution(ctx, route.PoolIds(), denom, profit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPoolProfitsForDistribution returns the profits made in the given denom on the given pool since the last distribution
+func (k Keeper) GetPoolProfitsForDistribution(ctx sdk.Context, denom string, poolId uint64) (sdk.Int, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixPoolProfitsForDistribution(denom, poolId)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), fmt.Errorf("no profits for pool %d in denom %s", poolId, denom)
+	}
+
+	profits := sdk.Int{}
+	if err := profits.Unmarshal(bz); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return profits, nil
+}
+
+// GetAllPoolProfitsForDistribution returns the profits made in the given denom on each pool since the last distribution, ordered by pool id
+func (k Keeper) GetAllPoolProfitsForDistribution(ctx sdk.Context, denom string) []PoolProfit {
+	poolProfits := make([]PoolProfit, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixPoolProfitsForDistributionByDenom(denom)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		profit := sdk.Int{}
+		if err := profit.Unmarshal(iterator.Value()); err == nil {
+			poolId := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+			poolProfits = append(poolProfits, PoolProfit{PoolId: poolId, Profit: profit})
+		}
+	}
+
+	return poolProfits
+}
+
+// GetPoolProfitsForDistributionOfAllDenoms returns the profits made on each pool since the last distribution in every denom,
+// ordered by denom and pool id
+func (k Keeper) GetPoolProfitsForDistributionOfAllDenoms(ctx sdk.Context) []types.PoolProfitsForDistribution {
+	poolProfits := make([]types.PoolProfitsForDistribution, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolProfitsForDistribution)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// The key is the prefix, followed by the denom, a separator and the pool id
+		key := iterator.Key()[len(types.KeyPrefixPoolProfitsForDistribution):]
+		if len(key) <= 9 {
+			continue
+		}
+
+		profit := sdk.Int{}
+		if err := profit.Unmarshal(iterator.Value()); err == nil {
+			denom := string(key[:len(key)-9])
+			poolId := sdk.BigEndianToUint64(key[len(key)-8:])
+			poolProfits = append(poolProfits, types.PoolProfitsForDistribution{PoolId: poolId, Profit: sdk.NewCoin(denom, profit)})
+		}
+	}
+
+	return poolProfits
+}
+
+// UpdatePoolProfitsForDistribution adds the profit of a trade in the given denom to each pool of the route
+func (k Keeper) UpdatePoolProfitsForDistribution(ctx sdk.Context, route []uint64, denom string, profit sdk.Int) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, poolId := range route {
+		profits, _ := k.GetPoolProfitsForDistribution(ctx, denom, poolId)
+		profits = profits.Add(profit)
+		bz, err := profits.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetKeyPrefixPoolProfitsForDistribution(denom, poolId), bz)
+	}
+
 	return nil
 }
+
+// DeleteAllPoolProfitsForDistribution deletes the profits made in the given denom on all pools since the last distribution
+func (k Keeper) DeleteAllPoolProfitsForDistribution(ctx sdk.Context, denom string) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.GetKeyPrefixPoolProfitsForDistributionByDenom(denom))
+}
+
+// GetProfitsForDistribution returns the profits in the given denom that are held in the module account and are yet to be distributed
+func (k Keeper) GetProfitsForDistribution(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixProfitsForDistribution(denom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), fmt.Errorf("no profits for distribution for denom %s", denom)
+	}
+
+	profits := sdk.Coin{}
+	if err := profits.Unmarshal(bz); err != nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), err
+	}
+
+	return profits, nil
+}
+
+// GetAllProfitsForDistribution returns the profits in every denom that are yet to be distributed, ordered by denom
+func (k Keeper) GetAllProfitsForDistribution(ctx sdk.Context) []sdk.Coin {
+	profits := make([]sdk.Coin, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixProfitsForDistribution)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		profit := sdk.Coin{}
+		if err := profit.Unmarshal(iterator.Value()); err == nil {
+			profits = append(profits, profit)
+		}
+	}
+
+	return profits
+}
+
+// SetProfitsForDistribution sets the profits in the denom of the given coin that are yet to be distributed. Zero profits are deleted.
+func (k Keeper) SetProfitsForDistribution(ctx sdk.Context, profits sdk.Coin) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixProfitsForDistribution(profits.Denom)
+
+	if profits.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+
+	bz, err := profits.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// UpdateProfitsForDistribution adds the profit of a trade, net of the developer fee, to the profits in the given denom
+// that are yet to be distributed
+func (k Keeper) UpdateProfitsForDistribution(ctx sdk.Context, denom string, profit sdk.Int) error {
+	profits, _ := k.GetProfitsForDistribution(ctx, denom)
+	profits.Amount = profits.Amount.Add(profit)
+	return k.SetProfitsForDistribution(ctx, profits)
+}
+
+// GetDistributedProfits returns the profits distributed by the ProtoRev module to the given destination for the given denom
+func (k Keeper) GetDistributedProfits(ctx sdk.Context, destination, denom string) (sdk.Coin, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixDistributedProfits(destination, denom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), fmt.Errorf("no profits distributed to %s for denom %s", destination, denom)
+	}
+
+	profits := sdk.Coin{}
+	if err := profits.Unmarshal(bz); err != nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), err
+	}
+
+	return profits, nil
+}
+
+// GetDistributedProfitsInOsmo returns the uosmo value of the profits distributed by the ProtoRev module to the given destination
+func (k Keeper) GetDistributedProfitsInOsmo(ctx sdk.Context, destination string) (sdk.Int, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixDistributedProfitsInOsmo(destination)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), fmt.Errorf("no profits distributed to %s", destination)
+	}
+
+	value := sdk.Int{}
+	if err := value.Unmarshal(bz); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return value, nil
+}
+
+// GetAllDistributedProfits returns the profits distributed by the ProtoRev module to each destination
+func (k Keeper) GetAllDistributedProfits(ctx sdk.Context) []types.DistributedProfits {
+	distributedProfits := make([]types.DistributedProfits, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, destination := range types.DistributionDestinations {
+		amounts := sdk.NewCoins()
+
+		iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixDistributedProfitsByDestination(destination))
+		for ; iterator.Valid(); iterator.Next() {
+			amount := sdk.Coin{}
+			if err := amount.Unmarshal(iterator.Value()); err == nil {
+				amounts = amounts.Add(amount)
+			}
+		}
+		iterator.Close()
+
+		if amounts.IsZero() {
+			continue
+		}
+
+		osmoValue, _ := k.GetDistributedProfitsInOsmo(ctx, destination)
+		distributedProfits = append(distributedProfits, types.DistributedProfits{
+			Destination: destination,
+			Amounts:     amounts,
+			OsmoValue:   osmoValue,
+		})
+	}
+
+	return distributedProfits
+}
+
+// UpdateDistributedProfits updates the profits distributed by the ProtoRev module to the given destination,
+// alongside their value in uosmo
+func (k Keeper) UpdateDistributedProfits(ctx sdk.Context, destination string, amount sdk.Coin, osmoValue sdk.Int) error {
+	store := ctx.KVStore(k.storeKey)
+
+	profits, _ := k.GetDistributedProfits(ctx, destination, amount.Denom)
+	profits.Amount = profits.Amount.Add(amount.Amount)
+	bz, err := profits.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetKeyPrefixDistributedProfits(destination, amount.Denom), bz)
+
+	value, _ := k.GetDistributedProfitsInOsmo(ctx, destination)
+	value = value.Add(osmoValue)
+	bz, err = value.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetKeyPrefixDistributedProfitsInOsmo(destination), bz)
+
+	return nil
+}
+
+// SetDistributedProfits sets the profits distributed by the ProtoRev module to the given destination, alongside their
+// value in uosmo
+func (k Keeper) SetDistributedProfits(ctx sdk.Context, distributedProfits types.DistributedProfits) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, amount := range distributedProfits.Amounts {
+		bz, err := amount.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetKeyPrefixDistributedProfits(distributedProfits.Destination, amount.Denom), bz)
+	}
+
+	bz, err := distributedProfits.OsmoValue.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetKeyPrefixDistributedProfitsInOsmo(distributedProfits.Destination), bz)
+
+	return nil
+}
+
+// GetCurrentStatisticsEpoch returns the number of the current epoch, which is used to bucket the per epoch statistics
+func (k Keeper) GetCurrentStatisticsEpoch(ctx sdk.Context) uint64 {
+	return uint64(k.epochKeeper.GetEpochInfo(ctx, types.EpochIdentifier).CurrentEpoch)
+}
+
+// GetEpochStatisticsByDenom returns the statistics of the trades executed with the given base denom during the given epoch
+func (k Keeper) GetEpochStatisticsByDenom(ctx sdk.Context, epoch uint64, denom string) (types.EpochDenomStatistics, error) {
+	statistics := types.EpochDenomStatistics{Epoch: epoch, Denom: denom, Profit: sdk.ZeroInt(), NumberOfTrades: sdk.ZeroInt()}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPrefixEpochStatisticsByDenom(epoch, denom))
+	if len(bz) == 0 {
+		return statistics, fmt.Errorf("no statistics for denom %s in epoch %d", denom, epoch)
+	}
+
+	found := types.EpochDenomStatistics{}
+	if err := k.cdc.Unmarshal(bz, &found); err != nil {
+		return statistics, err
+	}
+
+	return found, nil
+}
+
+// SetEpochStatisticsByDenom sets the statistics of the trades executed with a base denom during an epoch
+func (k Keeper) SetEpochStatisticsByDenom(ctx sdk.Context, statistics types.EpochDenomStatistics) error {
+	bz, err := k.cdc.Marshal(&statistics)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixEpochStatisticsByDenom(statistics.Epoch, statistics.Denom), bz)
+	return nil
+}
+
+// GetEpochStatisticsByDenomInRange returns the statistics of the trades executed with the given base denom (or all base denoms
+// if empty) during the epochs from fromEpoch to toEpoch inclusive, ordered by epoch
+func (k Keeper) GetEpochStatisticsByDenomInRange(ctx sdk.Context, denom string, fromEpoch, toEpoch uint64) ([]types.EpochDenomStatistics, error) {
+	allStatistics := make([]types.EpochDenomStatistics, 0)
+
+	iterator := k.epochStatisticsIterator(ctx, types.KeyPrefixEpochStatisticsByDenom, fromEpoch, toEpoch)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		statistics := types.EpochDenomStatistics{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &statistics); err != nil {
+			return nil, err
+		}
+
+		if denom == "" || statistics.Denom == denom {
+			allStatistics = append(allStatistics, statistics)
+		}
+	}
+
+	return allStatistics, nil
+}
+
+// GetEpochStatisticsByRoute returns the statistics of the trades executed on the given route during the given epoch
+func (k Keeper) GetEpochStatisticsByRoute(ctx sdk.Context, epoch uint64, route []uint64) (types.EpochRouteStatistics, error) {
+	statistics := types.EpochRouteStatistics{Epoch: epoch, Route: route, Profits: sdk.NewCoins(), NumberOfTrades: sdk.ZeroInt()}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPrefixEpochStatisticsByRoute(epoch, route))
+	if len(bz) == 0 {
+		return statistics, fmt.Errorf("no statistics for route %d in epoch %d", route, epoch)
+	}
+
+	found := types.EpochRouteStatistics{}
+	if err := k.cdc.Unmarshal(bz, &found); err != nil {
+		return statistics, err
+	}
+
+	return found, nil
+}
+
+// SetEpochStatisticsByRoute sets the statistics of the trades executed on a route during an epoch
+func (k Keeper) SetEpochStatisticsByRoute(ctx sdk.Context, statistics types.EpochRouteStatistics) error {
+	bz, err := k.cdc.Marshal(&statistics)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixEpochStatisticsByRoute(statistics.Epoch, statistics.Route), bz)
+	return nil
+}
+
+// GetEpochStatisticsByRouteInRange returns the statistics of the trades executed on the given route (or all routes if empty)
+// during the epochs from fromEpoch to toEpoch inclusive, ordered by epoch
+func (k Keeper) GetEpochStatisticsByRouteInRange(ctx sdk.Context, route []uint64, fromEpoch, toEpoch uint64) ([]types.EpochRouteStatistics, error) {
+	allStatistics := make([]types.EpochRouteStatistics, 0)
+	routeKey := string(types.CreateRouteKey(route))
+
+	iterator := k.epochStatisticsIterator(ctx, types.KeyPrefixEpochStatisticsByRoute, fromEpoch, toEpoch)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		statistics := types.EpochRouteStatistics{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &statistics); err != nil {
+			return nil, err
+		}
+
+		if len(route) == 0 || string(types.CreateRouteKey(statistics.Route)) == routeKey {
+			allStatistics = append(allStatistics, statistics)
+		}
+	}
+
+	return allStatistics, nil
+}
+
+// UpdateEpochStatistics adds a trade and its profit to the statistics of the current epoch for the given route and base denom
+func (k Keeper) UpdateEpochStatistics(ctx sdk.Context, route []uint64, denom string, profit sdk.Int) error {
+	return k.updateEpochStatistics(ctx, route, denom, profit, sdk.OneInt(), 0)
+}
+
+// UpdateEpochPointsUsed adds the pool points consumed to find the optimal amount in of the given route to the statistics
+// of the current epoch for the given route and base denom
+func (k Keeper) UpdateEpochPointsUsed(ctx sdk.Context, route []uint64, denom string, points uint64) error {
+	return k.updateEpochStatistics(ctx, route, denom, sdk.ZeroInt(), sdk.ZeroInt(), points)
+}
+
+// updateEpochStatistics updates the statistics of the current epoch for the given route and base denom. Nothing is tracked if
+// the per epoch statistics are disabled.
+func (k Keeper) updateEpochStatistics(ctx sdk.Context, route []uint64, denom string, profit, trades sdk.Int, points uint64) error {
+	if k.GetParams(ctx).EpochStatisticsRetention == 0 {
+		return nil
+	}
+
+	epoch := k.GetCurrentStatisticsEpoch(ctx)
+
+	denomStatistics, _ := k.GetEpochStatisticsByDenom(ctx, epoch, denom)
+	denomStatistics.Profit = denomStatistics.Profit.Add(profit)
+	denomStatistics.NumberOfTrades = denomStatistics.NumberOfTrades.Add(trades)
+	denomStatistics.PointsUsed += points
+	if err := k.SetEpochStatisticsByDenom(ctx, denomStatistics); err != nil {
+		return err
+	}
+
+	routeStatistics, _ := k.GetEpochStatisticsByRoute(ctx, epoch, route)
+	if profit.IsPositive() {
+		routeStatistics.Profits = sdk.NewCoins(routeStatistics.Profits...).Add(sdk.NewCoin(denom, profit))
+	}
+	routeStatistics.NumberOfTrades = routeStatistics.NumberOfTrades.Add(trades)
+	routeStatistics.PointsUsed += points
+	return k.SetEpochStatisticsByRoute(ctx, routeStatistics)
+}
+
+// PruneEpochStatistics deletes the per epoch statistics of the epochs that are no longer retained, given the current epoch
+func (k Keeper) PruneEpochStatistics(ctx sdk.Context, currentEpoch uint64) {
+	retention := k.GetParams(ctx).EpochStatisticsRetention
+	if currentEpoch < retention {
+		return
+	}
+
+	// Only the statistics of the last retention epochs (including the current one) are kept
+	for _, keyPrefix := range [][]byte{types.KeyPrefixEpochStatisticsByDenom, types.KeyPrefixEpochStatisticsByRoute} {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator(keyPrefix, append(append([]byte{}, keyPrefix...), sdk.Uint64ToBigEndian(currentEpoch-retention+1)...))
+
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// epochStatisticsIterator returns an iterator over the per epoch statistics stored under the given prefix for the epochs
+// from fromEpoch to toEpoch inclusive
+func (k Keeper) epochStatisticsIterator(ctx sdk.Context, keyPrefix []byte, fromEpoch, toEpoch uint64) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	start := sdk.Uint64ToBigEndian(fromEpoch)
+	var end []byte
+	if toEpoch < ^uint64(0) {
+		end = sdk.Uint64ToBigEndian(toEpoch + 1)
+	}
+
+	return store.Iterator(start, end)
+}
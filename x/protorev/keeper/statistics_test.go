@@ -192,3 +192,101 @@ func (s *KeeperTestSuite) TestUpdateStatistics() {
 	s.Require().NoError(err)
 	s.Require().Equal(2, len(routes))
 }
+
+// setStatisticsEpoch sets the current number of the epoch used to bucket the per epoch statistics
+func (s *KeeperTestSuite) setStatisticsEpoch(epoch int64) {
+	epochInfo := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, types.EpochIdentifier)
+	epochInfo.CurrentEpoch = epoch
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, types.EpochIdentifier)
+	s.Require().NoError(s.App.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo))
+}
+
+// TestEpochStatistics tests UpdateEpochStatistics, UpdateEpochPointsUsed, the range getters and PruneEpochStatistics
+func (s *KeeperTestSuite) TestEpochStatistics() {
+	route := poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}}
+	otherRoute := []uint64{2, 3, 4}
+
+	// Pseudo execute trades across three epochs
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		s.setStatisticsEpoch(epoch)
+
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateEpochPointsUsed(s.Ctx, route.PoolIds(), types.OsmosisDenomination, 6))
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, sdk.NewInt(epoch*100)))
+		s.Require().NoError(s.App.ProtoRevKeeper.UpdateEpochPointsUsed(s.Ctx, otherRoute, "Atom", 4))
+	}
+
+	// Check the statistics of a single epoch
+	denomStatistics, err := s.App.ProtoRevKeeper.GetEpochStatisticsByDenom(s.Ctx, 2, types.OsmosisDenomination)
+	s.Require().NoError(err)
+	s.Require().Equal(types.EpochDenomStatistics{
+		Epoch:          2,
+		Denom:          types.OsmosisDenomination,
+		Profit:         sdk.NewInt(200),
+		NumberOfTrades: sdk.OneInt(),
+		PointsUsed:     6,
+	}, denomStatistics)
+
+	routeStatistics, err := s.App.ProtoRevKeeper.GetEpochStatisticsByRoute(s.Ctx, 2, route.PoolIds())
+	s.Require().NoError(err)
+	s.Require().Equal(types.EpochRouteStatistics{
+		Epoch:          2,
+		Route:          route.PoolIds(),
+		Profits:        sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(200))),
+		NumberOfTrades: sdk.OneInt(),
+		PointsUsed:     6,
+	}, routeStatistics)
+
+	// Epochs without trades have no statistics
+	_, err = s.App.ProtoRevKeeper.GetEpochStatisticsByDenom(s.Ctx, 4, types.OsmosisDenomination)
+	s.Require().Error(err)
+
+	// Check the range getters
+	denomRange, err := s.App.ProtoRevKeeper.GetEpochStatisticsByDenomInRange(s.Ctx, types.OsmosisDenomination, 2, 3)
+	s.Require().NoError(err)
+	s.Require().Len(denomRange, 2)
+	s.Require().Equal(uint64(2), denomRange[0].Epoch)
+	s.Require().Equal(sdk.NewInt(300), denomRange[1].Profit)
+
+	allDenomsRange, err := s.App.ProtoRevKeeper.GetEpochStatisticsByDenomInRange(s.Ctx, "", 1, 3)
+	s.Require().NoError(err)
+	s.Require().Len(allDenomsRange, 6)
+
+	routeRange, err := s.App.ProtoRevKeeper.GetEpochStatisticsByRouteInRange(s.Ctx, otherRoute, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Len(routeRange, 2)
+	s.Require().Equal(sdk.ZeroInt(), routeRange[0].NumberOfTrades)
+	s.Require().Equal(uint64(4), routeRange[0].PointsUsed)
+
+	allRoutesRange, err := s.App.ProtoRevKeeper.GetEpochStatisticsByRouteInRange(s.Ctx, nil, 3, 3)
+	s.Require().NoError(err)
+	s.Require().Len(allRoutesRange, 2)
+
+	// Pruning as of epoch 4 with a retention of 2 epochs keeps epochs 3 and 4
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.EpochStatisticsRetention = 2
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+	s.App.ProtoRevKeeper.PruneEpochStatistics(s.Ctx, 4)
+
+	allDenomsRange, err = s.App.ProtoRevKeeper.GetEpochStatisticsByDenomInRange(s.Ctx, "", 0, 4)
+	s.Require().NoError(err)
+	s.Require().Len(allDenomsRange, 2)
+	allRoutesRange, err = s.App.ProtoRevKeeper.GetEpochStatisticsByRouteInRange(s.Ctx, nil, 0, 4)
+	s.Require().NoError(err)
+	s.Require().Len(allRoutesRange, 2)
+	for _, statistics := range allRoutesRange {
+		s.Require().Equal(uint64(3), statistics.Epoch)
+	}
+
+	// Disabling the per epoch statistics stops tracking them and prunes all of them
+	params.EpochStatisticsRetention = 0
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+	s.setStatisticsEpoch(4)
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, sdk.NewInt(100)))
+	_, err = s.App.ProtoRevKeeper.GetEpochStatisticsByDenom(s.Ctx, 4, types.OsmosisDenomination)
+	s.Require().Error(err)
+
+	s.App.ProtoRevKeeper.PruneEpochStatistics(s.Ctx, 4)
+	allDenomsRange, err = s.App.ProtoRevKeeper.GetEpochStatisticsByDenomInRange(s.Ctx, "", 0, 4)
+	s.Require().NoError(err)
+	s.Require().Empty(allDenomsRange)
+}
@@ -7,6 +7,9 @@ import (
 // OsmosisDenomination stores the native denom name for Osmosis on chain used for route building
 var OsmosisDenomination string = "uosmo"
 
+// EpochIdentifier is the identifier of the epoch that triggers the epoch hook and buckets the per epoch statistics
+const EpochIdentifier = "day"
+
 // ----------------- Module Execution Time Constants ----------------- //
 
 // MaxInputAmount is the upper bound index for finding the optimal in amount when determining route profitability (2 ^ 14) = 16,384
@@ -19,6 +22,14 @@ var ExtendedMaxInputAmount = sdk.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// Lower bound for the precision of the golden section search, as a fraction of the step size. The search
+// is also bounded to as many profit estimates as the binary search makes (at most 2 * MaxIterations), so it
+// may stop before reaching the precision over wide ranges
+var MinGoldenSectionSearchPrecision = sdk.NewDecWithPrec(1, 4)
+
+// InvGoldenRatio is the factor by which the golden section search shrinks its range every iteration ((sqrt(5) - 1) / 2)
+var InvGoldenRatio = sdk.MustNewDecFromStr("0.618033988749894848")
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -27,6 +38,26 @@ const MaxPoolPointsPerTx uint64 = 50
 // to the maximum execution time (in ms) of protorev per block
 const MaxPoolPointsPerBlock uint64 = 200
 
+// Upper bound for the max number of hops of graph routes. The number of graph routes
+// searched grows with MaxPoolGraphDegree to the power of the number of hops
+const MaxGraphRouteHopsLimit uint64 = 5
+
+// Max number of denoms each denom is connected to in the pool graph. Only the pairs
+// with the highest liquidity pools are kept
+const MaxPoolGraphDegree int = 8
+
+// Max number of graph routes that can be built per swap
+const MaxGraphRoutes int = 10
+
+// Max number of paths explored in the pool graph when building the graph routes of a swap,
+// so that the search is bounded regardless of the pool points remaining for the tx
+const MaxGraphSearchNodes int = 500
+
+// ---------------- Module Statistics Constants ---------------- //
+
+// Upper bound for the number of epochs for which the per epoch statistics are kept (one year of day epochs)
+const MaxEpochStatisticsRetention uint64 = 365
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
@@ -43,3 +74,21 @@ const (
 
 // All other years (5% of total profit)
 const ProfitSplitPhase3 int64 = 5
+
+// ---------------- Module Profit Distribution Constants ---------------- //
+
+// Destinations of the profits distributed at the end of each day epoch
+const (
+	DistributionDestinationBurn               = "burn"
+	DistributionDestinationCommunityPool      = "community_pool"
+	DistributionDestinationStakers            = "stakers"
+	DistributionDestinationLiquidityProviders = "liquidity_providers"
+)
+
+// DistributionDestinations lists all of the profit distribution destinations in a deterministic order
+var DistributionDestinations = []string{
+	DistributionDestinationBurn,
+	DistributionDestinationCommunityPool,
+	DistributionDestinationStakers,
+	DistributionDestinationLiquidityProviders,
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
@@ -20,6 +22,29 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistributionKeeper defines the distribution contract that must be fulfilled when
+// creating a x/protorev keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// IncentivesKeeper defines the incentives contract that must be fulfilled
+// by the x/protorev keeper to distribute profits to liquidity providers.
+type IncentivesKeeper interface {
+	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
+	GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo
+}
+
+// PoolIncentivesKeeper defines the pool incentives contract that must be fulfilled
+// by the x/protorev keeper to distribute profits to liquidity providers.
+type PoolIncentivesKeeper interface {
+	GetPoolGaugeId(ctx sdk.Context, poolId uint64, lockableDuration time.Duration) (uint64, error)
+	GetLongestLockableDuration(ctx sdk.Context) (time.Duration, error)
 }
 
 // GAMMKeeper defines the Gamm contract that must be fulfilled when
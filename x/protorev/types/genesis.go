@@ -20,32 +20,43 @@ var (
 		StableWeight:       5, // it takes around 5 ms to simulate and execute a stable swap
 		BalancerWeight:     2, // it takes around 2 ms to simulate and execute a balancer swap
 		ConcentratedWeight: 2, // it takes around 2 ms to simulate and execute a concentrated swap
+		CosmwasmWeight:     5, // it takes around 5 ms to simulate and execute a cosmwasm swap
 	}
-	DefaultDaysSinceModuleGenesis    = uint64(0)
-	DefaultDeveloperFees             = []sdk.Coin{}
-	DefaultLatestBlockHeight         = uint64(0)
-	DefaultDeveloperAddress          = ""
-	DefaultMaxPoolPointsPerBlock     = uint64(100)
-	DefaultMaxPoolPointsPerTx        = uint64(18)
-	DefaultPoolPointsConsumedInBlock = uint64(0)
-	DefaultProfits                   = []sdk.Coin{}
+	DefaultDaysSinceModuleGenesis     = uint64(0)
+	DefaultDeveloperFees              = []sdk.Coin{}
+	DefaultLatestBlockHeight          = uint64(0)
+	DefaultDeveloperAddress           = ""
+	DefaultMaxPoolPointsPerBlock      = uint64(100)
+	DefaultMaxPoolPointsPerTx         = uint64(18)
+	DefaultPoolPointsConsumedInBlock  = uint64(0)
+	DefaultProfits                    = []sdk.Coin{}
+	DefaultPoolProfitsForDistribution = []PoolProfitsForDistribution{}
+	DefaultDistributedProfits         = []DistributedProfits{}
+	DefaultEpochDenomStatistics       = []EpochDenomStatistics{}
+	DefaultEpochRouteStatistics       = []EpochRouteStatistics{}
+	DefaultProfitsForDistribution     = []sdk.Coin{}
 )
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                 DefaultParams(),
-		TokenPairArbRoutes:     DefaultTokenPairArbRoutes,
-		BaseDenoms:             DefaultBaseDenoms,
-		PoolWeights:            DefaultPoolWeights,
-		DaysSinceModuleGenesis: DefaultDaysSinceModuleGenesis,
-		DeveloperFees:          DefaultDeveloperFees,
-		DeveloperAddress:       DefaultDeveloperAddress,
-		LatestBlockHeight:      DefaultLatestBlockHeight,
-		MaxPoolPointsPerBlock:  DefaultMaxPoolPointsPerBlock,
-		MaxPoolPointsPerTx:     DefaultMaxPoolPointsPerTx,
-		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
-		Profits:                DefaultProfits,
+		Params:                     DefaultParams(),
+		TokenPairArbRoutes:         DefaultTokenPairArbRoutes,
+		BaseDenoms:                 DefaultBaseDenoms,
+		PoolWeights:                DefaultPoolWeights,
+		DaysSinceModuleGenesis:     DefaultDaysSinceModuleGenesis,
+		DeveloperFees:              DefaultDeveloperFees,
+		DeveloperAddress:           DefaultDeveloperAddress,
+		LatestBlockHeight:          DefaultLatestBlockHeight,
+		MaxPoolPointsPerBlock:      DefaultMaxPoolPointsPerBlock,
+		MaxPoolPointsPerTx:         DefaultMaxPoolPointsPerTx,
+		PointCountForBlock:         DefaultPoolPointsConsumedInBlock,
+		Profits:                    DefaultProfits,
+		PoolProfitsForDistribution: DefaultPoolProfitsForDistribution,
+		DistributedProfits:         DefaultDistributedProfits,
+		EpochDenomStatistics:       DefaultEpochDenomStatistics,
+		EpochRouteStatistics:       DefaultEpochRouteStatistics,
+		ProfitsForDistribution:     DefaultProfitsForDistribution,
 	}
 }
 
@@ -88,6 +99,30 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the profits made on each pool since the last distribution
+	if err := ValidatePoolProfitsForDistribution(gs.PoolProfitsForDistribution); err != nil {
+		return err
+	}
+
+	// Validate the profits distributed to each destination
+	if err := ValidateDistributedProfits(gs.DistributedProfits); err != nil {
+		return err
+	}
+
+	// Validate the per epoch statistics
+	if err := ValidateEpochDenomStatistics(gs.EpochDenomStatistics); err != nil {
+		return err
+	}
+
+	if err := ValidateEpochRouteStatistics(gs.EpochRouteStatistics); err != nil {
+		return err
+	}
+
+	// Validate the profits that are yet to be distributed
+	if err := sdk.Coins(gs.ProfitsForDistribution).Validate(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -55,6 +55,18 @@ type GenesisState struct {
 	PointCountForBlock uint64 `protobuf:"varint,11,opt,name=point_count_for_block,json=pointCountForBlock,proto3" json:"point_count_for_block,omitempty" yaml:"point_count_for_block"`
 	// All of the profits that have been accumulated by the module.
 	Profits []types.Coin `protobuf:"bytes,12,rep,name=profits,proto3" json:"profits" yaml:"profits"`
+	// The profits made on each pool since the last distribution to liquidity
+	// providers, per denom.
+	PoolProfitsForDistribution []PoolProfitsForDistribution `protobuf:"bytes,13,rep,name=pool_profits_for_distribution,json=poolProfitsForDistribution,proto3" json:"pool_profits_for_distribution" yaml:"pool_profits_for_distribution"`
+	// The profits that have been distributed to each destination, alongside their
+	// value in uosmo.
+	DistributedProfits []DistributedProfits `protobuf:"bytes,14,rep,name=distributed_profits,json=distributedProfits,proto3" json:"distributed_profits" yaml:"distributed_profits"`
+	// The per epoch statistics of the trades executed with each base denom.
+	EpochDenomStatistics []EpochDenomStatistics `protobuf:"bytes,15,rep,name=epoch_denom_statistics,json=epochDenomStatistics,proto3" json:"epoch_denom_statistics" yaml:"epoch_denom_statistics"`
+	// The per epoch statistics of the trades executed on each route.
+	EpochRouteStatistics []EpochRouteStatistics `protobuf:"bytes,16,rep,name=epoch_route_statistics,json=epochRouteStatistics,proto3" json:"epoch_route_statistics" yaml:"epoch_route_statistics"`
+	// The profits held in the module account that are yet to be distributed.
+	ProfitsForDistribution []types.Coin `protobuf:"bytes,17,rep,name=profits_for_distribution,json=profitsForDistribution,proto3" json:"profits_for_distribution" yaml:"profits_for_distribution"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -174,6 +186,41 @@ func (m *GenesisState) GetProfits() []types.Coin {
 	return nil
 }
 
+func (m *GenesisState) GetPoolProfitsForDistribution() []PoolProfitsForDistribution {
+	if m != nil {
+		return m.PoolProfitsForDistribution
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributedProfits() []DistributedProfits {
+	if m != nil {
+		return m.DistributedProfits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochDenomStatistics() []EpochDenomStatistics {
+	if m != nil {
+		return m.EpochDenomStatistics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRouteStatistics() []EpochRouteStatistics {
+	if m != nil {
+		return m.EpochRouteStatistics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProfitsForDistribution() []types.Coin {
+	if m != nil {
+		return m.ProfitsForDistribution
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -183,50 +230,61 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xdb, 0xde, 0xfe, 0x99, 0xf4, 0x56, 0xb7, 0xd3, 0x9b, 0xca, 0xc9, 0xa5, 0x8e,
-	0x31, 0x2d, 0x64, 0x41, 0x6d, 0xb5, 0x20, 0x16, 0x2c, 0x90, 0xea, 0xa2, 0x82, 0x84, 0xa8, 0xa2,
-	0x69, 0x11, 0x12, 0x48, 0x0c, 0xe3, 0x64, 0x9a, 0x5a, 0xb5, 0x3d, 0x96, 0x67, 0x12, 0xd2, 0x07,
-	0x60, 0xcf, 0xc3, 0xf0, 0x10, 0x5d, 0x56, 0xac, 0x58, 0x45, 0xa8, 0x5d, 0xb0, 0xcf, 0x13, 0x20,
-	0xcf, 0x4c, 0x92, 0x52, 0x62, 0xd8, 0x65, 0xce, 0xf9, 0x9d, 0xef, 0x3b, 0x67, 0xe6, 0x38, 0xe0,
-	0x2e, 0xe3, 0x31, 0xe3, 0x21, 0xf7, 0xd2, 0x8c, 0x09, 0x96, 0xd1, 0x9e, 0xd7, 0xdb, 0x0e, 0xa8,
-	0x20, 0xdb, 0x5e, 0x87, 0x26, 0x94, 0x87, 0xdc, 0x95, 0x09, 0x68, 0x6a, 0xce, 0x1d, 0x71, 0xae,
-	0xe6, 0x6a, 0xff, 0x75, 0x58, 0x87, 0xc9, 0xa8, 0x97, 0xff, 0x52, 0x40, 0xed, 0x5e, 0xa1, 0xee,
-	0x58, 0x40, 0x81, 0x9b, 0xc5, 0x20, 0xc9, 0x48, 0xac, 0x0d, 0x6b, 0xd5, 0x96, 0xe4, 0xb0, 0x32,
-	0x52, 0x07, 0x9d, 0xb2, 0xd4, 0xc9, 0x0b, 0x08, 0xa7, 0xe3, 0xe2, 0x16, 0x0b, 0x13, 0x95, 0x77,
-	0xbe, 0x2f, 0x80, 0xa5, 0x67, 0x6a, 0x98, 0x43, 0x41, 0x04, 0x85, 0x4f, 0xc0, 0x9c, 0xd2, 0x36,
-	0x0d, 0xdb, 0x68, 0x94, 0x77, 0x6c, 0xb7, 0x68, 0x38, 0xb7, 0x29, 0x39, 0x7f, 0xf6, 0x7c, 0x50,
-	0x2f, 0x21, 0x5d, 0x05, 0x3f, 0x1a, 0xa0, 0x22, 0xd8, 0x29, 0x4d, 0x70, 0x4a, 0xc2, 0x0c, 0x93,
-	0x2c, 0xc0, 0x19, 0xeb, 0x0a, 0xca, 0xcd, 0xbf, 0xec, 0x99, 0x46, 0x79, 0xe7, 0x7e, 0xb1, 0xde,
-	0x51, 0x5e, 0xd6, 0x24, 0x61, 0xb6, 0x9b, 0x05, 0x48, 0xd6, 0xf8, 0x1b, 0xb9, 0xf6, 0x70, 0x50,
-	0xbf, 0x75, 0x46, 0xe2, 0xe8, 0xb1, 0x33, 0x55, 0xd8, 0x41, 0x50, 0xfc, 0x52, 0x09, 0xdf, 0x83,
-	0x72, 0x3e, 0x33, 0x6e, 0xd3, 0x84, 0xc5, 0xdc, 0x9c, 0x91, 0xe6, 0x77, 0x8a, 0xcd, 0x7d, 0xc2,
-	0xe9, 0xd3, 0x9c, 0xf5, 0x6b, 0xda, 0x13, 0x2a, 0xcf, 0x6b, 0x2a, 0x0e, 0x02, 0xc1, 0x08, 0xe3,
-	0x90, 0x82, 0xa5, 0x94, 0xb1, 0x08, 0x7f, 0xa0, 0x61, 0xe7, 0x44, 0x70, 0x73, 0x56, 0xde, 0xd7,
-	0xe6, 0x6f, 0xee, 0x8b, 0xb1, 0xe8, 0xb5, 0x82, 0xfd, 0xff, 0xb5, 0xc9, 0xaa, 0x32, 0xb9, 0x2e,
-	0xe4, 0xa0, 0x72, 0x3a, 0x21, 0x21, 0x06, 0xd5, 0x36, 0x39, 0xe3, 0x98, 0x87, 0x49, 0x8b, 0xe2,
-	0x98, 0xb5, 0xbb, 0x11, 0xc5, 0x7a, 0xff, 0xcc, 0xbf, 0x6d, 0xa3, 0x31, 0xeb, 0x6f, 0x0c, 0x07,
-	0x75, 0x5b, 0x09, 0x15, 0xa2, 0x0e, 0x5a, 0xcb, 0x73, 0x87, 0x79, 0xea, 0xa5, 0xcc, 0xe8, 0x67,
-	0x87, 0x18, 0x2c, 0xb7, 0x69, 0x8f, 0x46, 0x2c, 0xa5, 0x19, 0x3e, 0xa6, 0x94, 0x9b, 0x73, 0xf2,
-	0xb2, 0xaa, 0xae, 0xde, 0xa4, 0x7c, 0xe6, 0xf1, 0x10, 0x7b, 0x2c, 0x4c, 0xfc, 0x75, 0xdd, 0x7d,
-	0x45, 0x9b, 0xfe, 0x54, 0xee, 0xa0, 0x7f, 0xc6, 0x81, 0x7d, 0x4a, 0x39, 0x3c, 0x00, 0xab, 0x11,
-	0x11, 0x94, 0x0b, 0x1c, 0x44, 0xac, 0x75, 0x8a, 0x4f, 0xe4, 0x64, 0xe6, 0xbc, 0xec, 0xdd, 0x1a,
-	0x0e, 0xea, 0x35, 0x25, 0x33, 0x05, 0x72, 0xd0, 0x8a, 0x8a, 0xfa, 0x79, 0xf0, 0xb9, 0x8c, 0xc1,
-	0xb7, 0x60, 0x65, 0xe2, 0x48, 0xda, 0xed, 0x8c, 0x72, 0x6e, 0x2e, 0xd8, 0x46, 0x63, 0xd1, 0x77,
-	0x87, 0x83, 0xba, 0x79, 0xb3, 0x29, 0x8d, 0x38, 0x5f, 0x3e, 0x6f, 0x2d, 0xeb, 0x91, 0x76, 0x55,
-	0x08, 0xfd, 0x3b, 0xa6, 0x74, 0x04, 0xbe, 0x03, 0xd5, 0x98, 0xf4, 0xb1, 0x7c, 0x90, 0x94, 0x85,
-	0x89, 0xe0, 0x38, 0xd7, 0x90, 0x4d, 0x99, 0x8b, 0x37, 0xaf, 0xbb, 0x10, 0x75, 0x50, 0x25, 0x26,
-	0xfd, 0xfc, 0xc5, 0x9b, 0x32, 0xd3, 0xa4, 0x99, 0x1c, 0x01, 0xbe, 0x02, 0x6b, 0xd3, 0x8a, 0x44,
-	0xdf, 0x04, 0x52, 0xfc, 0xf6, 0x70, 0x50, 0x5f, 0x2f, 0x16, 0x17, 0x7d, 0x07, 0xc1, 0x9b, 0xca,
-	0x47, 0x7d, 0x78, 0x08, 0x2a, 0x92, 0xc2, 0x2d, 0xd6, 0x4d, 0x04, 0x3e, 0x66, 0xa3, 0x96, 0xcb,
-	0x52, 0xd5, 0x9e, 0x7c, 0x43, 0x53, 0x31, 0x07, 0x41, 0x19, 0xdf, 0xcb, 0xc3, 0xfb, 0x4c, 0xf7,
-	0xfa, 0x02, 0xcc, 0xa7, 0x19, 0x3b, 0x0e, 0x05, 0x37, 0x97, 0xfe, 0xb4, 0x12, 0x6b, 0x7a, 0x25,
-	0x96, 0xb5, 0x8b, 0xaa, 0x73, 0xd0, 0x48, 0xc1, 0x3f, 0x38, 0xbf, 0xb4, 0x8c, 0x8b, 0x4b, 0xcb,
-	0xf8, 0x76, 0x69, 0x19, 0x9f, 0xae, 0xac, 0xd2, 0xc5, 0x95, 0x55, 0xfa, 0x7a, 0x65, 0x95, 0xde,
-	0x3c, 0xec, 0x84, 0xe2, 0xa4, 0x1b, 0xb8, 0x2d, 0x16, 0x7b, 0xfa, 0xe3, 0xd9, 0x8a, 0x48, 0xc0,
-	0x47, 0x07, 0xaf, 0xb7, 0xfd, 0xc8, 0xeb, 0x4f, 0xfe, 0x03, 0xc5, 0x59, 0x4a, 0x79, 0x30, 0x27,
-	0xcf, 0x0f, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff, 0x65, 0x8c, 0x0d, 0x6e, 0xa5, 0x05, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0xcf, 0xd2, 0x90, 0xd2, 0x71, 0x6a, 0x9a, 0x49, 0x63, 0x4d, 0x0c, 0xb1, 0xcd, 0x90, 0xd0,
+	0x1c, 0xda, 0xb5, 0x52, 0x2a, 0x0e, 0x1c, 0x90, 0xba, 0x0d, 0x01, 0x09, 0x51, 0x59, 0x93, 0x22,
+	0x24, 0x90, 0x18, 0x66, 0xbd, 0x13, 0x67, 0xd4, 0xdd, 0x9d, 0xd5, 0xce, 0x38, 0x38, 0x12, 0x17,
+	0x0e, 0x9c, 0xb8, 0xf0, 0x12, 0x1c, 0xb9, 0xf1, 0x10, 0x3d, 0x56, 0x9c, 0x38, 0x59, 0x28, 0x79,
+	0x03, 0x3f, 0x01, 0xda, 0x99, 0xf1, 0x9f, 0xba, 0xbb, 0x35, 0x37, 0xef, 0xf7, 0xfd, 0xfe, 0x7d,
+	0xb3, 0xdf, 0x8e, 0xc1, 0x47, 0x52, 0x25, 0x52, 0x09, 0xd5, 0xcd, 0x72, 0xa9, 0x65, 0xce, 0x2f,
+	0xba, 0x17, 0x47, 0x21, 0xd7, 0xec, 0xa8, 0x3b, 0xe0, 0x29, 0x57, 0x42, 0xf9, 0xa6, 0x01, 0x91,
+	0xc3, 0xf9, 0x53, 0x9c, 0xef, 0x70, 0xcd, 0xbb, 0x03, 0x39, 0x90, 0xa6, 0xda, 0x2d, 0x7e, 0x59,
+	0x40, 0xf3, 0x5e, 0xa5, 0xee, 0x4c, 0xc0, 0x02, 0x0f, 0xaa, 0x81, 0x2c, 0x67, 0x89, 0x33, 0x6c,
+	0xee, 0xf6, 0x0d, 0x8e, 0x5a, 0x23, 0xfb, 0xe0, 0x5a, 0x2d, 0xfb, 0xd4, 0x0d, 0x99, 0xe2, 0x33,
+	0x72, 0x5f, 0x8a, 0xd4, 0xf6, 0xf1, 0x9f, 0x75, 0xb0, 0xf9, 0x85, 0x1d, 0xe6, 0x54, 0x33, 0xcd,
+	0xe1, 0x67, 0x60, 0xc3, 0x6a, 0x23, 0xaf, 0xe3, 0x1d, 0xd6, 0x1e, 0x76, 0xfc, 0xaa, 0xe1, 0xfc,
+	0x9e, 0xc1, 0x05, 0xeb, 0x2f, 0xc6, 0xed, 0x35, 0xe2, 0x58, 0xf0, 0x57, 0x0f, 0xec, 0x68, 0xf9,
+	0x9c, 0xa7, 0x34, 0x63, 0x22, 0xa7, 0x2c, 0x0f, 0x69, 0x2e, 0x87, 0x9a, 0x2b, 0xf4, 0x56, 0xe7,
+	0xc6, 0x61, 0xed, 0xe1, 0xfd, 0x6a, 0xbd, 0x67, 0x05, 0xad, 0xc7, 0x44, 0xfe, 0x38, 0x0f, 0x89,
+	0xe1, 0x04, 0xfb, 0x85, 0xf6, 0x64, 0xdc, 0x7e, 0xff, 0x92, 0x25, 0xf1, 0xa7, 0xb8, 0x54, 0x18,
+	0x13, 0xa8, 0x5f, 0x63, 0xc2, 0x1f, 0x41, 0xad, 0x98, 0x99, 0x46, 0x3c, 0x95, 0x89, 0x42, 0x37,
+	0x8c, 0xf9, 0x87, 0xd5, 0xe6, 0x01, 0x53, 0xfc, 0xb8, 0xc0, 0x06, 0x4d, 0xe7, 0x09, 0xad, 0xe7,
+	0x82, 0x0a, 0x26, 0x20, 0x9c, 0xc2, 0x14, 0xe4, 0x60, 0x33, 0x93, 0x32, 0xa6, 0x3f, 0x71, 0x31,
+	0x38, 0xd7, 0x0a, 0xad, 0x9b, 0xf3, 0x3a, 0x78, 0xc3, 0x79, 0x49, 0x19, 0x7f, 0x6b, 0xc1, 0xc1,
+	0x7b, 0xce, 0x64, 0xdb, 0x9a, 0x2c, 0x0a, 0x61, 0x52, 0xcb, 0xe6, 0x48, 0x48, 0xc1, 0x6e, 0xc4,
+	0x2e, 0x15, 0x55, 0x22, 0xed, 0x73, 0x9a, 0xc8, 0x68, 0x18, 0x73, 0xea, 0xf6, 0x0f, 0xbd, 0xdd,
+	0xf1, 0x0e, 0xd7, 0x83, 0xfd, 0xc9, 0xb8, 0xdd, 0xb1, 0x42, 0x95, 0x50, 0x4c, 0x1a, 0x45, 0xef,
+	0xb4, 0x68, 0x7d, 0x6d, 0x3a, 0xee, 0xb5, 0x43, 0x0a, 0xea, 0x11, 0xbf, 0xe0, 0xb1, 0xcc, 0x78,
+	0x4e, 0xcf, 0x38, 0x57, 0x68, 0xc3, 0x1c, 0xd6, 0xae, 0xef, 0x36, 0xa9, 0x98, 0x79, 0x36, 0xc4,
+	0x13, 0x29, 0xd2, 0x60, 0xcf, 0xa5, 0xdf, 0x71, 0xa6, 0xaf, 0xd0, 0x31, 0xb9, 0x3d, 0x2b, 0x9c,
+	0x70, 0xae, 0xe0, 0x53, 0xb0, 0x1d, 0x33, 0xcd, 0x95, 0xa6, 0x61, 0x2c, 0xfb, 0xcf, 0xe9, 0xb9,
+	0x99, 0x0c, 0xdd, 0x34, 0xd9, 0x5b, 0x93, 0x71, 0xbb, 0x69, 0x65, 0x4a, 0x40, 0x98, 0x6c, 0xd9,
+	0x6a, 0x50, 0x14, 0xbf, 0x34, 0x35, 0xf8, 0x3d, 0xd8, 0x9a, 0x3b, 0xb2, 0x28, 0xca, 0xb9, 0x52,
+	0xe8, 0x9d, 0x8e, 0x77, 0x78, 0x2b, 0xf0, 0x27, 0xe3, 0x36, 0x5a, 0x0e, 0xe5, 0x20, 0xf8, 0xef,
+	0xbf, 0x1e, 0xd4, 0xdd, 0x48, 0x8f, 0x6d, 0x89, 0xdc, 0x99, 0xa1, 0x5c, 0x05, 0xfe, 0x00, 0x76,
+	0x13, 0x36, 0xa2, 0xe6, 0x85, 0x64, 0x52, 0xa4, 0x5a, 0xd1, 0x42, 0xc3, 0x84, 0x42, 0xb7, 0x96,
+	0x8f, 0xbb, 0x12, 0x8a, 0xc9, 0x4e, 0xc2, 0x46, 0xc5, 0x1b, 0xef, 0x99, 0x4e, 0x8f, 0xe7, 0x66,
+	0x04, 0xf8, 0x0d, 0x68, 0x94, 0x91, 0xf4, 0x08, 0x01, 0x23, 0xfe, 0xc1, 0x64, 0xdc, 0xde, 0xab,
+	0x16, 0xd7, 0x23, 0x4c, 0xe0, 0xb2, 0xf2, 0xb3, 0x11, 0x3c, 0x05, 0x3b, 0x06, 0x45, 0xfb, 0x72,
+	0x98, 0x6a, 0x7a, 0x26, 0xa7, 0x91, 0x6b, 0x46, 0xb5, 0x33, 0xff, 0x86, 0x4a, 0x61, 0x98, 0x40,
+	0x53, 0x7f, 0x52, 0x94, 0x4f, 0xa4, 0xcb, 0xfa, 0x15, 0xb8, 0x99, 0xe5, 0xf2, 0x4c, 0x68, 0x85,
+	0x36, 0x57, 0xad, 0x44, 0xc3, 0xad, 0x44, 0xdd, 0xb9, 0x58, 0x1e, 0x26, 0x53, 0x05, 0xf8, 0x87,
+	0x07, 0xf6, 0xec, 0x34, 0xb6, 0x60, 0xcc, 0x23, 0xa1, 0x74, 0x2e, 0xc2, 0xa1, 0x16, 0x32, 0x45,
+	0xb7, 0x8d, 0xc7, 0xa3, 0x37, 0x7f, 0x40, 0x3d, 0xcb, 0x3e, 0x91, 0xf9, 0xf1, 0x02, 0x37, 0xb8,
+	0xef, 0xec, 0xf7, 0x17, 0xbe, 0xa7, 0x2a, 0x23, 0x4c, 0x9a, 0x59, 0xa5, 0x12, 0xfc, 0xc5, 0x03,
+	0xdb, 0x33, 0x34, 0x8f, 0xa6, 0x2a, 0xa8, 0xbe, 0xea, 0xfa, 0x3a, 0x9e, 0x93, 0x9c, 0x74, 0x80,
+	0x5d, 0x2a, 0xb7, 0xe0, 0x25, 0xb2, 0x98, 0xc0, 0xe8, 0x35, 0x1e, 0xfc, 0xcd, 0x03, 0x0d, 0x9e,
+	0xc9, 0xfe, 0xb9, 0xbd, 0x78, 0xa8, 0xd2, 0x4c, 0x0b, 0xa5, 0x45, 0x5f, 0xa1, 0x77, 0x4d, 0x0c,
+	0xbf, 0x3a, 0xc6, 0xe7, 0x05, 0xcf, 0x5c, 0x51, 0xa7, 0x33, 0x56, 0x70, 0xe0, 0x82, 0xb8, 0xcd,
+	0x2a, 0xd7, 0xc6, 0xe4, 0x2e, 0x2f, 0x21, 0x2f, 0xa4, 0x31, 0x17, 0xee, 0x62, 0x9a, 0x3b, 0xff,
+	0x2b, 0x8d, 0xb9, 0x92, 0x57, 0xa5, 0x59, 0xd6, 0x9e, 0xa6, 0x59, 0x22, 0xc3, 0x9f, 0x01, 0xaa,
+	0xdc, 0xa0, 0xad, 0x55, 0x5b, 0x7a, 0xcf, 0x39, 0xb7, 0x5f, 0xd9, 0xd2, 0x92, 0x0d, 0x69, 0x64,
+	0xe5, 0x7b, 0xf6, 0xf4, 0xc5, 0x55, 0xcb, 0x7b, 0x79, 0xd5, 0xf2, 0xfe, 0xbd, 0x6a, 0x79, 0xbf,
+	0x5f, 0xb7, 0xd6, 0x5e, 0x5e, 0xb7, 0xd6, 0xfe, 0xb9, 0x6e, 0xad, 0x7d, 0xf7, 0x68, 0x20, 0xf4,
+	0xf9, 0x30, 0xf4, 0xfb, 0x32, 0xe9, 0xba, 0xe3, 0x78, 0x10, 0xb3, 0x50, 0x4d, 0x1f, 0xba, 0x17,
+	0x47, 0x9f, 0x74, 0x47, 0xf3, 0x7f, 0x72, 0x7d, 0x99, 0x71, 0x15, 0x6e, 0x98, 0xe7, 0x8f, 0xff,
+	0x1b, 0x00, 0x65, 0x6c, 0xbb, 0xf4, 0x6b, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -249,6 +307,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProfitsForDistribution) > 0 {
+		for iNdEx := len(m.ProfitsForDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitsForDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EpochRouteStatistics) > 0 {
+		for iNdEx := len(m.EpochRouteStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRouteStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.EpochDenomStatistics) > 0 {
+		for iNdEx := len(m.EpochDenomStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochDenomStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DistributedProfits) > 0 {
+		for iNdEx := len(m.DistributedProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PoolProfitsForDistribution) > 0 {
+		for iNdEx := len(m.PoolProfitsForDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolProfitsForDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Profits) > 0 {
 		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -424,6 +556,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolProfitsForDistribution) > 0 {
+		for _, e := range m.PoolProfitsForDistribution {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributedProfits) > 0 {
+		for _, e := range m.DistributedProfits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochDenomStatistics) > 0 {
+		for _, e := range m.EpochDenomStatistics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochRouteStatistics) > 0 {
+		for _, e := range m.EpochRouteStatistics {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProfitsForDistribution) > 0 {
+		for _, e := range m.ProfitsForDistribution {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -791,6 +953,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolProfitsForDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolProfitsForDistribution = append(m.PoolProfitsForDistribution, PoolProfitsForDistribution{})
+			if err := m.PoolProfitsForDistribution[len(m.PoolProfitsForDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedProfits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedProfits = append(m.DistributedProfits, DistributedProfits{})
+			if err := m.DistributedProfits[len(m.DistributedProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDenomStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochDenomStatistics = append(m.EpochDenomStatistics, EpochDenomStatistics{})
+			if err := m.EpochDenomStatistics[len(m.EpochDenomStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRouteStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRouteStatistics = append(m.EpochRouteStatistics, EpochRouteStatistics{})
+			if err := m.EpochRouteStatistics[len(m.EpochRouteStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitsForDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitsForDistribution = append(m.ProfitsForDistribution, types.Coin{})
+			if err := m.ProfitsForDistribution[len(m.ProfitsForDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
@@ -19,6 +20,57 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Valid profits pending distribution, distributed profits and epoch statistics",
+			genState: withGenesisStatistics(
+				[]types.PoolProfitsForDistribution{{PoolId: 1, Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))}},
+				[]types.DistributedProfits{{Destination: types.DistributionDestinationBurn, Amounts: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))), OsmoValue: sdk.NewInt(100)}},
+				[]types.EpochDenomStatistics{{Epoch: 1, Denom: types.OsmosisDenomination, Profit: sdk.NewInt(100), NumberOfTrades: sdk.OneInt(), PointsUsed: 6}},
+				[]types.EpochRouteStatistics{{Epoch: 1, Route: []uint64{1, 2}, Profits: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))), NumberOfTrades: sdk.OneInt(), PointsUsed: 6}},
+			),
+			valid: true,
+		},
+		{
+			description: "Duplicate pool profits pending distribution",
+			genState: withGenesisStatistics(
+				[]types.PoolProfitsForDistribution{
+					{PoolId: 1, Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(100))},
+					{PoolId: 1, Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(50))},
+				}, nil, nil, nil,
+			),
+			valid: false,
+		},
+		{
+			description: "Invalid distribution destination",
+			genState: withGenesisStatistics(
+				nil, []types.DistributedProfits{{Destination: "developer", Amounts: sdk.NewCoins(), OsmoValue: sdk.ZeroInt()}}, nil, nil,
+			),
+			valid: false,
+		},
+		{
+			description: "Negative distributed profits osmo value",
+			genState: withGenesisStatistics(
+				nil, []types.DistributedProfits{{Destination: types.DistributionDestinationStakers, Amounts: sdk.NewCoins(), OsmoValue: sdk.NewInt(-1)}}, nil, nil,
+			),
+			valid: false,
+		},
+		{
+			description: "Duplicate epoch denom statistics",
+			genState: withGenesisStatistics(
+				nil, nil, []types.EpochDenomStatistics{
+					{Epoch: 1, Denom: types.OsmosisDenomination, Profit: sdk.ZeroInt(), NumberOfTrades: sdk.ZeroInt()},
+					{Epoch: 1, Denom: types.OsmosisDenomination, Profit: sdk.ZeroInt(), NumberOfTrades: sdk.ZeroInt()},
+				}, nil,
+			),
+			valid: false,
+		},
+		{
+			description: "Epoch route statistics with an empty route",
+			genState: withGenesisStatistics(
+				nil, nil, nil, []types.EpochRouteStatistics{{Epoch: 1, Profits: sdk.NewCoins(), NumberOfTrades: sdk.ZeroInt()}},
+			),
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -33,3 +85,14 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+// withGenesisStatistics returns the default genesis state with the given profits pending distribution, distributed profits
+// and epoch statistics.
+func withGenesisStatistics(poolProfits []types.PoolProfitsForDistribution, distributedProfits []types.DistributedProfits, epochDenomStatistics []types.EpochDenomStatistics, epochRouteStatistics []types.EpochRouteStatistics) *types.GenesisState {
+	genState := types.DefaultGenesis()
+	genState.PoolProfitsForDistribution = poolProfits
+	genState.DistributedProfits = distributedProfits
+	genState.EpochDenomStatistics = epochDenomStatistics
+	genState.EpochRouteStatistics = epochRouteStatistics
+	return genState
+}
@@ -36,6 +36,13 @@ const (
 	prefixLatestBlockHeight
 	prefixPoolWeights
 	prefixSwapsToBackrun
+	prefixPoolGraph
+	prefixPoolProfitsForDistribution
+	prefixDistributedProfits
+	prefixDistributedProfitsInOsmo
+	prefixEpochStatisticsByDenom
+	prefixEpochStatisticsByRoute
+	prefixProfitsForDistribution
 )
 
 var (
@@ -49,6 +56,9 @@ var (
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
 
+	// KeyPrefixPoolGraph is the prefix that is used to store the pool id connecting a denom to each of its neighbours in the pool graph
+	KeyPrefixPoolGraph = []byte{prefixPoolGraph}
+
 	// -------------- Keys for statistics stores -------------- //
 	// KeyPrefixNumberOfTrades is the prefix for the store that keeps track of the number of trades executed
 	KeyPrefixNumberOfTrades = []byte{prefixNumberOfTrades}
@@ -62,6 +72,24 @@ var (
 	// KeyPrefixProfitsByRoute is the prefix for the store that keeps track of the profits made by route
 	KeyPrefixProfitsByRoute = []byte{prefixProfitsByRoute}
 
+	// KeyPrefixPoolProfitsForDistribution is the prefix for the store that keeps track of the profits made on each pool since the last distribution
+	KeyPrefixPoolProfitsForDistribution = []byte{prefixPoolProfitsForDistribution}
+
+	// KeyPrefixDistributedProfits is the prefix for the store that keeps track of the profits distributed by destination and denom
+	KeyPrefixDistributedProfits = []byte{prefixDistributedProfits}
+
+	// KeyPrefixDistributedProfitsInOsmo is the prefix for the store that keeps track of the uosmo value of the profits distributed by destination
+	KeyPrefixDistributedProfitsInOsmo = []byte{prefixDistributedProfitsInOsmo}
+
+	// KeyPrefixEpochStatisticsByDenom is the prefix for the store that keeps track of the statistics of each epoch by base denom
+	KeyPrefixEpochStatisticsByDenom = []byte{prefixEpochStatisticsByDenom}
+
+	// KeyPrefixEpochStatisticsByRoute is the prefix for the store that keeps track of the statistics of each epoch by route
+	KeyPrefixEpochStatisticsByRoute = []byte{prefixEpochStatisticsByRoute}
+
+	// KeyPrefixProfitsForDistribution is the prefix for the store that keeps track of the profits held in the module account that are yet to be distributed
+	KeyPrefixProfitsForDistribution = []byte{prefixProfitsForDistribution}
+
 	// -------------- Keys for configuration/admin stores -------------- //
 	// KeyPrefixDeveloperAccount is the prefix for store that keeps track of the developer account
 	KeyPrefixDeveloperAccount = []byte{prefixDeveloperAccount}
@@ -96,6 +124,11 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key needed to fetch the pool id connecting a denom to a neighbour in the pool graph
+func GetKeyPrefixPoolGraphEdge(denom, neighbourDenom string) []byte {
+	return append(KeyPrefixPoolGraph, []byte(denom+"|"+neighbourDenom)...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func GetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
@@ -121,6 +154,46 @@ func GetKeyPrefixProfitsByRoute(route []uint64, denom string) []byte {
 	return append(append(KeyPrefixProfitsByRoute, CreateRouteKey(route)...), []byte(denom)...)
 }
 
+// Returns the key needed to fetch the profits made on a given pool in a given denom since the last distribution
+func GetKeyPrefixPoolProfitsForDistribution(denom string, poolId uint64) []byte {
+	return append(GetKeyPrefixPoolProfitsForDistributionByDenom(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to iterate over the profits made on all pools in a given denom since the last distribution
+func GetKeyPrefixPoolProfitsForDistributionByDenom(denom string) []byte {
+	return append(KeyPrefixPoolProfitsForDistribution, []byte(denom+"|")...)
+}
+
+// Returns the key needed to fetch the profits in a given denom that are yet to be distributed
+func GetKeyPrefixProfitsForDistribution(denom string) []byte {
+	return append(KeyPrefixProfitsForDistribution, []byte(denom)...)
+}
+
+// Returns the key needed to fetch the profits distributed to a given destination in a given denom
+func GetKeyPrefixDistributedProfits(destination, denom string) []byte {
+	return append(GetKeyPrefixDistributedProfitsByDestination(destination), []byte(denom)...)
+}
+
+// Returns the key needed to iterate over the profits distributed to a given destination
+func GetKeyPrefixDistributedProfitsByDestination(destination string) []byte {
+	return append(KeyPrefixDistributedProfits, []byte(destination+"|")...)
+}
+
+// Returns the key needed to fetch the uosmo value of the profits distributed to a given destination
+func GetKeyPrefixDistributedProfitsInOsmo(destination string) []byte {
+	return append(KeyPrefixDistributedProfitsInOsmo, []byte(destination)...)
+}
+
+// Returns the key needed to fetch the statistics of a given epoch for a given base denom
+func GetKeyPrefixEpochStatisticsByDenom(epoch uint64, denom string) []byte {
+	return append(append(KeyPrefixEpochStatisticsByDenom, sdk.Uint64ToBigEndian(epoch)...), []byte(denom)...)
+}
+
+// Returns the key needed to fetch the statistics of a given epoch for a given route
+func GetKeyPrefixEpochStatisticsByRoute(epoch uint64, route []uint64) []byte {
+	return append(append(KeyPrefixEpochStatisticsByRoute, sdk.Uint64ToBigEndian(epoch)...), CreateRouteKey(route)...)
+}
+
 // createRouteKey creates a key for the given route. converts a slice of uint64 to a string separated by a pipe
 // {1,2,3,4} -> []byte("1|2|3|4")
 func CreateRouteKey(route []uint64) []byte {
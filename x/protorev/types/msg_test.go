@@ -520,6 +520,7 @@ func TestMsgSetPoolWeights(t *testing.T) {
 				BalancerWeight:     1,
 				StableWeight:       1,
 				ConcentratedWeight: 1,
+				CosmwasmWeight:     1,
 			},
 			false,
 		},
@@ -530,6 +531,17 @@ func TestMsgSetPoolWeights(t *testing.T) {
 				BalancerWeight:     0,
 				StableWeight:       1,
 				ConcentratedWeight: 1,
+				CosmwasmWeight:     1,
+			},
+			false,
+		},
+		{
+			"Invalid message (unset cosmwasm pool weight)",
+			createAccount().String(),
+			types.PoolWeights{
+				BalancerWeight:     1,
+				StableWeight:       1,
+				ConcentratedWeight: 1,
 			},
 			false,
 		},
@@ -540,6 +552,7 @@ func TestMsgSetPoolWeights(t *testing.T) {
 				BalancerWeight:     1,
 				StableWeight:       1,
 				ConcentratedWeight: 1,
+				CosmwasmWeight:     1,
 			},
 			true,
 		},
@@ -13,10 +13,24 @@ var (
 	// See https://github.com/osmosis-labs/osmosis/issues/4349 for more details
 	// Note that governance has full ability to change this live on-chain, and this admin can at most prevent protorev from working.
 	// All the settings manager's controls have limits, so it can't lead to a chain halt, excess processing time or prevention of swaps.
-	DefaultAdminAccount = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
+	DefaultAdminAccount      = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
+	DefaultMaxGraphRouteHops = uint64(4)
+	// By default all of the profits are kept in the module account
+	DefaultProfitDistributions []ProfitDistribution
+	// Per epoch statistics are kept for a month by default
+	DefaultEpochStatisticsRetention = uint64(30)
+	// The binary search is used by default to find the optimal amount in of a route
+	DefaultProfitSearchMode = BinarySearch
+	// The golden section search finds the optimal amount in to within a hundredth of the step size by default
+	DefaultGoldenSectionSearchPrecision = sdk.NewDecWithPrec(1, 2)
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	ParamStoreKeyEnableModule             = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount             = []byte("AdminAccount")
+	ParamStoreKeyMaxGraphRouteHops        = []byte("MaxGraphRouteHops")
+	ParamStoreKeyProfitDistributions      = []byte("ProfitDistributions")
+	ParamStoreKeyEpochStatisticsRetention = []byte("EpochStatisticsRetention")
+	ParamStoreKeyProfitSearchMode         = []byte("ProfitSearchMode")
+	ParamStoreKeyGoldenSectionPrecision   = []byte("GoldenSectionSearchPrecision")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -25,16 +39,21 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, maxGraphRouteHops uint64, profitDistributions []ProfitDistribution, epochStatisticsRetention uint64, profitSearchMode ProfitSearchMode, goldenSectionSearchPrecision sdk.Dec) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:                      enable,
+		Admin:                        admin,
+		MaxGraphRouteHops:            maxGraphRouteHops,
+		ProfitDistributions:          profitDistributions,
+		EpochStatisticsRetention:     epochStatisticsRetention,
+		ProfitSearchMode:             profitSearchMode,
+		GoldenSectionSearchPrecision: goldenSectionSearchPrecision,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultMaxGraphRouteHops, DefaultProfitDistributions, DefaultEpochStatisticsRetention, DefaultProfitSearchMode, DefaultGoldenSectionSearchPrecision)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -42,6 +61,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGraphRouteHops, &p.MaxGraphRouteHops, ValidateMaxGraphRouteHops),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitDistributions, &p.ProfitDistributions, ValidateProfitDistributions),
+		paramtypes.NewParamSetPair(ParamStoreKeyEpochStatisticsRetention, &p.EpochStatisticsRetention, ValidateEpochStatisticsRetention),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitSearchMode, &p.ProfitSearchMode, ValidateProfitSearchMode),
+		paramtypes.NewParamSetPair(ParamStoreKeyGoldenSectionPrecision, &p.GoldenSectionSearchPrecision, ValidateGoldenSectionSearchPrecision),
 	}
 }
 
@@ -51,6 +75,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
+	if err := ValidateMaxGraphRouteHops(p.MaxGraphRouteHops); err != nil {
+		return err
+	}
+
+	if err := ValidateProfitDistributions(p.ProfitDistributions); err != nil {
+		return err
+	}
+
+	if err := ValidateEpochStatisticsRetention(p.EpochStatisticsRetention); err != nil {
+		return err
+	}
+
+	if err := ValidateProfitSearchMode(p.ProfitSearchMode); err != nil {
+		return err
+	}
+
+	if err := ValidateGoldenSectionSearchPrecision(p.GoldenSectionSearchPrecision); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,3 +118,98 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+func ValidateMaxGraphRouteHops(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxGraphRouteHopsLimit {
+		return fmt.Errorf("max graph route hops must be at most %d, got %d", MaxGraphRouteHopsLimit, v)
+	}
+
+	return nil
+}
+
+func ValidateEpochStatisticsRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxEpochStatisticsRetention {
+		return fmt.Errorf("epoch statistics retention must be at most %d, got %d", MaxEpochStatisticsRetention, v)
+	}
+
+	return nil
+}
+
+func ValidateProfitSearchMode(i interface{}) error {
+	v, ok := i.(ProfitSearchMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ProfitSearchMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid profit search mode: %d", v)
+	}
+
+	return nil
+}
+
+func ValidateGoldenSectionSearchPrecision(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(MinGoldenSectionSearchPrecision) || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("golden section search precision must be between %s and 1, got %s", MinGoldenSectionSearchPrecision, v)
+	}
+
+	return nil
+}
+
+func ValidateProfitDistributions(i interface{}) error {
+	v, ok := i.([]ProfitDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, distribution := range v {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[distribution.Denom] {
+			return fmt.Errorf("duplicate profit distribution for denom %s", distribution.Denom)
+		}
+		seenDenoms[distribution.Denom] = true
+	}
+
+	return nil
+}
+
+// Validate validates a profit distribution. The denom must be valid and the fractions must be
+// non-negative and add up to at most one.
+func (d ProfitDistribution) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid profit distribution denom: %w", err)
+	}
+
+	total := sdk.ZeroDec()
+	for _, fraction := range []sdk.Dec{d.Burn, d.CommunityPool, d.Stakers, d.LiquidityProviders} {
+		if fraction.IsNil() || fraction.IsNegative() {
+			return fmt.Errorf("profit distribution fractions for denom %s must be non-negative", d.Denom)
+		}
+		total = total.Add(fraction)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("profit distribution fractions for denom %s must add up to at most one, got %s", d.Denom, total)
+	}
+
+	return nil
+}
@@ -5,9 +5,10 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,12 +25,60 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProfitSearchMode is an enumeration of the searches that can be used to find
+// the optimal amount in of a route.
+type ProfitSearchMode int32
+
+const (
+	// BinarySearch searches over multiples of the step size of the base denom,
+	// comparing the profits of neighbouring multiples.
+	BinarySearch ProfitSearchMode = 0
+	// GoldenSectionSearch maximises the profit, which is concave in the amount
+	// in, by narrowing down the range of amounts in until it is smaller than the
+	// configured precision.
+	GoldenSectionSearch ProfitSearchMode = 1
+)
+
+var ProfitSearchMode_name = map[int32]string{
+	0: "BinarySearch",
+	1: "GoldenSectionSearch",
+}
+
+var ProfitSearchMode_value = map[string]int32{
+	"BinarySearch":        0,
+	"GoldenSectionSearch": 1,
+}
+
+func (x ProfitSearchMode) String() string {
+	return proto.EnumName(ProfitSearchMode_name, int32(x))
+}
+
+func (ProfitSearchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_72168e5a5a65ae7e, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// Boolean whether the protorev module is enabled.
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The max number of hops of the cyclic arbitrage routes built by searching
+	// the pool graph. Zero disables the search.
+	MaxGraphRouteHops uint64 `protobuf:"varint,3,opt,name=max_graph_route_hops,json=maxGraphRouteHops,proto3" json:"max_graph_route_hops,omitempty" yaml:"max_graph_route_hops"`
+	// The distribution of the profits accumulated in the module account, per
+	// base denom. Profits in denoms without a distribution are kept in the
+	// module account.
+	ProfitDistributions []ProfitDistribution `protobuf:"bytes,4,rep,name=profit_distributions,json=profitDistributions,proto3" json:"profit_distributions" yaml:"profit_distributions"`
+	// The number of day epochs for which the per epoch statistics are kept. Zero
+	// disables the per epoch statistics.
+	EpochStatisticsRetention uint64 `protobuf:"varint,5,opt,name=epoch_statistics_retention,json=epochStatisticsRetention,proto3" json:"epoch_statistics_retention,omitempty" yaml:"epoch_statistics_retention"`
+	// The search used to find the optimal amount in of a route.
+	ProfitSearchMode ProfitSearchMode `protobuf:"varint,6,opt,name=profit_search_mode,json=profitSearchMode,proto3,enum=osmosis.protorev.v1beta1.ProfitSearchMode" json:"profit_search_mode,omitempty" yaml:"profit_search_mode"`
+	// The precision of the golden section search, as a fraction of the step size
+	// of the base denom. The search stops once the optimal amount in is known to
+	// within this precision.
+	GoldenSectionSearchPrecision github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=golden_section_search_precision,json=goldenSectionSearchPrecision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"golden_section_search_precision" yaml:"golden_section_search_precision"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +128,97 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMaxGraphRouteHops() uint64 {
+	if m != nil {
+		return m.MaxGraphRouteHops
+	}
+	return 0
+}
+
+func (m *Params) GetProfitDistributions() []ProfitDistribution {
+	if m != nil {
+		return m.ProfitDistributions
+	}
+	return nil
+}
+
+func (m *Params) GetEpochStatisticsRetention() uint64 {
+	if m != nil {
+		return m.EpochStatisticsRetention
+	}
+	return 0
+}
+
+func (m *Params) GetProfitSearchMode() ProfitSearchMode {
+	if m != nil {
+		return m.ProfitSearchMode
+	}
+	return BinarySearch
+}
+
+// ProfitDistribution defines the fractions of the profits accumulated in a
+// given denom that are burned, sent to the community pool, sent to stakers
+// (the fee collector) and sent to the liquidity providers of the pools that
+// were arbitraged. The fractions must add up to at most one, the rest is kept
+// in the module account.
+type ProfitDistribution struct {
+	// The denom of the profits to distribute.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// The fraction of the profits that is burned.
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn" yaml:"burn"`
+	// The fraction of the profits that is sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// The fraction of the profits that is sent to stakers via the fee collector.
+	Stakers github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=stakers,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stakers" yaml:"stakers"`
+	// The fraction of the profits that is sent to the liquidity providers of the
+	// arbitraged pools, in proportion to the profits made on each pool.
+	LiquidityProviders github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity_providers,json=liquidityProviders,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_providers" yaml:"liquidity_providers"`
+}
+
+func (m *ProfitDistribution) Reset()         { *m = ProfitDistribution{} }
+func (m *ProfitDistribution) String() string { return proto.CompactTextString(m) }
+func (*ProfitDistribution) ProtoMessage()    {}
+func (*ProfitDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72168e5a5a65ae7e, []int{1}
+}
+func (m *ProfitDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitDistribution.Merge(m, src)
+}
+func (m *ProfitDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitDistribution proto.InternalMessageInfo
+
+func (m *ProfitDistribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.protorev.v1beta1.ProfitSearchMode", ProfitSearchMode_name, ProfitSearchMode_value)
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
+	proto.RegisterType((*ProfitDistribution)(nil), "osmosis.protorev.v1beta1.ProfitDistribution")
 }
 
 func init() {
@@ -88,22 +226,50 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x0c, 0xcd, 0xf4, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x8d, 0x7f, 0x28, 0x2a, 0x01, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xbf, 0x26, 0xed, 0xd7, 0x69, 0xa9, 0xc2, 0xb4, 0x08, 0x37, 0x40, 0x1c, 0x06, 0xb5,
+	0x8a, 0xaa, 0x36, 0x51, 0x0b, 0x62, 0x81, 0x84, 0x84, 0x4c, 0xa5, 0xb2, 0x00, 0x14, 0xa6, 0x1b,
+	0xd4, 0x8d, 0x35, 0xb1, 0x87, 0x64, 0xd4, 0xd8, 0x63, 0x66, 0x26, 0xa1, 0x59, 0xb0, 0x63, 0xc1,
+	0x92, 0x37, 0x60, 0xc1, 0x43, 0xf0, 0x0a, 0x5d, 0x76, 0x89, 0xba, 0xb0, 0x50, 0xfb, 0x06, 0x79,
+	0x02, 0xe4, 0x19, 0x3b, 0xfd, 0x57, 0x95, 0x55, 0x32, 0xe7, 0x9c, 0x7b, 0xee, 0xbd, 0xe3, 0x63,
+	0x83, 0x15, 0x2e, 0x43, 0x2e, 0x99, 0x6c, 0xc6, 0x82, 0x2b, 0x2e, 0xe8, 0xa0, 0x39, 0xd8, 0x6c,
+	0x53, 0x45, 0x36, 0x9b, 0x31, 0x11, 0x24, 0x94, 0x0d, 0x8d, 0x43, 0x3b, 0x93, 0x35, 0x72, 0x59,
+	0x23, 0x93, 0x55, 0x96, 0x3a, 0xbc, 0xc3, 0x35, 0xda, 0x4c, 0xff, 0x19, 0x41, 0x65, 0xd9, 0xd7,
+	0x05, 0x9e, 0x21, 0xcc, 0xc1, 0x50, 0xe8, 0x77, 0x09, 0x4c, 0xb7, 0xb4, 0x37, 0x5c, 0x07, 0x33,
+	0x34, 0x22, 0xed, 0x1e, 0x0d, 0x6c, 0xab, 0x66, 0xd5, 0xff, 0x77, 0xe1, 0x28, 0x71, 0x16, 0x86,
+	0x24, 0xec, 0xbd, 0x40, 0x19, 0x81, 0x70, 0x2e, 0x81, 0xab, 0xa0, 0x44, 0x82, 0x90, 0x45, 0xf6,
+	0x7f, 0x35, 0xab, 0x3e, 0xeb, 0x96, 0x47, 0x89, 0x33, 0x6f, 0xb4, 0x1a, 0x46, 0xd8, 0xd0, 0xb0,
+	0x05, 0x96, 0x42, 0x72, 0xe0, 0x75, 0x04, 0x89, 0xbb, 0x9e, 0xe0, 0x7d, 0x45, 0xbd, 0x2e, 0x8f,
+	0xa5, 0x3d, 0x55, 0xb3, 0xea, 0x45, 0xd7, 0x19, 0x25, 0xce, 0x03, 0x53, 0x76, 0x9d, 0x0a, 0xe1,
+	0xbb, 0x21, 0x39, 0xd8, 0x49, 0x51, 0x9c, 0x82, 0x6f, 0x78, 0x2c, 0xe1, 0x37, 0x0b, 0x2c, 0xc5,
+	0x82, 0x7f, 0x62, 0xca, 0x0b, 0x98, 0x54, 0x82, 0xb5, 0xfb, 0x8a, 0xf1, 0x48, 0xda, 0xc5, 0xda,
+	0x54, 0x7d, 0x6e, 0x6b, 0xbd, 0x71, 0xd3, 0xed, 0x34, 0x5a, 0xba, 0x6a, 0xfb, 0x5c, 0x91, 0xfb,
+	0xe4, 0x30, 0x71, 0x0a, 0x67, 0x43, 0x5c, 0xe7, 0x8b, 0xf0, 0x62, 0x7c, 0xa5, 0x50, 0x42, 0x1f,
+	0x54, 0x68, 0xcc, 0xfd, 0xae, 0x27, 0x15, 0x51, 0x4c, 0x2a, 0xe6, 0x4b, 0x4f, 0x50, 0x45, 0xa3,
+	0x94, 0xb6, 0x4b, 0x7a, 0xbd, 0x95, 0x51, 0xe2, 0x3c, 0xce, 0x6e, 0xf0, 0x46, 0x2d, 0xc2, 0xb6,
+	0x26, 0x77, 0xc7, 0x1c, 0xce, 0x29, 0xf8, 0x05, 0xc0, 0x6c, 0x24, 0x49, 0x89, 0xf0, 0xbb, 0x5e,
+	0xc8, 0x03, 0x6a, 0x4f, 0xd7, 0xac, 0xfa, 0xc2, 0xd6, 0xda, 0x6d, 0x8b, 0xee, 0xea, 0x92, 0x77,
+	0x3c, 0xa0, 0xee, 0xa3, 0x51, 0xe2, 0x2c, 0x5f, 0x58, 0xf1, 0x9c, 0x1f, 0xc2, 0xe5, 0xf8, 0x52,
+	0x01, 0xfc, 0x69, 0x01, 0xa7, 0xc3, 0x7b, 0x01, 0x8d, 0x3c, 0x49, 0xfd, 0x74, 0x96, 0xbc, 0x22,
+	0x16, 0xd4, 0x67, 0x32, 0xdd, 0x71, 0x46, 0x3f, 0xf9, 0x8f, 0xe9, 0x0d, 0x1e, 0x27, 0xce, 0x6a,
+	0x87, 0xa9, 0x6e, 0xbf, 0xdd, 0xf0, 0x79, 0x98, 0x45, 0x2c, 0xfb, 0xd9, 0x90, 0xc1, 0x7e, 0x53,
+	0x0d, 0x63, 0x2a, 0x1b, 0xdb, 0xd4, 0x1f, 0x25, 0xce, 0xaa, 0x19, 0xe4, 0x16, 0x7b, 0x84, 0x1f,
+	0x1a, 0xc5, 0xae, 0x11, 0x98, 0xe1, 0x5a, 0x63, 0xfa, 0x78, 0x0a, 0xc0, 0xab, 0x0f, 0x34, 0xcd,
+	0x65, 0x40, 0x23, 0x1e, 0xda, 0xd6, 0xe5, 0x5c, 0x6a, 0x18, 0x61, 0x43, 0xc3, 0x0f, 0xa0, 0xd8,
+	0xee, 0x8b, 0x3c, 0xbe, 0x2f, 0x27, 0x5e, 0x62, 0xce, 0x98, 0xa6, 0x1e, 0x08, 0x6b, 0x2b, 0x18,
+	0x81, 0x05, 0x9f, 0x87, 0x61, 0x3f, 0x62, 0x6a, 0xe8, 0xc5, 0x9c, 0xf7, 0x74, 0xc8, 0x67, 0xdd,
+	0x9d, 0x89, 0xcd, 0xef, 0x19, 0xf3, 0x8b, 0x6e, 0x08, 0xdf, 0x19, 0x03, 0x2d, 0xce, 0x7b, 0x70,
+	0x0f, 0xcc, 0x48, 0x45, 0xf6, 0xa9, 0x48, 0xa3, 0x9f, 0x36, 0x7a, 0x35, 0x71, 0xa3, 0xec, 0xf5,
+	0xce, 0x6c, 0x10, 0xce, 0x0d, 0xe1, 0x57, 0xb0, 0xd8, 0x63, 0x9f, 0xfb, 0x2c, 0xd0, 0xdd, 0x05,
+	0x1f, 0xb0, 0x20, 0xed, 0x53, 0xd2, 0x7d, 0xde, 0x4e, 0xdc, 0xa7, 0x62, 0xfa, 0x5c, 0x63, 0x89,
+	0x30, 0x1c, 0xa3, 0xad, 0x1c, 0x5c, 0x7b, 0x0d, 0xca, 0x97, 0x33, 0x0c, 0xcb, 0x60, 0xde, 0x65,
+	0x11, 0x11, 0x43, 0x83, 0x95, 0x0b, 0xf0, 0x3e, 0x58, 0xdc, 0xb9, 0x1a, 0x91, 0xb2, 0x55, 0x29,
+	0x7e, 0xff, 0x55, 0x2d, 0xb8, 0xef, 0x0f, 0x4f, 0xaa, 0xd6, 0xd1, 0x49, 0xd5, 0xfa, 0x7b, 0x52,
+	0xb5, 0x7e, 0x9c, 0x56, 0x0b, 0x47, 0xa7, 0xd5, 0xc2, 0x9f, 0xd3, 0x6a, 0x61, 0xef, 0xd9, 0xb9,
+	0xc1, 0xb3, 0x97, 0x68, 0xa3, 0x47, 0xda, 0x32, 0x3f, 0x34, 0x07, 0x9b, 0xcf, 0x9b, 0x07, 0x67,
+	0x5f, 0x61, 0xbd, 0x4a, 0x7b, 0x5a, 0x9f, 0x9f, 0xfe, 0x1b, 0x00, 0x2d, 0xb6, 0xe1, 0xd3, 0xa6,
+	0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +292,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.GoldenSectionSearchPrecision.Size()
+		i -= size
+		if _, err := m.GoldenSectionSearchPrecision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ProfitSearchMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProfitSearchMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochStatisticsRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochStatisticsRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProfitDistributions) > 0 {
+		for iNdEx := len(m.ProfitDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxGraphRouteHops != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGraphRouteHops))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -146,6 +351,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfitDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityProviders.Size()
+		i -= size
+		if _, err := m.LiquidityProviders.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Stakers.Size()
+		i -= size
+		if _, err := m.Stakers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -170,6 +445,44 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxGraphRouteHops != 0 {
+		n += 1 + sovParams(uint64(m.MaxGraphRouteHops))
+	}
+	if len(m.ProfitDistributions) > 0 {
+		for _, e := range m.ProfitDistributions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.EpochStatisticsRetention != 0 {
+		n += 1 + sovParams(uint64(m.EpochStatisticsRetention))
+	}
+	if m.ProfitSearchMode != 0 {
+		n += 1 + sovParams(uint64(m.ProfitSearchMode))
+	}
+	l = m.GoldenSectionSearchPrecision.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ProfitDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Burn.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Stakers.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LiquidityProviders.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -260,6 +573,349 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGraphRouteHops", wireType)
+			}
+			m.MaxGraphRouteHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGraphRouteHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitDistributions = append(m.ProfitDistributions, ProfitDistribution{})
+			if err := m.ProfitDistributions[len(m.ProfitDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStatisticsRetention", wireType)
+			}
+			m.EpochStatisticsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStatisticsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitSearchMode", wireType)
+			}
+			m.ProfitSearchMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProfitSearchMode |= ProfitSearchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoldenSectionSearchPrecision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoldenSectionSearchPrecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stakers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProviders.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v16/x/protorev/types"
+)
+
+func TestValidateProfitDistributions(t *testing.T) {
+	distribution := func(denom string, burn, communityPool, stakers, liquidityProviders int64) types.ProfitDistribution {
+		return types.ProfitDistribution{
+			Denom:              denom,
+			Burn:               sdk.NewDecWithPrec(burn, 2),
+			CommunityPool:      sdk.NewDecWithPrec(communityPool, 2),
+			Stakers:            sdk.NewDecWithPrec(stakers, 2),
+			LiquidityProviders: sdk.NewDecWithPrec(liquidityProviders, 2),
+		}
+	}
+
+	cases := []struct {
+		description   string
+		distributions []types.ProfitDistribution
+		valid         bool
+	}{
+		{
+			description:   "No distributions",
+			distributions: nil,
+			valid:         true,
+		},
+		{
+			description:   "Fractions adding up to one",
+			distributions: []types.ProfitDistribution{distribution("uosmo", 25, 25, 25, 25)},
+			valid:         true,
+		},
+		{
+			description:   "Fractions adding up to less than one",
+			distributions: []types.ProfitDistribution{distribution("uosmo", 10, 0, 0, 0), distribution("Atom", 0, 0, 0, 50)},
+			valid:         true,
+		},
+		{
+			description:   "Fractions adding up to more than one",
+			distributions: []types.ProfitDistribution{distribution("uosmo", 25, 25, 25, 26)},
+			valid:         false,
+		},
+		{
+			description:   "Negative fraction",
+			distributions: []types.ProfitDistribution{distribution("uosmo", -10, 0, 0, 0)},
+			valid:         false,
+		},
+		{
+			description:   "Unset fraction",
+			distributions: []types.ProfitDistribution{{Denom: "uosmo", Burn: sdk.OneDec()}},
+			valid:         false,
+		},
+		{
+			description:   "Invalid denom",
+			distributions: []types.ProfitDistribution{distribution("", 10, 0, 0, 0)},
+			valid:         false,
+		},
+		{
+			description:   "Duplicate denom",
+			distributions: []types.ProfitDistribution{distribution("uosmo", 10, 0, 0, 0), distribution("uosmo", 0, 10, 0, 0)},
+			valid:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := types.ValidateProfitDistributions(tc.distributions)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateEpochStatisticsRetention(t *testing.T) {
+	require.NoError(t, types.ValidateEpochStatisticsRetention(uint64(0)))
+	require.NoError(t, types.ValidateEpochStatisticsRetention(types.MaxEpochStatisticsRetention))
+	require.Error(t, types.ValidateEpochStatisticsRetention(types.MaxEpochStatisticsRetention+1))
+	require.Error(t, types.ValidateEpochStatisticsRetention(int64(1)))
+}
+
+func TestValidateProfitSearchMode(t *testing.T) {
+	require.NoError(t, types.ValidateProfitSearchMode(types.BinarySearch))
+	require.NoError(t, types.ValidateProfitSearchMode(types.GoldenSectionSearch))
+	require.Error(t, types.ValidateProfitSearchMode(types.ProfitSearchMode(2)))
+	require.Error(t, types.ValidateProfitSearchMode(uint64(1)))
+}
+
+func TestValidateGoldenSectionSearchPrecision(t *testing.T) {
+	require.NoError(t, types.ValidateGoldenSectionSearchPrecision(types.DefaultGoldenSectionSearchPrecision))
+	require.NoError(t, types.ValidateGoldenSectionSearchPrecision(types.MinGoldenSectionSearchPrecision))
+	require.NoError(t, types.ValidateGoldenSectionSearchPrecision(sdk.OneDec()))
+	require.Error(t, types.ValidateGoldenSectionSearchPrecision(sdk.ZeroDec()))
+	require.Error(t, types.ValidateGoldenSectionSearchPrecision(sdk.NewDecWithPrec(1, 5)))
+	require.Error(t, types.ValidateGoldenSectionSearchPrecision(sdk.NewDecWithPrec(11, 1)))
+	require.Error(t, types.ValidateGoldenSectionSearchPrecision(sdk.Dec{}))
+	require.Error(t, types.ValidateGoldenSectionSearchPrecision("0.01"))
+}
@@ -263,6 +263,271 @@ func (m *RouteStatistics) GetRoute() []uint64 {
 	return nil
 }
 
+// EpochDenomStatistics contains the number of trades the module has executed
+// with a given base denom during a given day epoch, the profits from the trades
+// and the pool points used to find them
+type EpochDenomStatistics struct {
+	// epoch is the number of the day epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	// denom is the base denom the trades were executed with
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// profit is the total profit from all trades in the epoch
+	Profit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=profit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"profit" yaml:"profit"`
+	// number_of_trades is the number of trades executed in the epoch
+	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
+	// points_used is the number of pool points consumed in the epoch
+	PointsUsed uint64 `protobuf:"varint,5,opt,name=points_used,json=pointsUsed,proto3" json:"points_used,omitempty" yaml:"points_used"`
+}
+
+func (m *EpochDenomStatistics) Reset()         { *m = EpochDenomStatistics{} }
+func (m *EpochDenomStatistics) String() string { return proto.CompactTextString(m) }
+func (*EpochDenomStatistics) ProtoMessage()    {}
+func (*EpochDenomStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+}
+func (m *EpochDenomStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochDenomStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochDenomStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochDenomStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochDenomStatistics.Merge(m, src)
+}
+func (m *EpochDenomStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochDenomStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochDenomStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochDenomStatistics proto.InternalMessageInfo
+
+func (m *EpochDenomStatistics) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochDenomStatistics) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EpochDenomStatistics) GetPointsUsed() uint64 {
+	if m != nil {
+		return m.PointsUsed
+	}
+	return 0
+}
+
+// EpochRouteStatistics contains the number of trades the module has executed on
+// a given route during a given day epoch, the profits from the trades and the
+// pool points used to find them
+type EpochRouteStatistics struct {
+	// epoch is the number of the day epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	// route is the route that was used (pool ids along the arbitrage route)
+	Route []uint64 `protobuf:"varint,2,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// profits is the total profit from all trades on this route in the epoch
+	Profits []types.Coin `protobuf:"bytes,3,rep,name=profits,proto3" json:"profits" yaml:"profits"`
+	// number_of_trades is the number of trades executed on this route in the
+	// epoch
+	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
+	// points_used is the number of pool points consumed on this route in the
+	// epoch
+	PointsUsed uint64 `protobuf:"varint,5,opt,name=points_used,json=pointsUsed,proto3" json:"points_used,omitempty" yaml:"points_used"`
+}
+
+func (m *EpochRouteStatistics) Reset()         { *m = EpochRouteStatistics{} }
+func (m *EpochRouteStatistics) String() string { return proto.CompactTextString(m) }
+func (*EpochRouteStatistics) ProtoMessage()    {}
+func (*EpochRouteStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+}
+func (m *EpochRouteStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRouteStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRouteStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRouteStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRouteStatistics.Merge(m, src)
+}
+func (m *EpochRouteStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRouteStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRouteStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRouteStatistics proto.InternalMessageInfo
+
+func (m *EpochRouteStatistics) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRouteStatistics) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *EpochRouteStatistics) GetProfits() []types.Coin {
+	if m != nil {
+		return m.Profits
+	}
+	return nil
+}
+
+func (m *EpochRouteStatistics) GetPointsUsed() uint64 {
+	if m != nil {
+		return m.PointsUsed
+	}
+	return 0
+}
+
+// PoolProfitsForDistribution contains the profits the module has made in a
+// given denom on a given pool since the last distribution of the profits in
+// that denom to liquidity providers
+type PoolProfitsForDistribution struct {
+	// pool_id is the id of the pool the profits were made on
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// profit is the total profit made on the pool since the last distribution
+	Profit types.Coin `protobuf:"bytes,2,opt,name=profit,proto3" json:"profit" yaml:"profit"`
+}
+
+func (m *PoolProfitsForDistribution) Reset()         { *m = PoolProfitsForDistribution{} }
+func (m *PoolProfitsForDistribution) String() string { return proto.CompactTextString(m) }
+func (*PoolProfitsForDistribution) ProtoMessage()    {}
+func (*PoolProfitsForDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{6}
+}
+func (m *PoolProfitsForDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolProfitsForDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolProfitsForDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolProfitsForDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolProfitsForDistribution.Merge(m, src)
+}
+func (m *PoolProfitsForDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolProfitsForDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolProfitsForDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolProfitsForDistribution proto.InternalMessageInfo
+
+func (m *PoolProfitsForDistribution) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolProfitsForDistribution) GetProfit() types.Coin {
+	if m != nil {
+		return m.Profit
+	}
+	return types.Coin{}
+}
+
+// DistributedProfits contains the profits the module has distributed to a
+// given destination (burn, community pool, stakers or liquidity providers)
+type DistributedProfits struct {
+	// destination is where the profits were distributed to
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// amounts is the total amount distributed to the destination per denom
+	Amounts []types.Coin `protobuf:"bytes,2,rep,name=amounts,proto3" json:"amounts" yaml:"amounts"`
+	// osmo_value is the total value of the distributed amounts in uosmo at the
+	// time of each distribution
+	OsmoValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=osmo_value,json=osmoValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"osmo_value" yaml:"osmo_value"`
+}
+
+func (m *DistributedProfits) Reset()         { *m = DistributedProfits{} }
+func (m *DistributedProfits) String() string { return proto.CompactTextString(m) }
+func (*DistributedProfits) ProtoMessage()    {}
+func (*DistributedProfits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{7}
+}
+func (m *DistributedProfits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributedProfits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributedProfits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributedProfits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributedProfits.Merge(m, src)
+}
+func (m *DistributedProfits) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributedProfits) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributedProfits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributedProfits proto.InternalMessageInfo
+
+func (m *DistributedProfits) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *DistributedProfits) GetAmounts() []types.Coin {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
 // PoolWeights contains the weights of all of the different pool types. This
 // distinction is made and necessary because the execution time ranges
 // significantly between the different pool types. Each weight roughly
@@ -275,13 +540,15 @@ type PoolWeights struct {
 	BalancerWeight uint64 `protobuf:"varint,2,opt,name=balancer_weight,json=balancerWeight,proto3" json:"balancer_weight,omitempty" yaml:"balancer_weight"`
 	// The weight of a concentrated pool
 	ConcentratedWeight uint64 `protobuf:"varint,3,opt,name=concentrated_weight,json=concentratedWeight,proto3" json:"concentrated_weight,omitempty" yaml:"concentrated_weight"`
+	// The weight of a cosmwasm pool
+	CosmwasmWeight uint64 `protobuf:"varint,4,opt,name=cosmwasm_weight,json=cosmwasmWeight,proto3" json:"cosmwasm_weight,omitempty" yaml:"cosmwasm_weight"`
 }
 
 func (m *PoolWeights) Reset()         { *m = PoolWeights{} }
 func (m *PoolWeights) String() string { return proto.CompactTextString(m) }
 func (*PoolWeights) ProtoMessage()    {}
 func (*PoolWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+	return fileDescriptor_1e9f2391fd9fec01, []int{8}
 }
 func (m *PoolWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,6 +598,13 @@ func (m *PoolWeights) GetConcentratedWeight() uint64 {
 	return 0
 }
 
+func (m *PoolWeights) GetCosmwasmWeight() uint64 {
+	if m != nil {
+		return m.CosmwasmWeight
+	}
+	return 0
+}
+
 // BaseDenom represents a single base denom that the module uses for its
 // arbitrage trades. It contains the denom name alongside the step size of the
 // binary search that is used to find the optimal swap amount
@@ -346,7 +620,7 @@ func (m *BaseDenom) Reset()         { *m = BaseDenom{} }
 func (m *BaseDenom) String() string { return proto.CompactTextString(m) }
 func (*BaseDenom) ProtoMessage()    {}
 func (*BaseDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+	return fileDescriptor_1e9f2391fd9fec01, []int{9}
 }
 func (m *BaseDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,6 +661,10 @@ func init() {
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
 	proto.RegisterType((*RouteStatistics)(nil), "osmosis.protorev.v1beta1.RouteStatistics")
+	proto.RegisterType((*EpochDenomStatistics)(nil), "osmosis.protorev.v1beta1.EpochDenomStatistics")
+	proto.RegisterType((*EpochRouteStatistics)(nil), "osmosis.protorev.v1beta1.EpochRouteStatistics")
+	proto.RegisterType((*PoolProfitsForDistribution)(nil), "osmosis.protorev.v1beta1.PoolProfitsForDistribution")
+	proto.RegisterType((*DistributedProfits)(nil), "osmosis.protorev.v1beta1.DistributedProfits")
 	proto.RegisterType((*PoolWeights)(nil), "osmosis.protorev.v1beta1.PoolWeights")
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
 }
@@ -396,49 +674,65 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x35, 0xe9, 0x8f, 0x5c, 0x7f, 0xa4, 0xb8, 0xa5, 0xb8, 0x19, 0xec, 0xe8, 0x90, 0x4a,
-	0x96, 0xda, 0x0a, 0x20, 0x86, 0x4a, 0x0c, 0xb8, 0x2c, 0x15, 0x52, 0x5b, 0x5d, 0x2b, 0x21, 0x58,
-	0xac, 0xb3, 0x73, 0x4d, 0xad, 0x26, 0xbe, 0xc8, 0x77, 0x29, 0xb4, 0x7f, 0x05, 0x03, 0xec, 0xfc,
-	0x25, 0xcc, 0x1d, 0x3b, 0x56, 0x0c, 0x16, 0x4a, 0x17, 0x66, 0xaf, 0x2c, 0xc8, 0x77, 0x67, 0x27,
-	0xaa, 0x40, 0x82, 0x01, 0x26, 0xbf, 0xf7, 0xbd, 0xf7, 0x7d, 0xef, 0xde, 0x7b, 0x77, 0x86, 0x8f,
-	0x18, 0x1f, 0x30, 0x1e, 0x71, 0x77, 0x98, 0x30, 0xc1, 0x12, 0x7a, 0xee, 0x9e, 0x77, 0x02, 0x2a,
-	0x48, 0xa7, 0x04, 0x1c, 0x69, 0x18, 0xa6, 0x4e, 0x74, 0x4a, 0x5c, 0x27, 0x36, 0x37, 0x43, 0x19,
-	0xf2, 0x65, 0xc0, 0x55, 0x8e, 0xca, 0x6a, 0xae, 0xf7, 0x58, 0x8f, 0x29, 0x3c, 0xb7, 0x34, 0x6a,
-	0xa9, 0x1c, 0x37, 0x20, 0x9c, 0x96, 0xe5, 0x42, 0x16, 0xc5, 0x2a, 0x8e, 0x6e, 0x00, 0x34, 0x8e,
-	0xd9, 0x19, 0x8d, 0x0f, 0x49, 0x94, 0xbc, 0x48, 0x02, 0xcc, 0x46, 0x82, 0x72, 0xe3, 0x0d, 0x84,
-	0x24, 0x09, 0xfc, 0x44, 0x7a, 0x26, 0x68, 0x55, 0xdb, 0x8b, 0x8f, 0x6d, 0xe7, 0x77, 0xc7, 0x72,
-	0x24, 0xcb, 0xdb, 0xbc, 0x4a, 0xed, 0x4a, 0x96, 0xda, 0xf7, 0x2e, 0xc8, 0xa0, 0xbf, 0x83, 0x26,
-	0x02, 0x08, 0xd7, 0x49, 0x29, 0xed, 0xc0, 0x05, 0x91, 0x17, 0xf4, 0xa3, 0xd8, 0x9c, 0x69, 0x81,
-	0x76, 0xdd, 0x5b, 0xcb, 0x52, 0xbb, 0xa1, 0x38, 0x45, 0x04, 0xe1, 0x79, 0x69, 0xee, 0xc5, 0x46,
-	0x07, 0xd6, 0x15, 0xca, 0x46, 0xc2, 0xac, 0x4a, 0xc2, 0x7a, 0x96, 0xda, 0xab, 0xd3, 0x04, 0x36,
-	0x12, 0x08, 0x2b, 0xd9, 0x83, 0x91, 0xd8, 0xa9, 0x7d, 0xff, 0x6c, 0x03, 0xf4, 0x05, 0xc0, 0x59,
-	0x59, 0xd3, 0xd8, 0x87, 0x73, 0x22, 0x21, 0xdd, 0x3f, 0xe9, 0xe4, 0x38, 0xcf, 0xf3, 0xee, 0xeb,
-	0x4e, 0x96, 0x75, 0x11, 0x49, 0x46, 0x58, 0xab, 0x18, 0x3e, 0xac, 0x73, 0x41, 0x87, 0x3e, 0x8f,
-	0x2e, 0xa9, 0xee, 0xc1, 0xcb, 0x19, 0x5f, 0x53, 0x7b, 0xab, 0x17, 0x89, 0xd3, 0x51, 0xe0, 0x84,
-	0x6c, 0xa0, 0xd7, 0xa3, 0x3f, 0xdb, 0xbc, 0x7b, 0xe6, 0x8a, 0x8b, 0x21, 0xe5, 0xce, 0x5e, 0x2c,
-	0x26, 0x0d, 0x94, 0x42, 0x08, 0x2f, 0xe4, 0xf6, 0x51, 0x74, 0x49, 0x75, 0x03, 0x9f, 0x00, 0x9c,
-	0x95, 0xe7, 0x31, 0x1e, 0xc2, 0xda, 0x90, 0xb1, 0xbe, 0x09, 0x5a, 0xa0, 0x5d, 0xf3, 0x1a, 0x59,
-	0x6a, 0x2f, 0x2a, 0x76, 0x8e, 0x22, 0x2c, 0x83, 0xff, 0x6f, 0xb0, 0x3f, 0x00, 0x6c, 0xc8, 0xc1,
-	0x1e, 0x09, 0x22, 0x22, 0x2e, 0xa2, 0x90, 0x1b, 0xaf, 0xe0, 0xfc, 0x30, 0x61, 0x27, 0x91, 0x28,
-	0x66, 0xbc, 0xe9, 0xe8, 0xdb, 0x99, 0xdf, 0xbc, 0x72, 0xbc, 0xbb, 0x2c, 0x8a, 0xbd, 0x0d, 0x3d,
-	0xdd, 0x15, 0xdd, 0x83, 0xe2, 0x21, 0x5c, 0x28, 0x18, 0x1c, 0xae, 0xc6, 0xa3, 0x41, 0x40, 0x13,
-	0x9f, 0x9d, 0xf8, 0x7a, 0x73, 0xaa, 0xa3, 0xbd, 0xbf, 0x1e, 0xf3, 0x03, 0x55, 0xe4, 0xae, 0x1e,
-	0xc2, 0x2b, 0x0a, 0x3a, 0x38, 0x39, 0x56, 0x4b, 0xdd, 0x82, 0xb3, 0xf2, 0xb6, 0x9a, 0xd5, 0x56,
-	0xb5, 0x5d, 0xf3, 0x56, 0xb3, 0xd4, 0x5e, 0x52, 0x5c, 0x09, 0x23, 0xac, 0xc2, 0x68, 0x0c, 0xe0,
-	0xe2, 0x21, 0x63, 0xfd, 0xd7, 0x34, 0xea, 0x9d, 0x0a, 0x6e, 0x3c, 0x87, 0xcb, 0x5c, 0x90, 0xa0,
-	0x4f, 0xfd, 0x77, 0x12, 0xd1, 0x4b, 0x32, 0xb3, 0xd4, 0x5e, 0x2f, 0x56, 0x3c, 0x15, 0x46, 0x78,
-	0x49, 0xf9, 0x8a, 0x6f, 0xec, 0xc2, 0x46, 0x40, 0xfa, 0x24, 0x0e, 0x69, 0x52, 0x08, 0xcc, 0x48,
-	0x81, 0x66, 0x96, 0xda, 0x1b, 0x4a, 0xe0, 0x4e, 0x02, 0xc2, 0x2b, 0x05, 0xa2, 0x45, 0x0e, 0xe0,
-	0x5a, 0xc8, 0xe2, 0x90, 0xc6, 0x22, 0x21, 0x82, 0x76, 0x0b, 0xa1, 0xaa, 0x14, 0xb2, 0xb2, 0xd4,
-	0x6e, 0x2a, 0xa1, 0x5f, 0x24, 0x21, 0x6c, 0x4c, 0xa3, 0x4a, 0x10, 0x7d, 0x04, 0xb0, 0xee, 0x11,
-	0x4e, 0x5f, 0xd2, 0x98, 0x0d, 0xf2, 0xd1, 0x74, 0x73, 0x43, 0xb6, 0x56, 0x9f, 0x1e, 0x8d, 0x84,
-	0x11, 0x56, 0xe1, 0x7f, 0xfe, 0x2e, 0xbc, 0xfd, 0xab, 0xb1, 0x05, 0xae, 0xc7, 0x16, 0xf8, 0x36,
-	0xb6, 0xc0, 0x87, 0x5b, 0xab, 0x72, 0x7d, 0x6b, 0x55, 0x6e, 0x6e, 0xad, 0xca, 0xdb, 0xa7, 0x53,
-	0xfa, 0xfa, 0x71, 0x6f, 0xf7, 0x49, 0xc0, 0x0b, 0xc7, 0x3d, 0xef, 0x3c, 0x73, 0xdf, 0x4f, 0xfe,
-	0xbc, 0xb2, 0x62, 0x30, 0x27, 0xfd, 0x27, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf0, 0xfc,
-	0x0d, 0x9a, 0x05, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xf8, 0x23, 0xa9, 0xc7, 0xcd, 0x07, 0xd3, 0x10, 0x1c, 0x1f, 0xbc, 0xd1, 0x20, 0x85,
+	0x48, 0xa8, 0xb6, 0x02, 0x08, 0x50, 0x25, 0x84, 0xd8, 0x14, 0x44, 0x84, 0xd4, 0x44, 0xd3, 0x40,
+	0x05, 0x97, 0xd5, 0xac, 0x77, 0x92, 0x8c, 0x6a, 0xef, 0x58, 0x3b, 0xb3, 0x2e, 0xed, 0x5f, 0x81,
+	0x04, 0xdc, 0xb9, 0xf1, 0x5f, 0x70, 0xee, 0xb1, 0xc7, 0x8a, 0xc3, 0x0a, 0x92, 0x0b, 0x17, 0x2e,
+	0x7b, 0xe5, 0x00, 0x9a, 0x8f, 0x5d, 0xaf, 0xac, 0x10, 0xe1, 0x43, 0x50, 0x4f, 0x99, 0xf7, 0x7b,
+	0xef, 0xfd, 0xe6, 0xbd, 0xdf, 0x7b, 0x99, 0x35, 0x7c, 0x4b, 0xc8, 0xb1, 0x90, 0x5c, 0x0e, 0x26,
+	0x89, 0x50, 0x22, 0x61, 0xd3, 0xc1, 0x74, 0x3f, 0x64, 0x8a, 0xee, 0x97, 0x40, 0xdf, 0x1c, 0x50,
+	0xc7, 0x05, 0xf6, 0x4b, 0xdc, 0x05, 0x76, 0xb7, 0x87, 0xc6, 0x15, 0x18, 0xc7, 0xc0, 0x1a, 0x36,
+	0xaa, 0xbb, 0x79, 0x26, 0xce, 0x84, 0xc5, 0xf5, 0xc9, 0xa1, 0x3d, 0x1b, 0x33, 0x08, 0xa9, 0x64,
+	0xe5, 0x75, 0x43, 0xc1, 0x63, 0xeb, 0xc7, 0x2f, 0x01, 0x44, 0x27, 0xe2, 0x31, 0x8b, 0x8f, 0x29,
+	0x4f, 0x3e, 0x49, 0x42, 0x22, 0x52, 0xc5, 0x24, 0xfa, 0x1a, 0x42, 0x9a, 0x84, 0x41, 0x62, 0xac,
+	0x0e, 0xd8, 0xa9, 0xef, 0xb5, 0xdf, 0xf1, 0xfa, 0xff, 0x56, 0x56, 0xdf, 0x64, 0xf9, 0xdb, 0xcf,
+	0x33, 0x6f, 0x29, 0xcf, 0xbc, 0xd7, 0x9e, 0xd2, 0xf1, 0xe8, 0x1e, 0x9e, 0x11, 0x60, 0xd2, 0xa2,
+	0x25, 0x75, 0x1f, 0xde, 0x52, 0xfa, 0xc2, 0x80, 0xc7, 0x9d, 0xda, 0x0e, 0xd8, 0x6b, 0xf9, 0x77,
+	0xf2, 0xcc, 0x5b, 0xb7, 0x39, 0x85, 0x07, 0x93, 0x15, 0x73, 0x3c, 0x8c, 0xd1, 0x3e, 0x6c, 0x59,
+	0x54, 0xa4, 0xaa, 0x53, 0x37, 0x09, 0x9b, 0x79, 0xe6, 0x6d, 0x54, 0x13, 0x44, 0xaa, 0x30, 0xb1,
+	0xb4, 0x47, 0xa9, 0xba, 0xd7, 0xf8, 0xe3, 0x27, 0x0f, 0xe0, 0x5f, 0x00, 0x6c, 0x9a, 0x3b, 0xd1,
+	0x03, 0xb8, 0xac, 0x12, 0x1a, 0xfd, 0x97, 0x4e, 0x4e, 0x74, 0x9c, 0xff, 0xba, 0xeb, 0x64, 0xd5,
+	0x5d, 0x62, 0x92, 0x31, 0x71, 0x2c, 0x28, 0x80, 0x2d, 0xa9, 0xd8, 0x24, 0x90, 0xfc, 0x19, 0x73,
+	0x3d, 0xf8, 0x3a, 0xe3, 0xd7, 0xcc, 0xdb, 0x3d, 0xe3, 0xea, 0x3c, 0x0d, 0xfb, 0x43, 0x31, 0x76,
+	0xe3, 0x71, 0x7f, 0xee, 0xca, 0xe8, 0xf1, 0x40, 0x3d, 0x9d, 0x30, 0xd9, 0x3f, 0x8c, 0xd5, 0xac,
+	0x81, 0x92, 0x08, 0x93, 0x5b, 0xfa, 0xfc, 0x90, 0x3f, 0x63, 0xae, 0x81, 0x1f, 0x01, 0x6c, 0x9a,
+	0x7a, 0xd0, 0x9b, 0xb0, 0x31, 0x11, 0x62, 0xd4, 0x01, 0x3b, 0x60, 0xaf, 0xe1, 0xaf, 0xe7, 0x99,
+	0xd7, 0xb6, 0xd9, 0x1a, 0xc5, 0xc4, 0x38, 0xff, 0x3f, 0x61, 0xff, 0x02, 0x70, 0xdd, 0x08, 0xfb,
+	0x50, 0x51, 0xc5, 0xa5, 0xe2, 0x43, 0x89, 0xbe, 0x80, 0x2b, 0x93, 0x44, 0x9c, 0x72, 0x55, 0x68,
+	0xbc, 0xdd, 0x77, 0xdb, 0xa9, 0x37, 0xaf, 0x94, 0xf7, 0x40, 0xf0, 0xd8, 0xdf, 0x72, 0xea, 0xae,
+	0xb9, 0x1e, 0x6c, 0x1e, 0x26, 0x05, 0x03, 0x92, 0x70, 0x23, 0x4e, 0xc7, 0x21, 0x4b, 0x02, 0x71,
+	0x1a, 0xb8, 0xc9, 0xd9, 0x8e, 0x0e, 0x17, 0x96, 0xf9, 0x0d, 0x7b, 0xc9, 0x3c, 0x1f, 0x26, 0x6b,
+	0x16, 0x3a, 0x3a, 0x3d, 0xb1, 0x43, 0xdd, 0x85, 0x4d, 0xb3, 0xad, 0x9d, 0xfa, 0x4e, 0x7d, 0xaf,
+	0xe1, 0x6f, 0xe4, 0x99, 0x77, 0xdb, 0xe6, 0x1a, 0x18, 0x13, 0xeb, 0xc6, 0x7f, 0xd6, 0xe0, 0xe6,
+	0xa7, 0x13, 0x31, 0x3c, 0xbf, 0xcf, 0x62, 0x31, 0xae, 0x48, 0xb0, 0x0b, 0x9b, 0x4c, 0xe3, 0x6e,
+	0x4a, 0x15, 0x02, 0x03, 0x63, 0x62, 0xdd, 0x3a, 0x2e, 0xd2, 0xa9, 0xae, 0xa5, 0x4a, 0x9c, 0x81,
+	0x31, 0xb1, 0x6e, 0xf4, 0x08, 0x2e, 0x5b, 0x41, 0xdc, 0x70, 0x3e, 0x5e, 0xb8, 0xf7, 0xd5, 0xaa,
+	0xc0, 0x98, 0x38, 0xba, 0x2b, 0xe5, 0x6d, 0xdc, 0xb4, 0xbc, 0x1f, 0xc0, 0xf6, 0x44, 0xf0, 0x58,
+	0xc9, 0x20, 0x95, 0x2c, 0xea, 0x34, 0x8d, 0x46, 0x5b, 0x79, 0xe6, 0xa1, 0x62, 0x93, 0x4b, 0x27,
+	0x26, 0xd0, 0x5a, 0x5f, 0x6a, 0xe3, 0xf7, 0x42, 0xef, 0xf9, 0x95, 0x5b, 0x40, 0x6f, 0x3b, 0xd8,
+	0xda, 0xb5, 0x83, 0xad, 0xae, 0x70, 0xfd, 0x46, 0x56, 0xf8, 0xd5, 0xd5, 0xf8, 0x7b, 0x00, 0xbb,
+	0xc7, 0x42, 0x8c, 0x8e, 0x6d, 0xf5, 0x9f, 0x89, 0xe4, 0x3e, 0x97, 0x2a, 0xe1, 0x61, 0xaa, 0xb8,
+	0x88, 0xd1, 0xdb, 0x70, 0x45, 0xbf, 0x30, 0x01, 0x8f, 0x9c, 0xd6, 0xa8, 0xd2, 0xba, 0x75, 0xe8,
+	0xed, 0x12, 0x62, 0x74, 0x18, 0xa1, 0xcf, 0xcb, 0xb5, 0xd5, 0xfb, 0x7d, 0xad, 0x8a, 0x73, 0xcf,
+	0xec, 0xdc, 0x9e, 0xe2, 0xbf, 0x01, 0x44, 0x65, 0x1d, 0x2c, 0x72, 0xc5, 0xa1, 0x0f, 0x61, 0x3b,
+	0x62, 0x52, 0xf1, 0x98, 0xea, 0xe2, 0x4c, 0x45, 0xad, 0x6a, 0x97, 0x15, 0x27, 0x26, 0xd5, 0x50,
+	0x3d, 0x61, 0x3a, 0x16, 0x69, 0xac, 0xa4, 0xd9, 0x85, 0x45, 0x26, 0xec, 0xf2, 0x30, 0x29, 0x18,
+	0x50, 0x08, 0xa1, 0xce, 0x0d, 0xa6, 0x74, 0x94, 0x32, 0xf7, 0x2f, 0x7a, 0xb0, 0xf0, 0x6c, 0xdd,
+	0xb7, 0x72, 0xc6, 0x84, 0x49, 0x4b, 0x1b, 0x5f, 0x99, 0xf3, 0xcf, 0x35, 0xd8, 0xd6, 0x73, 0x79,
+	0xc4, 0xf8, 0xd9, 0xb9, 0x92, 0xe8, 0x23, 0xb8, 0x2a, 0x15, 0x0d, 0x47, 0x2c, 0x78, 0x62, 0x10,
+	0x37, 0x8e, 0x4e, 0x9e, 0x79, 0x9b, 0xc5, 0xe7, 0xa4, 0xe2, 0xc6, 0xe4, 0xb6, 0xb5, 0x6d, 0x3e,
+	0x3a, 0x80, 0xeb, 0x21, 0x1d, 0xd1, 0x78, 0xc8, 0x92, 0x82, 0xa0, 0x66, 0x08, 0xba, 0x79, 0xe6,
+	0x6d, 0x59, 0x82, 0xb9, 0x00, 0x4c, 0xd6, 0x0a, 0xc4, 0x91, 0x1c, 0xc1, 0x3b, 0x43, 0x11, 0x0f,
+	0x59, 0xac, 0x12, 0xaa, 0x58, 0x54, 0x10, 0xd5, 0x0d, 0x51, 0x2f, 0xcf, 0xbc, 0xae, 0x25, 0xba,
+	0x22, 0x08, 0x13, 0x54, 0x45, 0x67, 0x55, 0x69, 0x71, 0x9e, 0x50, 0x39, 0x2e, 0xc8, 0x1a, 0xf3,
+	0x55, 0xcd, 0x05, 0x60, 0xb2, 0x56, 0x20, 0x96, 0x04, 0xff, 0x00, 0x60, 0xcb, 0xa7, 0x92, 0x99,
+	0x47, 0x79, 0xf6, 0xc4, 0x82, 0xeb, 0x9f, 0xd8, 0x9b, 0xfe, 0x90, 0xfb, 0x0f, 0x9e, 0x5f, 0xf4,
+	0xc0, 0x8b, 0x8b, 0x1e, 0xf8, 0xed, 0xa2, 0x07, 0xbe, 0xbb, 0xec, 0x2d, 0xbd, 0xb8, 0xec, 0x2d,
+	0xbd, 0xbc, 0xec, 0x2d, 0x7d, 0xf3, 0x5e, 0x85, 0xdf, 0xfd, 0x1a, 0xb9, 0x3b, 0xa2, 0xa1, 0x2c,
+	0x8c, 0xc1, 0x74, 0xff, 0xfd, 0xc1, 0xb7, 0xb3, 0x9f, 0x8a, 0xe6, 0xc6, 0x70, 0xd9, 0xd8, 0xef,
+	0xfe, 0x33, 0x00, 0xf5, 0xb4, 0xae, 0x54, 0x4b, 0x0a, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -743,7 +1037,7 @@ func (m *RouteStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
+func (m *EpochDenomStatistics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -753,35 +1047,57 @@ func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolWeights) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochDenomStatistics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochDenomStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.ConcentratedWeight))
+	if m.PointsUsed != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PointsUsed))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
 	}
-	if m.BalancerWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.BalancerWeight))
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.StableWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.StableWeight))
+	if m.Epoch != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Epoch))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
+func (m *EpochRouteStatistics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -791,63 +1107,273 @@ func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseDenom) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochRouteStatistics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochRouteStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PointsUsed != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PointsUsed))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size := m.StepSize.Size()
+		size := m.NumberOfTrades.Size()
 		i -= size
-		if _, err := m.StepSize.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
+	dAtA[i] = 0x22
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Route) > 0 {
+		dAtA4 := make([]byte, len(m.Route)*10)
+		var j3 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintProtorev(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtorev(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolProfitsForDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenPairArbRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolProfitsForDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolProfitsForDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ArbRoutes) > 0 {
-		for _, e := range m.ArbRoutes {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
+	{
+		size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributedProfits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributedProfits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributedProfits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoValue.Size()
+		i -= size
+		if _, err := m.OsmoValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmwasmWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.CosmwasmWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConcentratedWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.ConcentratedWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BalancerWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.BalancerWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StableWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.StableWeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StepSize.Size()
+		i -= size
+		if _, err := m.StepSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPairArbRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArbRoutes) > 0 {
+		for _, e := range m.ArbRoutes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
-	}
 	l = len(m.TokenOut)
 	if l > 0 {
 		n += 1 + l + sovProtorev(uint64(l))
@@ -916,6 +1442,94 @@ func (m *RouteStatistics) Size() (n int) {
 	return n
 }
 
+func (m *EpochDenomStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProtorev(uint64(m.Epoch))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.PointsUsed != 0 {
+		n += 1 + sovProtorev(uint64(m.PointsUsed))
+	}
+	return n
+}
+
+func (m *EpochRouteStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovProtorev(uint64(m.Epoch))
+	}
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovProtorev(uint64(e))
+		}
+		n += 1 + sovProtorev(uint64(l)) + l
+	}
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.PointsUsed != 0 {
+		n += 1 + sovProtorev(uint64(m.PointsUsed))
+	}
+	return n
+}
+
+func (m *PoolProfitsForDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *DistributedProfits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.OsmoValue.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
 func (m *PoolWeights) Size() (n int) {
 	if m == nil {
 		return 0
@@ -931,6 +1545,9 @@ func (m *PoolWeights) Size() (n int) {
 	if m.ConcentratedWeight != 0 {
 		n += 1 + sovProtorev(uint64(m.ConcentratedWeight))
 	}
+	if m.CosmwasmWeight != 0 {
+		n += 1 + sovProtorev(uint64(m.CosmwasmWeight))
+	}
 	return n
 }
 
@@ -1548,7 +2165,7 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolWeights) Unmarshal(dAtA []byte) error {
+func (m *EpochDenomStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,17 +2188,17 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolWeights: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochDenomStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochDenomStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.StableWeight = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1591,16 +2208,16 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StableWeight |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalancerWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.BalancerWeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1610,16 +2227,688 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalancerWeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
 			}
-			m.ConcentratedWeight = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsUsed", wireType)
+			}
+			m.PointsUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointsUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRouteStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRouteStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRouteStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtorev
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsUsed", wireType)
+			}
+			m.PointsUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointsUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolProfitsForDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolProfitsForDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolProfitsForDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributedProfits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributedProfits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributedProfits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableWeight", wireType)
+			}
+			m.StableWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancerWeight", wireType)
+			}
+			m.BalancerWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancerWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedWeight", wireType)
+			}
+			m.ConcentratedWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtorev
@@ -1634,6 +2923,25 @@ func (m *PoolWeights) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmWeight", wireType)
+			}
+			m.CosmwasmWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmwasmWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
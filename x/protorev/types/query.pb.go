@@ -12,6 +12,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -1386,1173 +1387,1523 @@ func (m *QueryGetProtoRevPoolResponse) GetPoolId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
-	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
-	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+// QueryGetProtoRevDistributedProfitsRequest is request type for the
+// Query/GetProtoRevDistributedProfits RPC method.
+type QueryGetProtoRevDistributedProfitsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
+func (m *QueryGetProtoRevDistributedProfitsRequest) Reset() {
+	*m = QueryGetProtoRevDistributedProfitsRequest{}
 }
-
-var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
-	0x1b, 0x8e, 0x7b, 0x48, 0xbe, 0x4e, 0xda, 0x7e, 0xed, 0xb4, 0x49, 0x13, 0x37, 0xdd, 0x4d, 0x27,
-	0xa7, 0xe6, 0xb4, 0xab, 0xb4, 0xf9, 0xda, 0x8f, 0x43, 0x81, 0xb8, 0x81, 0x2a, 0xaa, 0x68, 0x16,
-	0x53, 0x84, 0x04, 0x12, 0x8b, 0x77, 0x77, 0xb2, 0xb5, 0xea, 0xf5, 0xb8, 0xb6, 0x37, 0x64, 0x6f,
-	0x41, 0x42, 0x42, 0x20, 0x71, 0xba, 0xe6, 0x8e, 0x1f, 0xc0, 0x1f, 0xe0, 0x82, 0x0b, 0xa4, 0x5e,
-	0xa1, 0x4a, 0x08, 0x09, 0xf5, 0x62, 0xa9, 0x5a, 0x24, 0x6e, 0xb8, 0xda, 0x5f, 0x80, 0x3c, 0xf3,
-	0x7a, 0xed, 0xf5, 0x61, 0xb3, 0x4e, 0x24, 0xae, 0xb2, 0xf6, 0xbc, 0xef, 0xf3, 0x3e, 0xcf, 0xcc,
-	0xbc, 0x33, 0x8f, 0x83, 0x66, 0x99, 0xd3, 0x60, 0x8e, 0xee, 0x14, 0x2d, 0x9b, 0xb9, 0xcc, 0xa6,
-	0xbb, 0xc5, 0xdd, 0xb5, 0x0a, 0x75, 0xb5, 0xb5, 0xe2, 0xc3, 0x26, 0xb5, 0x5b, 0x05, 0xfe, 0x1a,
-	0x4f, 0x40, 0x54, 0xc1, 0x8f, 0x2a, 0x40, 0x94, 0x7c, 0xbe, 0xce, 0xea, 0x8c, 0xbf, 0x2d, 0x7a,
-	0xbf, 0x44, 0x80, 0x3c, 0x55, 0x67, 0xac, 0x6e, 0xd0, 0xa2, 0x66, 0xe9, 0x45, 0xcd, 0x34, 0x99,
-	0xab, 0xb9, 0x3a, 0x33, 0x21, 0x5d, 0x5e, 0xaa, 0x72, 0xb8, 0x62, 0x45, 0x73, 0xa8, 0x28, 0xd3,
-	0x2d, 0x6a, 0x69, 0x75, 0xdd, 0xe4, 0xc1, 0x10, 0x3b, 0x97, 0xca, 0xcf, 0xd2, 0x6c, 0xad, 0xe1,
-	0x43, 0x2e, 0xa4, 0x87, 0xf9, 0x8c, 0x45, 0x60, 0x2e, 0x5c, 0xdb, 0x8f, 0xa9, 0x32, 0x1d, 0xea,
-	0x91, 0xf3, 0x08, 0xbf, 0xe5, 0x31, 0x2a, 0x71, 0x74, 0x95, 0x3e, 0x6c, 0x52, 0xc7, 0x25, 0x3b,
-	0xe8, 0x5c, 0xcf, 0x5b, 0xc7, 0x62, 0xa6, 0x43, 0xf1, 0x36, 0x1a, 0x16, 0x2c, 0x26, 0xa4, 0x69,
-	0xe9, 0xca, 0xe8, 0xd5, 0xe9, 0x42, 0xda, 0x3c, 0x15, 0x44, 0xa6, 0x32, 0xf6, 0xa8, 0x9d, 0x1f,
-	0xea, 0xb4, 0xf3, 0xa7, 0x5a, 0x5a, 0xc3, 0x78, 0x91, 0x88, 0x6c, 0xa2, 0x02, 0x0c, 0x59, 0x40,
-	0x73, 0xbc, 0xce, 0x6d, 0xea, 0x96, 0x3c, 0x04, 0x95, 0xee, 0xde, 0x6d, 0x36, 0x2a, 0xd4, 0xde,
-	0xde, 0xb9, 0x67, 0x6b, 0x35, 0xda, 0x25, 0xf4, 0x9d, 0x84, 0xe6, 0xf7, 0x8b, 0x04, 0x92, 0x0e,
-	0x3a, 0x63, 0xf2, 0x91, 0x32, 0xdb, 0x29, 0xbb, 0x7c, 0x8c, 0xd3, 0x3d, 0xa1, 0x6c, 0x79, 0x64,
-	0x9e, 0xb4, 0xf3, 0xf3, 0x75, 0xdd, 0xbd, 0xdf, 0xac, 0x14, 0xaa, 0xac, 0x51, 0x84, 0xe9, 0x11,
-	0x7f, 0x56, 0x9d, 0xda, 0x83, 0xa2, 0xdb, 0xb2, 0xa8, 0x53, 0xd8, 0x32, 0xdd, 0x4e, 0x3b, 0x7f,
-	0x41, 0xd0, 0x8e, 0xe2, 0x11, 0xf5, 0xb4, 0xd9, 0x53, 0x9c, 0x6c, 0xc7, 0x85, 0x94, 0x6c, 0xb6,
-	0xa3, 0xbb, 0x8e, 0xd2, 0xda, 0xa4, 0x26, 0x6b, 0x80, 0x10, 0x3c, 0x8f, 0x8e, 0xd7, 0xbc, 0x67,
-	0xa0, 0x74, 0xa6, 0xd3, 0xce, 0x9f, 0x14, 0x45, 0xf8, 0x6b, 0xa2, 0x8a, 0x61, 0x62, 0xc6, 0xf5,
-	0x46, 0x01, 0x41, 0xef, 0x26, 0x1a, 0xb6, 0xf8, 0x08, 0x2c, 0xca, 0x64, 0x41, 0x88, 0x29, 0x78,
-	0x4b, 0xde, 0x5d, 0x8f, 0x5b, 0x4c, 0x37, 0x95, 0xb3, 0xa1, 0x95, 0xe0, 0x29, 0xde, 0x4a, 0x88,
-	0x1f, 0x33, 0xe8, 0x72, 0xb4, 0xde, 0x86, 0x61, 0x40, 0x49, 0x7f, 0x15, 0x1e, 0x22, 0xd2, 0x2f,
-	0x08, 0x08, 0xdd, 0x41, 0x23, 0x02, 0xd4, 0x9b, 0xf7, 0xa3, 0xfd, 0x19, 0x8d, 0xc3, 0xfe, 0x38,
-	0x1d, 0x66, 0xe5, 0x10, 0x75, 0xa4, 0xfb, 0x0b, 0x5d, 0x89, 0x96, 0x7c, 0xdb, 0xeb, 0x2e, 0xc7,
-	0xd5, 0xab, 0x8e, 0xd2, 0x52, 0x59, 0xd3, 0xa5, 0xa1, 0xb9, 0xb5, 0xbd, 0x67, 0x5e, 0xf6, 0x58,
-	0x78, 0x6e, 0xf9, 0x6b, 0xa2, 0x8a, 0x61, 0xf2, 0xb5, 0x84, 0x16, 0x07, 0x00, 0x05, 0x39, 0x35,
-	0x84, 0x9c, 0xee, 0x20, 0xcc, 0xf1, 0x62, 0xfa, 0xc6, 0xe7, 0xc9, 0x21, 0xb4, 0x49, 0x50, 0x78,
-	0x56, 0x30, 0x09, 0xa0, 0x88, 0x1a, 0xc2, 0x25, 0xcb, 0x71, 0x4a, 0x1b, 0x86, 0x11, 0x01, 0xf3,
-	0xd7, 0xe1, 0x1b, 0x09, 0x2d, 0x0d, 0x12, 0x9d, 0xa2, 0xe0, 0xe8, 0xbf, 0xa5, 0xe0, 0x1e, 0x7b,
-	0x40, 0xcd, 0x92, 0xa6, 0xdb, 0x1b, 0x76, 0x85, 0xa3, 0x76, 0x15, 0x7c, 0x96, 0xa0, 0x20, 0x29,
-	0x1a, 0x14, 0xbc, 0x8f, 0x86, 0xf9, 0xd2, 0xf9, 0xec, 0x57, 0xd2, 0xd9, 0xc7, 0x51, 0xa2, 0x87,
-	0x90, 0x40, 0x22, 0x2a, 0x40, 0x92, 0x39, 0x34, 0x13, 0x9b, 0xcc, 0x5a, 0x43, 0x37, 0x37, 0xaa,
-	0x55, 0xd6, 0x34, 0x5d, 0x9f, 0x32, 0x45, 0xb3, 0xfd, 0xc3, 0x80, 0xeb, 0x4d, 0x74, 0x4a, 0xf3,
-	0xde, 0x97, 0x35, 0x31, 0x00, 0x9d, 0x3e, 0xd1, 0x69, 0xe7, 0xcf, 0x0b, 0x02, 0x3d, 0xc3, 0x44,
-	0x3d, 0xa9, 0x85, 0x60, 0xc8, 0x22, 0x5a, 0x88, 0x96, 0xd9, 0xa4, 0xbb, 0xd4, 0x60, 0x16, 0xb5,
-	0x23, 0x8c, 0x9a, 0xf1, 0xde, 0x88, 0x87, 0x02, 0xab, 0x2d, 0x74, 0xb6, 0xe6, 0x8f, 0x45, 0x98,
-	0x4d, 0x75, 0xda, 0xf9, 0x09, 0xff, 0x0c, 0x8a, 0x84, 0x10, 0xf5, 0x4c, 0x2d, 0x02, 0x49, 0x66,
-	0xe3, 0xa7, 0x40, 0x89, 0x31, 0xe3, 0x5d, 0xaa, 0xd7, 0xef, 0x07, 0x67, 0xc5, 0x17, 0x52, 0x7c,
-	0x5a, 0x7b, 0xc2, 0x80, 0x18, 0x45, 0x27, 0x2d, 0xc6, 0x8c, 0xf2, 0x47, 0xe2, 0x3d, 0x34, 0xd8,
-	0x5c, 0x9f, 0x9b, 0x25, 0x00, 0x51, 0x2e, 0xc2, 0xca, 0x9e, 0x83, 0xe3, 0x23, 0x04, 0x44, 0xd4,
-	0x51, 0x2b, 0x88, 0x24, 0x05, 0xb4, 0x12, 0x65, 0xf3, 0xa6, 0xb6, 0xe7, 0x61, 0x95, 0x98, 0x6e,
-	0xba, 0x4e, 0x89, 0xda, 0x8a, 0xc1, 0xaa, 0x0f, 0x7c, 0xfa, 0x5f, 0x4a, 0x68, 0x75, 0xc0, 0x04,
-	0x10, 0xf2, 0x01, 0x9a, 0x6c, 0x68, 0x7b, 0x65, 0xce, 0xc1, 0xe2, 0x21, 0x65, 0x6f, 0x22, 0x2b,
-	0x5e, 0x10, 0x57, 0x75, 0x4c, 0x99, 0xed, 0xb4, 0xf3, 0xd3, 0x82, 0x6a, 0x6a, 0x28, 0x51, 0xc7,
-	0x1a, 0x49, 0x75, 0x92, 0xfa, 0x2b, 0x4a, 0xe8, 0xde, 0x9e, 0x4f, 0xff, 0x93, 0x84, 0xfe, 0x4a,
-	0x8a, 0x06, 0xee, 0xef, 0xa0, 0xf1, 0x24, 0x42, 0xee, 0x1e, 0x10, 0xbf, 0xdc, 0x69, 0xe7, 0x2f,
-	0xa5, 0x13, 0x77, 0xf7, 0x88, 0x8a, 0x1b, 0x31, 0xf8, 0xa4, 0x4b, 0x45, 0xd1, 0x1c, 0xca, 0xef,
-	0xaf, 0xee, 0x46, 0xf9, 0x54, 0x8a, 0xef, 0xa7, 0x70, 0x14, 0x50, 0xfc, 0x10, 0x8d, 0x7a, 0xd7,
-	0x47, 0x99, 0x5f, 0x8f, 0xfe, 0x39, 0x30, 0x93, 0xbe, 0x4d, 0xba, 0x10, 0x8a, 0x0c, 0x9b, 0x04,
-	0x0b, 0x01, 0x21, 0x14, 0xa2, 0xa2, 0x4a, 0xb7, 0x12, 0x99, 0x46, 0xb9, 0x28, 0x8f, 0xd7, 0x4d,
-	0xad, 0x62, 0xd0, 0x9a, 0x4f, 0x75, 0x1b, 0xe5, 0x53, 0x23, 0x80, 0xe6, 0x0a, 0x1a, 0xa1, 0xe2,
-	0x15, 0x9f, 0xba, 0xff, 0x28, 0x38, 0xb8, 0xdd, 0x60, 0x80, 0xa8, 0x7e, 0x88, 0xd7, 0x24, 0x17,
-	0x93, 0x9a, 0xc4, 0xbf, 0xd1, 0xd6, 0x11, 0x0a, 0xe8, 0x42, 0xbb, 0x8e, 0x05, 0x47, 0x71, 0x30,
-	0x46, 0xd4, 0x13, 0x5d, 0x25, 0xf8, 0x06, 0x1a, 0x65, 0xee, 0x7d, 0x6a, 0x43, 0xda, 0x11, 0x9e,
-	0x36, 0x1e, 0xcc, 0x40, 0x68, 0x90, 0xa8, 0x88, 0x3f, 0xf1, 0x44, 0x72, 0x07, 0x4d, 0x25, 0xb3,
-	0x01, 0x71, 0xcb, 0x68, 0x84, 0x2f, 0xbd, 0x5e, 0x83, 0x7d, 0x11, 0x12, 0x07, 0x03, 0x9e, 0xa3,
-	0x60, 0xcc, 0xd8, 0xaa, 0x5d, 0xfd, 0xeb, 0x02, 0x3a, 0xce, 0xd1, 0xf0, 0xe7, 0x12, 0x1a, 0x16,
-	0x7e, 0x10, 0xf7, 0x39, 0xb8, 0xe3, 0x36, 0x54, 0x5e, 0x1d, 0x30, 0x5a, 0xd0, 0x23, 0xb3, 0x1f,
-	0xff, 0xfa, 0xe7, 0xb7, 0x47, 0x72, 0x78, 0xaa, 0xe8, 0xbb, 0xe3, 0xdd, 0xb5, 0xf5, 0xc0, 0x21,
-	0x0b, 0xcf, 0x89, 0x7f, 0x91, 0xd0, 0x64, 0xaa, 0x8b, 0xc4, 0xaf, 0xee, 0x53, 0x72, 0x3f, 0xa7,
-	0x2a, 0xbf, 0x76, 0x70, 0x00, 0x90, 0x51, 0xe0, 0x32, 0xae, 0xe0, 0xf9, 0x64, 0x19, 0x51, 0x33,
-	0x1a, 0x15, 0xd4, 0x6b, 0x13, 0xb3, 0x08, 0x4a, 0x74, 0xac, 0x59, 0x04, 0x25, 0x3b, 0xd4, 0xfd,
-	0x04, 0x81, 0xd5, 0x2b, 0x57, 0x5a, 0x62, 0x4f, 0xe2, 0x1f, 0x25, 0x34, 0x96, 0x68, 0x31, 0xf1,
-	0x4b, 0x83, 0x73, 0x89, 0xb9, 0x57, 0xf9, 0xe5, 0x83, 0x25, 0x83, 0x88, 0x45, 0x2e, 0x62, 0x06,
-	0x5f, 0x4e, 0x16, 0xa1, 0x19, 0x46, 0x19, 0x84, 0xe0, 0x27, 0x12, 0x9a, 0xea, 0x67, 0x2d, 0xb1,
-	0x32, 0x38, 0x93, 0x34, 0xb3, 0x2b, 0xdf, 0x3a, 0x14, 0x06, 0x88, 0x5a, 0xe3, 0xa2, 0x96, 0xf1,
-	0x62, 0xb2, 0xa8, 0xc0, 0xdd, 0x79, 0x8b, 0xc3, 0xed, 0x12, 0x6e, 0x4b, 0xe8, 0x52, 0x5f, 0xdb,
-	0x89, 0x6f, 0x65, 0x9a, 0xe7, 0x64, 0x8b, 0x2b, 0x6f, 0x1e, 0x0e, 0x04, 0xf4, 0x5d, 0xe5, 0xfa,
-	0x56, 0xf0, 0x52, 0xfa, 0xa2, 0x71, 0x55, 0xe5, 0x40, 0x29, 0xfe, 0xa3, 0x57, 0x60, 0xdc, 0x4f,
-	0x66, 0x11, 0x98, 0xea, 0x80, 0xb3, 0x08, 0x4c, 0x37, 0xc6, 0xe4, 0x1a, 0x17, 0xb8, 0x8a, 0x97,
-	0x93, 0x05, 0xba, 0x5e, 0x66, 0xd9, 0xd2, 0x74, 0xbb, 0xac, 0xd9, 0x15, 0xa1, 0xd5, 0xc1, 0x3f,
-	0x4b, 0xe8, 0x42, 0x8a, 0x8b, 0xc5, 0x37, 0x33, 0xcc, 0x7b, 0xdc, 0x24, 0xcb, 0xaf, 0x1c, 0x34,
-	0x1d, 0xf4, 0x2c, 0x73, 0x3d, 0x73, 0x78, 0x26, 0x65, 0xc1, 0xc2, 0xce, 0x19, 0xff, 0x26, 0xa1,
-	0x8b, 0x7d, 0xbc, 0x2f, 0xde, 0x18, 0x9c, 0x4c, 0x8a, 0xc5, 0x96, 0x95, 0xc3, 0x40, 0x80, 0xa6,
-	0x22, 0xd7, 0xb4, 0x88, 0x17, 0x92, 0x35, 0xc5, 0x3c, 0x37, 0xfe, 0x49, 0x42, 0xe3, 0xc9, 0xae,
-	0x19, 0x67, 0x38, 0xc3, 0xe2, 0x9e, 0x5c, 0xbe, 0x79, 0xc0, 0x6c, 0x10, 0xb2, 0xc4, 0x85, 0xcc,
-	0x62, 0x92, 0x72, 0x8e, 0x87, 0xdc, 0x37, 0x7e, 0xda, 0xdb, 0x45, 0x71, 0xef, 0x99, 0xa5, 0x8b,
-	0x52, 0x7d, 0x6e, 0x96, 0x2e, 0x4a, 0xb7, 0xbf, 0x64, 0x9d, 0x0b, 0x2b, 0xe0, 0x95, 0x64, 0x61,
-	0xc9, 0x96, 0x17, 0xff, 0x2d, 0xa1, 0xe9, 0xfd, 0xbe, 0x0e, 0xf0, 0x1b, 0x07, 0x27, 0x18, 0xfe,
-	0x1e, 0x91, 0x6f, 0x1f, 0x1a, 0x07, 0xb4, 0xde, 0xe0, 0x5a, 0xd7, 0x70, 0x71, 0x70, 0xad, 0xfc,
-	0xbb, 0x24, 0x7a, 0x2b, 0x07, 0x16, 0x3d, 0xcb, 0xad, 0x1c, 0xb3, 0xff, 0x59, 0x6e, 0xe5, 0xf8,
-	0x57, 0xc1, 0x7e, 0xb7, 0x72, 0xc8, 0xeb, 0xe3, 0x1f, 0x24, 0x84, 0xe3, 0xc6, 0x1d, 0xff, 0x7f,
-	0xf0, 0xfa, 0xbd, 0x5f, 0x03, 0xf2, 0x0b, 0x07, 0xc8, 0x04, 0xda, 0x73, 0x9c, 0x76, 0x1e, 0x5f,
-	0x4a, 0xa6, 0x0d, 0x9f, 0x07, 0xf8, 0x7b, 0x09, 0xfd, 0x37, 0xd2, 0x93, 0xf8, 0x7f, 0xd9, 0x7a,
-	0xd8, 0x27, 0x7b, 0x3d, 0x6b, 0x1a, 0x30, 0x25, 0x9c, 0xe9, 0x14, 0x96, 0xd3, 0x7b, 0x5e, 0xb9,
-	0xfb, 0xe8, 0x59, 0x4e, 0x7a, 0xfc, 0x2c, 0x27, 0x3d, 0x7d, 0x96, 0x93, 0xbe, 0x7a, 0x9e, 0x1b,
-	0x7a, 0xfc, 0x3c, 0x37, 0xf4, 0xfb, 0xf3, 0xdc, 0xd0, 0x7b, 0xeb, 0xa1, 0xff, 0xb4, 0x42, 0xfe,
-	0xaa, 0xa1, 0x55, 0x9c, 0x10, 0xd8, 0xf5, 0xe2, 0x5e, 0xe8, 0xbe, 0x6a, 0x59, 0xd4, 0xa9, 0x0c,
-	0xf3, 0xe7, 0x6b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x99, 0xf3, 0x62, 0x86, 0xac, 0x17, 0x00,
-	0x00,
+func (m *QueryGetProtoRevDistributedProfitsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevDistributedProfitsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevDistributedProfitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{30}
+}
+func (m *QueryGetProtoRevDistributedProfitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDistributedProfitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDistributedProfitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevDistributedProfitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDistributedProfitsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevDistributedProfitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDistributedProfitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDistributedProfitsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetProtoRevDistributedProfitsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+// QueryGetProtoRevDistributedProfitsResponse is response type for the
+// Query/GetProtoRevDistributedProfits RPC method.
+type QueryGetProtoRevDistributedProfitsResponse struct {
+	// distributed_profits is the list of profits distributed per destination
+	DistributedProfits []DistributedProfits `protobuf:"bytes,1,rep,name=distributed_profits,json=distributedProfits,proto3" json:"distributed_profits" yaml:"distributed_profits"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetProtoRevDistributedProfitsResponse) Reset() {
+	*m = QueryGetProtoRevDistributedProfitsResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetProtoRevDistributedProfitsResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*QueryGetProtoRevDistributedProfitsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevDistributedProfitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{31}
 }
-
-func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	out := new(QueryGetProtoRevNumberOfTradesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevDistributedProfitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDistributedProfitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDistributedProfitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevDistributedProfitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDistributedProfitsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevDistributedProfitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDistributedProfitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDistributedProfitsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	out := new(QueryGetProtoRevProfitsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevDistributedProfitsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevDistributedProfitsResponse) GetDistributedProfits() []DistributedProfits {
+	if m != nil {
+		return m.DistributedProfits
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
-	out := new(QueryGetProtoRevAllProfitsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevEpochStatisticsByDenomRequest is request type for the
+// Query/GetProtoRevEpochStatisticsByDenom RPC method.
+type QueryGetProtoRevEpochStatisticsByDenomRequest struct {
+	// denom is the base denom to query statistics by, all base denoms are
+	// returned if empty
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// from_epoch is the first epoch of the range (inclusive)
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty" yaml:"from_epoch"`
+	// to_epoch is the last epoch of the range (inclusive), the current epoch is
+	// used if zero
+	ToEpoch uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty" yaml:"to_epoch"`
 }
 
-func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	out := new(QueryGetProtoRevStatisticsByRouteResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsByDenomRequest{}
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevEpochStatisticsByDenomRequest) ProtoMessage() {}
+func (*QueryGetProtoRevEpochStatisticsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
-	out := new(QueryGetProtoRevAdminAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	out := new(QueryGetProtoRevDeveloperAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevEpochStatisticsByDenomResponse is response type for the
+// Query/GetProtoRevEpochStatisticsByDenom RPC method.
+type QueryGetProtoRevEpochStatisticsByDenomResponse struct {
+	// statistics contains the statistics of each epoch and base denom in the
+	// range, ordered by epoch
+	Statistics []EpochDenomStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics" yaml:"statistics"`
 }
 
-func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	out := new(QueryGetProtoRevPoolWeightsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsByDenomResponse{}
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevEpochStatisticsByDenomResponse) ProtoMessage() {}
+func (*QueryGetProtoRevEpochStatisticsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) GetStatistics() []EpochDenomStatistics {
+	if m != nil {
+		return m.Statistics
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevEpochStatisticsByRouteRequest is request type for the
+// Query/GetProtoRevEpochStatisticsByRoute RPC method.
+type QueryGetProtoRevEpochStatisticsByRouteRequest struct {
+	// route is the set of pool ids to query statistics by i.e. 1,2,3, all routes
+	// are returned if empty
+	Route []uint64 `protobuf:"varint,1,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// from_epoch is the first epoch of the range (inclusive)
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty" yaml:"from_epoch"`
+	// to_epoch is the last epoch of the range (inclusive), the current epoch is
+	// used if zero
+	ToEpoch uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty" yaml:"to_epoch"`
 }
 
-func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	out := new(QueryGetProtoRevBaseDenomsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsByRouteRequest{}
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevEpochStatisticsByRouteRequest) ProtoMessage() {}
+func (*QueryGetProtoRevEpochStatisticsByRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
-	out := new(QueryGetProtoRevEnabledResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
-	out := new(QueryGetProtoRevPoolResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
-	// GetProtoRevPool queries the pool id used via the highest liquidity method
-	// for arbitrage route building given a pair of denominations
-	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetProtoRevEpochStatisticsByRouteResponse is response type for the
+// Query/GetProtoRevEpochStatisticsByRoute RPC method.
+type QueryGetProtoRevEpochStatisticsByRouteResponse struct {
+	// statistics contains the statistics of each epoch and route in the range,
+	// ordered by epoch
+	Statistics []EpochRouteStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics" yaml:"statistics"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) Reset() {
+	*m = QueryGetProtoRevEpochStatisticsByRouteResponse{}
 }
-func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
+func (*QueryGetProtoRevEpochStatisticsByRouteResponse) ProtoMessage() {}
+func (*QueryGetProtoRevEpochStatisticsByRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
 }
-func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+
+var xxx_messageInfo_QueryGetProtoRevEpochStatisticsByRouteResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) GetStatistics() []EpochRouteStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
+
+// QuerySimulateBackrunRequest is request type for the Query/SimulateBackrun RPC
+// method.
+type QuerySimulateBackrunRequest struct {
+	// pool_id is the id of the pool the hypothetical swap is made on
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// token_in is the token swapped into the pool
+	TokenIn types.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// token_out_denom is the denom of the token swapped out of the pool
+	TokenOutDenom string `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+
+func (m *QuerySimulateBackrunRequest) Reset()         { *m = QuerySimulateBackrunRequest{} }
+func (m *QuerySimulateBackrunRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBackrunRequest) ProtoMessage()    {}
+func (*QuerySimulateBackrunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+func (m *QuerySimulateBackrunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+func (m *QuerySimulateBackrunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBackrunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+func (m *QuerySimulateBackrunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBackrunRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
+func (m *QuerySimulateBackrunRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySimulateBackrunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBackrunRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySimulateBackrunRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateBackrunRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevNumberOfTradesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+func (m *QuerySimulateBackrunRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevProfitsByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+func (m *QuerySimulateBackrunRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllProfitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
+// QuerySimulateBackrunResponse is response type for the Query/SimulateBackrun
+// RPC method.
+type QuerySimulateBackrunResponse struct {
+	// routes are the cyclic arbitrage routes that were considered
+	Routes []SimulatedRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// best_route is the most profitable route, empty if no route is profitable
+	BestRoute []types1.SwapAmountInRoute `protobuf:"bytes,2,rep,name=best_route,json=bestRoute,proto3" json:"best_route" yaml:"best_route"`
+	// optimal_input is the amount in that maximises the profit of the best route
+	OptimalInput types.Coin `protobuf:"bytes,3,opt,name=optimal_input,json=optimalInput,proto3" json:"optimal_input" yaml:"optimal_input"`
+	// profit is the expected profit of the best route
+	Profit types.Coin `protobuf:"bytes,4,opt,name=profit,proto3" json:"profit" yaml:"profit"`
+	// osmo_profit is the expected profit of the best route denominated in uosmo,
+	// which is used to compare routes
+	OsmoProfit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=osmo_profit,json=osmoProfit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"osmo_profit" yaml:"osmo_profit"`
+}
+
+func (m *QuerySimulateBackrunResponse) Reset()         { *m = QuerySimulateBackrunResponse{} }
+func (m *QuerySimulateBackrunResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBackrunResponse) ProtoMessage()    {}
+func (*QuerySimulateBackrunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
+}
+func (m *QuerySimulateBackrunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBackrunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBackrunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
+}
+func (m *QuerySimulateBackrunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBackrunResponse.Merge(m, src)
+}
+func (m *QuerySimulateBackrunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBackrunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBackrunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateBackrunResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateBackrunResponse) GetRoutes() []SimulatedRoute {
+	if m != nil {
+		return m.Routes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+	return nil
+}
+
+func (m *QuerySimulateBackrunResponse) GetBestRoute() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.BestRoute
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevStatisticsByRouteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySimulateBackrunResponse) GetOptimalInput() types.Coin {
+	if m != nil {
+		return m.OptimalInput
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
+	return types.Coin{}
+}
+
+func (m *QuerySimulateBackrunResponse) GetProfit() types.Coin {
+	if m != nil {
+		return m.Profit
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
+// SimulatedRoute is a cyclic arbitrage route considered when simulating a
+// backrun
+type SimulatedRoute struct {
+	// route is the list of swaps of the route
+	Route []types1.SwapAmountInRoute `protobuf:"bytes,1,rep,name=route,proto3" json:"route" yaml:"route"`
+	// pool_points is the number of pool points the route consumes
+	PoolPoints uint64 `protobuf:"varint,2,opt,name=pool_points,json=poolPoints,proto3" json:"pool_points,omitempty" yaml:"pool_points"`
+	// step_size is the step size used to search for the optimal amount in
+	StepSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=step_size,json=stepSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"step_size" yaml:"step_size"`
+}
+
+func (m *SimulatedRoute) Reset()         { *m = SimulatedRoute{} }
+func (m *SimulatedRoute) String() string { return proto.CompactTextString(m) }
+func (*SimulatedRoute) ProtoMessage()    {}
+func (*SimulatedRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{38}
+}
+func (m *SimulatedRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
+}
+func (m *SimulatedRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedRoute.Merge(m, src)
+}
+func (m *SimulatedRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedRoute proto.InternalMessageInfo
+
+func (m *SimulatedRoute) GetRoute() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Route
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+	return nil
+}
+
+func (m *SimulatedRoute) GetPoolPoints() uint64 {
+	if m != nil {
+		return m.PoolPoints
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
+	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
+	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+	proto.RegisterType((*QueryGetProtoRevDistributedProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDistributedProfitsRequest")
+	proto.RegisterType((*QueryGetProtoRevDistributedProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDistributedProfitsResponse")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsByDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsByDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsByRouteRequest")
+	proto.RegisterType((*QueryGetProtoRevEpochStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEpochStatisticsByRouteResponse")
+	proto.RegisterType((*QuerySimulateBackrunRequest)(nil), "osmosis.protorev.v1beta1.QuerySimulateBackrunRequest")
+	proto.RegisterType((*QuerySimulateBackrunResponse)(nil), "osmosis.protorev.v1beta1.QuerySimulateBackrunResponse")
+	proto.RegisterType((*SimulatedRoute)(nil), "osmosis.protorev.v1beta1.SimulatedRoute")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
+}
+
+var fileDescriptor_f5e7ac9973cce389 = []byte{
+	// 2081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5b, 0x6f, 0xdc, 0xc6,
+	0x15, 0x36, 0x2d, 0x5b, 0xb2, 0x8f, 0x7c, 0x1d, 0xdb, 0xb2, 0x4c, 0xcb, 0xbb, 0xf2, 0xe8, 0x62,
+	0xc9, 0x92, 0x76, 0x21, 0xc7, 0xb5, 0x7b, 0x89, 0xdb, 0x88, 0x56, 0x1a, 0x0b, 0x41, 0x22, 0x95,
+	0x76, 0x11, 0x20, 0x2d, 0xca, 0x72, 0xb5, 0x23, 0x89, 0xd0, 0x2e, 0x87, 0x26, 0xb9, 0xb2, 0x94,
+	0xb7, 0xb6, 0x40, 0x80, 0xa2, 0x05, 0x7a, 0x7b, 0x2c, 0xfa, 0xd6, 0x1f, 0xe0, 0x3f, 0x90, 0x87,
+	0x3e, 0x14, 0xc8, 0x53, 0x11, 0xa0, 0x28, 0x50, 0x04, 0xc8, 0xd6, 0xb0, 0xfb, 0x58, 0xa0, 0x85,
+	0x7e, 0x41, 0xc1, 0x99, 0xc3, 0x25, 0x97, 0x97, 0xbd, 0x02, 0x45, 0x9f, 0x76, 0xc9, 0x39, 0xe7,
+	0x9b, 0xef, 0x3b, 0x33, 0x73, 0xe6, 0xf0, 0xc0, 0x2c, 0xf7, 0xea, 0xdc, 0xb3, 0xbc, 0xb2, 0xe3,
+	0x72, 0x9f, 0xbb, 0xec, 0xa0, 0x7c, 0xb0, 0x5a, 0x61, 0xbe, 0xb9, 0x5a, 0x7e, 0xde, 0x60, 0xee,
+	0x51, 0x49, 0xbc, 0x26, 0x93, 0x68, 0x55, 0x0a, 0xad, 0x4a, 0x68, 0xa5, 0x5e, 0xdd, 0xe5, 0xbb,
+	0x5c, 0xbc, 0x2d, 0x07, 0xff, 0xa4, 0x81, 0x3a, 0xb5, 0xcb, 0xf9, 0x6e, 0x8d, 0x95, 0x4d, 0xc7,
+	0x2a, 0x9b, 0xb6, 0xcd, 0x7d, 0xd3, 0xb7, 0xb8, 0x8d, 0xee, 0xea, 0xdd, 0x6d, 0x01, 0x57, 0xae,
+	0x98, 0x1e, 0x93, 0xd3, 0xb4, 0x26, 0x75, 0xcc, 0x5d, 0xcb, 0x16, 0xc6, 0x68, 0x3b, 0x97, 0xcb,
+	0xcf, 0x31, 0x5d, 0xb3, 0x1e, 0x42, 0xde, 0xc9, 0x37, 0x0b, 0x19, 0x4b, 0xc3, 0x42, 0x7c, 0xee,
+	0xd0, 0x66, 0x9b, 0x5b, 0xe1, 0x7c, 0xcb, 0x2d, 0x20, 0xce, 0x6b, 0x75, 0xd3, 0x36, 0x77, 0x99,
+	0xdb, 0xb2, 0xf3, 0x5e, 0x98, 0x8e, 0xe1, 0xf2, 0x86, 0xcf, 0xa4, 0x35, 0xbd, 0x0a, 0xe4, 0x7b,
+	0x01, 0xff, 0x2d, 0xc1, 0x45, 0x67, 0xcf, 0x1b, 0xcc, 0xf3, 0xe9, 0x0e, 0x5c, 0x69, 0x7b, 0xeb,
+	0x39, 0xdc, 0xf6, 0x18, 0xd9, 0x84, 0x51, 0xc9, 0x79, 0x52, 0x99, 0x56, 0x16, 0xc6, 0xef, 0x4d,
+	0x97, 0xf2, 0xa2, 0x5a, 0x92, 0x9e, 0xda, 0xb5, 0xcf, 0x9b, 0xc5, 0x13, 0xc7, 0xcd, 0xe2, 0xf9,
+	0x23, 0xb3, 0x5e, 0xfb, 0x26, 0x95, 0xde, 0x54, 0x47, 0x18, 0x7a, 0x07, 0xe6, 0xc4, 0x3c, 0xef,
+	0x31, 0x7f, 0x2b, 0x40, 0xd0, 0xd9, 0xc1, 0x87, 0x8d, 0x7a, 0x85, 0xb9, 0x9b, 0x3b, 0xcf, 0x5c,
+	0xb3, 0xca, 0x5a, 0x84, 0xfe, 0xa0, 0xc0, 0x7c, 0x37, 0x4b, 0x24, 0xe9, 0xc1, 0x25, 0x5b, 0x8c,
+	0x18, 0x7c, 0xc7, 0xf0, 0xc5, 0x98, 0xa0, 0x7b, 0x56, 0xdb, 0x08, 0xc8, 0x7c, 0xd9, 0x2c, 0xce,
+	0xef, 0x5a, 0xfe, 0x5e, 0xa3, 0x52, 0xda, 0xe6, 0xf5, 0x32, 0x06, 0x53, 0xfe, 0xac, 0x78, 0xd5,
+	0xfd, 0xb2, 0x7f, 0xe4, 0x30, 0xaf, 0xb4, 0x61, 0xfb, 0xc7, 0xcd, 0xe2, 0x75, 0x49, 0x3b, 0x89,
+	0x47, 0xf5, 0x0b, 0x76, 0xdb, 0xe4, 0x74, 0x33, 0x2d, 0x64, 0xcb, 0xe5, 0x3b, 0x96, 0xef, 0x69,
+	0x47, 0xeb, 0xcc, 0xe6, 0x75, 0x14, 0x42, 0xe6, 0xe1, 0x74, 0x35, 0x78, 0x46, 0x4a, 0x97, 0x8e,
+	0x9b, 0xc5, 0x73, 0x72, 0x12, 0xf1, 0x9a, 0xea, 0x72, 0x98, 0xda, 0x30, 0xdf, 0x0d, 0x10, 0xf5,
+	0xae, 0xc3, 0xa8, 0x23, 0x46, 0x70, 0x51, 0x6e, 0x94, 0xa4, 0x98, 0x52, 0xb0, 0x41, 0x5a, 0xeb,
+	0xf1, 0x98, 0x5b, 0xb6, 0x76, 0x39, 0xb6, 0x12, 0xc2, 0x25, 0x58, 0x09, 0xf9, 0x67, 0x06, 0x6e,
+	0x27, 0xe7, 0x5b, 0xab, 0xd5, 0x70, 0xca, 0x70, 0x15, 0x9e, 0x03, 0xed, 0x64, 0x84, 0x84, 0xde,
+	0x87, 0x31, 0x09, 0x1a, 0xc4, 0x7d, 0xa4, 0x33, 0xa3, 0x09, 0xdc, 0x1f, 0x17, 0xe2, 0xac, 0x3c,
+	0xaa, 0x8f, 0xb5, 0xfe, 0xc1, 0x42, 0x72, 0xca, 0xa7, 0xc1, 0x59, 0xf4, 0x7c, 0x6b, 0xdb, 0xd3,
+	0x8e, 0xf4, 0x60, 0x2b, 0xc7, 0x62, 0x2b, 0xb6, 0xb6, 0x98, 0xf6, 0x54, 0x3c, 0xb6, 0xe2, 0x35,
+	0xd5, 0xe5, 0x30, 0xfd, 0x8d, 0x02, 0x8b, 0x3d, 0x80, 0xa2, 0x9c, 0x2a, 0x80, 0xd7, 0x1a, 0xc4,
+	0x18, 0x2f, 0xe6, 0x6f, 0x7c, 0xe1, 0x1c, 0x43, 0xbb, 0x81, 0x0a, 0x2f, 0x4b, 0x26, 0x11, 0x14,
+	0xd5, 0x63, 0xb8, 0x74, 0x29, 0x4d, 0x69, 0xad, 0x56, 0x4b, 0x80, 0x85, 0xeb, 0xf0, 0x5b, 0x05,
+	0xee, 0xf6, 0x62, 0x9d, 0xa3, 0x60, 0xe4, 0x7f, 0xa5, 0xe0, 0x19, 0xdf, 0x67, 0xf6, 0x96, 0x69,
+	0xb9, 0x6b, 0x6e, 0x45, 0xa0, 0xb6, 0x14, 0xfc, 0x3c, 0x43, 0x41, 0x96, 0x35, 0x2a, 0xf8, 0x01,
+	0x8c, 0x8a, 0xa5, 0x0b, 0xd9, 0x2f, 0xe7, 0xb3, 0x4f, 0xa3, 0x24, 0x93, 0x90, 0x44, 0xa2, 0x3a,
+	0x42, 0xd2, 0x39, 0x98, 0x49, 0x05, 0xb3, 0x5a, 0xb7, 0xec, 0xb5, 0xed, 0x6d, 0xde, 0xb0, 0xfd,
+	0x90, 0x32, 0x83, 0xd9, 0xce, 0x66, 0xc8, 0xf5, 0x11, 0x9c, 0x37, 0x83, 0xf7, 0x86, 0x29, 0x07,
+	0xf0, 0xa4, 0x4f, 0x1e, 0x37, 0x8b, 0x57, 0x25, 0x81, 0xb6, 0x61, 0xaa, 0x9f, 0x33, 0x63, 0x30,
+	0x74, 0x11, 0xee, 0x24, 0xa7, 0x59, 0x67, 0x07, 0xac, 0xc6, 0x1d, 0xe6, 0x26, 0x18, 0x35, 0x60,
+	0xa1, 0xbb, 0x29, 0xb2, 0xda, 0x80, 0xcb, 0xd5, 0x70, 0x2c, 0xc1, 0x6c, 0xea, 0xb8, 0x59, 0x9c,
+	0x0c, 0x73, 0x50, 0xc2, 0x84, 0xea, 0x97, 0xaa, 0x09, 0x48, 0x3a, 0x9b, 0xce, 0x02, 0x5b, 0x9c,
+	0xd7, 0x3e, 0x62, 0xd6, 0xee, 0x5e, 0x94, 0x2b, 0x7e, 0xa9, 0xc0, 0x4c, 0x47, 0x33, 0x24, 0xc6,
+	0xe0, 0x5c, 0x70, 0x51, 0x19, 0x2f, 0xe4, 0x7b, 0x3c, 0x60, 0x73, 0x1d, 0x6e, 0x96, 0x08, 0x44,
+	0xbb, 0x89, 0x2b, 0x7b, 0x05, 0xd3, 0x47, 0x0c, 0x88, 0xea, 0xe3, 0x4e, 0x64, 0x49, 0x4b, 0xb0,
+	0x9c, 0x64, 0xf3, 0x81, 0x79, 0x18, 0x60, 0x6d, 0x71, 0xcb, 0xf6, 0xbd, 0x2d, 0xe6, 0x6a, 0x35,
+	0xbe, 0xbd, 0x1f, 0xd2, 0xff, 0x95, 0x02, 0x2b, 0x3d, 0x3a, 0xa0, 0x90, 0x1f, 0xc1, 0x8d, 0xba,
+	0x79, 0x68, 0x08, 0x0e, 0x8e, 0x30, 0x31, 0x82, 0x40, 0x56, 0x02, 0x23, 0xa1, 0xea, 0x94, 0x36,
+	0x7b, 0xdc, 0x2c, 0x4e, 0x4b, 0xaa, 0xb9, 0xa6, 0x54, 0xbf, 0x56, 0xcf, 0x9a, 0x27, 0xeb, 0x7c,
+	0x25, 0x09, 0x3d, 0x3b, 0x0c, 0xe9, 0xff, 0x2c, 0xe3, 0x7c, 0x65, 0x59, 0x23, 0xf7, 0xef, 0xc3,
+	0x44, 0x16, 0x21, 0xff, 0x10, 0x89, 0xdf, 0x3e, 0x6e, 0x16, 0x6f, 0xe5, 0x13, 0xf7, 0x0f, 0xa9,
+	0x4e, 0xea, 0x29, 0xf8, 0xac, 0x4b, 0x45, 0x33, 0x3d, 0x26, 0xee, 0xaf, 0xd6, 0x46, 0xf9, 0x54,
+	0x01, 0xda, 0xc9, 0x0a, 0x29, 0xfe, 0x18, 0xc6, 0x83, 0xeb, 0xc3, 0x10, 0xd7, 0x63, 0x98, 0x07,
+	0x66, 0xf2, 0xb7, 0x49, 0x0b, 0x42, 0x53, 0x71, 0x93, 0x10, 0x29, 0x20, 0x86, 0x42, 0x75, 0xa8,
+	0xb4, 0x66, 0xa2, 0xd3, 0x50, 0x48, 0xf2, 0x78, 0xd7, 0x36, 0x2b, 0x35, 0x56, 0x0d, 0xa9, 0x6e,
+	0x42, 0x31, 0xd7, 0x02, 0x69, 0x2e, 0xc3, 0x18, 0x93, 0xaf, 0x44, 0xe8, 0xce, 0x68, 0x24, 0xba,
+	0xdd, 0x70, 0x80, 0xea, 0xa1, 0x49, 0x70, 0x48, 0x6e, 0x66, 0x1d, 0x92, 0xf0, 0x46, 0xbb, 0x0f,
+	0x10, 0xd1, 0xc5, 0xe3, 0x7a, 0x2d, 0x4a, 0xc5, 0xd1, 0x18, 0xd5, 0xcf, 0xb6, 0x94, 0x90, 0x87,
+	0x30, 0xce, 0xfd, 0x3d, 0xe6, 0xa2, 0xdb, 0x49, 0xe1, 0x36, 0x11, 0x45, 0x20, 0x36, 0x48, 0x75,
+	0x10, 0x4f, 0xc2, 0x91, 0xbe, 0x0f, 0x53, 0xd9, 0x6c, 0x50, 0xdc, 0x12, 0x8c, 0x89, 0xa5, 0xb7,
+	0xaa, 0xb8, 0x2f, 0x62, 0xe2, 0x70, 0x20, 0xa8, 0x28, 0x38, 0xaf, 0x6d, 0x54, 0xb3, 0xf6, 0xeb,
+	0xba, 0xe5, 0xf9, 0xae, 0x55, 0x69, 0xf8, 0xac, 0x9a, 0xa8, 0x2c, 0x5e, 0x66, 0xec, 0xd7, 0x2c,
+	0x6b, 0x24, 0xf2, 0x13, 0x05, 0xae, 0x54, 0xa3, 0x61, 0xa3, 0xbd, 0xde, 0xe8, 0x70, 0x3b, 0xa4,
+	0x31, 0x35, 0x8a, 0xdb, 0x43, 0xc5, 0x14, 0x98, 0x86, 0xa5, 0x3a, 0xa9, 0xa6, 0xfc, 0xe8, 0x67,
+	0x19, 0x19, 0xe2, 0x5d, 0x87, 0x6f, 0xef, 0xc5, 0x4b, 0x89, 0x41, 0x6a, 0xbf, 0x60, 0xd5, 0x77,
+	0x5c, 0x5e, 0x37, 0x58, 0x80, 0x26, 0x96, 0xef, 0x54, 0x7c, 0xd5, 0xa3, 0x31, 0xaa, 0x9f, 0x0d,
+	0x1e, 0xc4, 0xac, 0xa4, 0x04, 0x67, 0x7c, 0x8e, 0x3e, 0x23, 0xc2, 0xe7, 0xca, 0x71, 0xb3, 0x78,
+	0x51, 0xfa, 0x84, 0x23, 0x54, 0x1f, 0xf3, 0xb9, 0xb0, 0xa7, 0xbf, 0x57, 0xa0, 0xd4, 0x2b, 0x7f,
+	0x0c, 0xbb, 0x95, 0x51, 0x48, 0x94, 0xf2, 0x83, 0x2d, 0xd0, 0x04, 0x42, 0xff, 0xd5, 0x44, 0x4f,
+	0xd1, 0x1d, 0xa4, 0xfa, 0xfb, 0x7f, 0x8a, 0x6e, 0x7b, 0xa1, 0x39, 0x48, 0x74, 0x07, 0xac, 0xd5,
+	0xbe, 0x0a, 0xf3, 0xce, 0x53, 0xab, 0xde, 0xa8, 0x99, 0x3e, 0xd3, 0xcc, 0xed, 0x7d, 0xb7, 0x61,
+	0x87, 0xb1, 0xec, 0xe7, 0xa0, 0x93, 0x0f, 0x82, 0xd0, 0xec, 0x33, 0xdb, 0xb0, 0x6c, 0x11, 0xce,
+	0x8e, 0x05, 0xff, 0x75, 0x24, 0xd8, 0x8a, 0x9c, 0x74, 0x14, 0x91, 0xdb, 0x67, 0xf6, 0x86, 0x4d,
+	0x34, 0xb8, 0x28, 0xdf, 0xf2, 0x86, 0x8f, 0x19, 0x6c, 0x44, 0x9c, 0x17, 0xf5, 0xb8, 0x59, 0x9c,
+	0x88, 0xbb, 0xb5, 0x0c, 0xa8, 0x7e, 0x5e, 0xbc, 0xd9, 0x6c, 0xf8, 0x32, 0x91, 0xfd, 0x67, 0x04,
+	0xa6, 0xb2, 0xf5, 0x61, 0xac, 0x3f, 0x4a, 0x14, 0x94, 0x0b, 0xf9, 0x71, 0x0e, 0x21, 0xaa, 0x22,
+	0xd6, 0x5d, 0x8a, 0x49, 0xb2, 0x07, 0x50, 0x61, 0x9e, 0x2f, 0xbf, 0xb1, 0x27, 0x4f, 0x26, 0x17,
+	0x31, 0xfa, 0x24, 0x8f, 0xf0, 0x5f, 0x98, 0xce, 0x5a, 0x3d, 0xa8, 0xac, 0x36, 0x6c, 0x39, 0x45,
+	0x62, 0x11, 0x23, 0xbc, 0x20, 0xcb, 0x33, 0xcf, 0x17, 0x56, 0xe4, 0x87, 0x70, 0x9e, 0x3b, 0xbe,
+	0x55, 0x37, 0x6b, 0x86, 0x65, 0x3b, 0x0d, 0x7f, 0x72, 0xa4, 0x5b, 0xec, 0xa7, 0x10, 0x17, 0xcb,
+	0xd0, 0x36, 0x6f, 0xaa, 0x9f, 0xc3, 0xe7, 0x8d, 0xe0, 0x91, 0x3c, 0x69, 0x7d, 0x55, 0x9e, 0xea,
+	0x06, 0x9b, 0xfc, 0xc6, 0x6f, 0xff, 0xb2, 0x24, 0x0c, 0xc6, 0x03, 0x4f, 0x4c, 0xa6, 0x93, 0xa7,
+	0xc5, 0x5a, 0xae, 0xf7, 0xfd, 0x29, 0x1e, 0xde, 0x5d, 0x11, 0x54, 0x70, 0x77, 0x79, 0x75, 0x2e,
+	0xf3, 0x31, 0xfd, 0xf4, 0x24, 0x5c, 0x68, 0x5f, 0x2a, 0xf2, 0x71, 0x3c, 0x23, 0xf4, 0xbf, 0x0c,
+	0x57, 0x51, 0x57, 0x66, 0x16, 0x79, 0x08, 0xe3, 0xb1, 0x2a, 0x08, 0xd3, 0x48, 0xec, 0x8e, 0x8d,
+	0x0d, 0x52, 0x1d, 0x9c, 0x56, 0x61, 0x44, 0x0c, 0x38, 0xeb, 0xf9, 0xcc, 0x31, 0x3c, 0xeb, 0x13,
+	0x86, 0x1b, 0x5b, 0xeb, 0x3b, 0x18, 0x97, 0xc2, 0xe3, 0x8d, 0x40, 0x54, 0x3f, 0x13, 0xfc, 0x7f,
+	0x6a, 0x7d, 0xc2, 0xee, 0x35, 0x0b, 0x70, 0x5a, 0xec, 0x7d, 0xf2, 0x0b, 0x05, 0x46, 0x65, 0x1f,
+	0x86, 0x74, 0xb8, 0x12, 0xd3, 0xed, 0x1f, 0x75, 0xa5, 0x47, 0x6b, 0x79, 0x98, 0xe8, 0xec, 0x4f,
+	0xff, 0xfa, 0xcf, 0xdf, 0x9d, 0x2c, 0x90, 0xa9, 0x32, 0xba, 0x95, 0x0f, 0x56, 0xef, 0x47, 0x7d,
+	0x2c, 0xd9, 0xeb, 0x21, 0x7f, 0x51, 0xe0, 0x46, 0x6e, 0xf7, 0x86, 0x7c, 0xa7, 0xcb, 0x94, 0xdd,
+	0x3a, 0x44, 0xea, 0x3b, 0x83, 0x03, 0xa0, 0x8c, 0x92, 0x90, 0xb1, 0x40, 0xe6, 0xb3, 0x65, 0x24,
+	0x9b, 0x40, 0x49, 0x41, 0xed, 0xed, 0x99, 0x7e, 0x04, 0x65, 0x76, 0x8a, 0xd4, 0x77, 0x06, 0x07,
+	0xe8, 0x4d, 0x10, 0x56, 0x37, 0x46, 0xe5, 0x48, 0x66, 0x51, 0xf2, 0x99, 0x02, 0xd7, 0x32, 0x5b,
+	0x3b, 0xe4, 0x5b, 0xbd, 0x73, 0x49, 0x75, 0x8d, 0xd4, 0xb7, 0x07, 0x73, 0x46, 0x11, 0x8b, 0x42,
+	0xc4, 0x0c, 0xb9, 0x9d, 0x2d, 0xc2, 0xac, 0xd5, 0xc2, 0x32, 0x8d, 0x7c, 0xa9, 0xc0, 0x54, 0xa7,
+	0x96, 0x0e, 0xd1, 0x7a, 0x67, 0x92, 0x57, 0x66, 0xa8, 0x8f, 0x87, 0xc2, 0x40, 0x51, 0xab, 0x42,
+	0xd4, 0x12, 0x59, 0xcc, 0x16, 0x15, 0xdd, 0xd4, 0xc1, 0xe2, 0xc8, 0x84, 0xd3, 0x54, 0xe0, 0x56,
+	0xc7, 0x76, 0x0f, 0x79, 0xdc, 0x57, 0x9c, 0xb3, 0x5b, 0x4b, 0xea, 0xfa, 0x70, 0x20, 0xa8, 0xef,
+	0x9e, 0xd0, 0xb7, 0x4c, 0xee, 0xe6, 0x2f, 0x9a, 0x50, 0x65, 0x44, 0x4a, 0xc9, 0x3f, 0xda, 0x05,
+	0xa6, 0xfb, 0x38, 0xfd, 0x08, 0xcc, 0xed, 0x3c, 0xa9, 0xeb, 0xc3, 0x81, 0xa0, 0xc0, 0xb7, 0x84,
+	0xc0, 0x15, 0xb2, 0x94, 0x2d, 0x50, 0xd6, 0x27, 0x8e, 0x69, 0xb9, 0x86, 0xe9, 0x56, 0x0c, 0xac,
+	0x0d, 0xfe, 0xac, 0xc0, 0xf5, 0x9c, 0xee, 0x11, 0x79, 0xd4, 0x47, 0xdc, 0xd3, 0xcd, 0x29, 0xf5,
+	0xdb, 0x83, 0xba, 0xa3, 0x9e, 0x25, 0xa1, 0x67, 0x8e, 0xcc, 0xe4, 0x2c, 0x58, 0xbc, 0x63, 0x45,
+	0xfe, 0xa6, 0xc0, 0xcd, 0x0e, 0x3d, 0x27, 0xb2, 0xd6, 0x3b, 0x99, 0x9c, 0xd6, 0x96, 0xaa, 0x0d,
+	0x03, 0x81, 0x9a, 0xca, 0x42, 0xd3, 0x22, 0xb9, 0x93, 0xad, 0x29, 0xd5, 0xeb, 0x22, 0x7f, 0x52,
+	0x60, 0x22, 0xbb, 0x5b, 0x45, 0xfa, 0xc8, 0x61, 0xe9, 0x5e, 0x98, 0xfa, 0x68, 0x40, 0x6f, 0x14,
+	0x72, 0x57, 0x08, 0x99, 0x25, 0x34, 0x27, 0x8f, 0xc7, 0xba, 0x5e, 0xe4, 0x55, 0xfb, 0x29, 0x4a,
+	0xf7, 0x7c, 0xfa, 0x39, 0x45, 0xb9, 0xfd, 0x25, 0x75, 0x7d, 0x38, 0x10, 0x14, 0x76, 0x5f, 0x08,
+	0x2b, 0x91, 0xe5, 0x6c, 0x61, 0xd9, 0xad, 0x26, 0xf2, 0x2f, 0x05, 0xa6, 0xbb, 0x75, 0xe5, 0xc8,
+	0x77, 0x07, 0x27, 0x18, 0xef, 0x03, 0xaa, 0xef, 0x0d, 0x8d, 0x83, 0x5a, 0x1f, 0x0a, 0xad, 0xab,
+	0xa4, 0xdc, 0xbb, 0x56, 0xd1, 0x0f, 0x4c, 0xde, 0xca, 0x51, 0x6b, 0xac, 0x9f, 0x5b, 0x39, 0xd5,
+	0x76, 0x53, 0xdf, 0x1e, 0xcc, 0xb9, 0xb7, 0x5b, 0x39, 0xd6, 0x63, 0x23, 0x2f, 0x15, 0x20, 0xe9,
+	0x86, 0x19, 0xf9, 0x7a, 0xef, 0xf3, 0xb7, 0x77, 0xe1, 0xd4, 0x6f, 0x0c, 0xe0, 0x89, 0xb4, 0xe7,
+	0x04, 0xed, 0x22, 0xb9, 0x95, 0x4d, 0x1b, 0xdb, 0x72, 0xe4, 0x8f, 0x0a, 0x5c, 0x4c, 0x9c, 0x49,
+	0xf2, 0xb5, 0xfe, 0xce, 0x70, 0x48, 0xf6, 0x41, 0xbf, 0x6e, 0xc8, 0x94, 0x0a, 0xa6, 0x53, 0x44,
+	0xcd, 0x3f, 0xf3, 0xe4, 0xab, 0xf6, 0xb3, 0x9e, 0xee, 0x6d, 0xf5, 0x73, 0xd6, 0x73, 0x7b, 0x73,
+	0xea, 0xfa, 0x70, 0x20, 0xbd, 0x95, 0x3c, 0x19, 0x6d, 0x37, 0xf2, 0x6f, 0x05, 0x6e, 0x77, 0x6d,
+	0x4e, 0x91, 0x3e, 0x4e, 0x68, 0xc7, 0xf6, 0x9c, 0xfa, 0x64, 0x78, 0xa0, 0xde, 0xce, 0xba, 0xe8,
+	0x15, 0x19, 0xed, 0x45, 0x9e, 0xac, 0xc0, 0xbb, 0x29, 0x96, 0x65, 0xec, 0x30, 0x8a, 0xdb, 0x6a,
+	0xd9, 0x27, 0xc3, 0x03, 0x0d, 0xae, 0x58, 0x96, 0xb5, 0x2f, 0x15, 0xb8, 0x98, 0x68, 0xd2, 0x74,
+	0x3d, 0x6a, 0xd9, 0x4d, 0x2b, 0xf5, 0x41, 0xbf, 0x6e, 0xbd, 0x7d, 0x26, 0x79, 0xe8, 0x66, 0x54,
+	0xa4, 0x9f, 0xf6, 0xe1, 0xe7, 0xaf, 0x0b, 0xca, 0x17, 0xaf, 0x0b, 0xca, 0xab, 0xd7, 0x05, 0xe5,
+	0xd7, 0x6f, 0x0a, 0x27, 0xbe, 0x78, 0x53, 0x38, 0xf1, 0xf7, 0x37, 0x85, 0x13, 0x1f, 0xdf, 0x8f,
+	0x7d, 0xc0, 0x23, 0xd6, 0x4a, 0xcd, 0xac, 0x78, 0x31, 0xe0, 0x07, 0xe5, 0xc3, 0x08, 0x5a, 0x7c,
+	0xd2, 0x57, 0x46, 0xc5, 0xf3, 0x5b, 0xff, 0x1d, 0x00, 0x84, 0x9b, 0x95, 0xf4, 0xc9, 0x22, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevDistributedProfits queries the profits the module has
+	// distributed to each destination
+	GetProtoRevDistributedProfits(ctx context.Context, in *QueryGetProtoRevDistributedProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDistributedProfitsResponse, error)
+	// GetProtoRevEpochStatisticsByDenom queries the per epoch statistics of the
+	// trades executed with a base denom within a range of day epochs
+	GetProtoRevEpochStatisticsByDenom(ctx context.Context, in *QueryGetProtoRevEpochStatisticsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsByDenomResponse, error)
+	// GetProtoRevEpochStatisticsByRoute queries the per epoch statistics of the
+	// trades executed on a route within a range of day epochs
+	GetProtoRevEpochStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevEpochStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsByRouteResponse, error)
+	// SimulateBackrun simulates the backrun of a hypothetical swap without
+	// changing any state, returning the routes that would be considered, the
+	// most profitable route, its optimal amount in and its expected profit
+	SimulateBackrun(ctx context.Context, in *QuerySimulateBackrunRequest, opts ...grpc.CallOption) (*QuerySimulateBackrunResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAdminAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	out := new(QueryGetProtoRevNumberOfTradesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevDeveloperAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	out := new(QueryGetProtoRevProfitsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolWeightsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
+	out := new(QueryGetProtoRevAllProfitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	out := new(QueryGetProtoRevStatisticsByRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevBaseDenomsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevEnabledRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
+	out := new(QueryGetProtoRevAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	out := new(QueryGetProtoRevDeveloperAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.protorev.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetProtoRevNumberOfTrades",
-			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
-		},
-		{
-			MethodName: "GetProtoRevProfitsByDenom",
-			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllProfits",
-			Handler:    _Query_GetProtoRevAllProfits_Handler,
-		},
-		{
-			MethodName: "GetProtoRevStatisticsByRoute",
-			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllRouteStatistics",
-			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
-		},
-		{
-			MethodName: "GetProtoRevTokenPairArbRoutes",
-			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAdminAccount",
-			Handler:    _Query_GetProtoRevAdminAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevDeveloperAccount",
-			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPoolWeights",
-			Handler:    _Query_GetProtoRevPoolWeights_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerTx",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
-		},
-		{
-			MethodName: "GetProtoRevBaseDenoms",
-			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
-		},
-		{
-			MethodName: "GetProtoRevEnabled",
-			Handler:    _Query_GetProtoRevEnabled_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPool",
-			Handler:    _Query_GetProtoRevPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/protorev/v1beta1/query.proto",
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	out := new(QueryGetProtoRevPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	out := new(QueryGetProtoRevBaseDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
+	out := new(QueryGetProtoRevEnabledResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error) {
+	out := new(QueryGetProtoRevPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetProtoRevDistributedProfits(ctx context.Context, in *QueryGetProtoRevDistributedProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDistributedProfitsResponse, error) {
+	out := new(QueryGetProtoRevDistributedProfitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDistributedProfits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEpochStatisticsByDenom(ctx context.Context, in *QueryGetProtoRevEpochStatisticsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsByDenomResponse, error) {
+	out := new(QueryGetProtoRevEpochStatisticsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatisticsByDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevEpochStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevEpochStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEpochStatisticsByRouteResponse, error) {
+	out := new(QueryGetProtoRevEpochStatisticsByRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatisticsByRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NumberOfTrades.Size()
-		i -= size
-		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SimulateBackrun(ctx context.Context, in *QuerySimulateBackrunRequest, opts ...grpc.CallOption) (*QuerySimulateBackrunResponse, error) {
+	out := new(QuerySimulateBackrunResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/SimulateBackrun", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevPool queries the pool id used via the highest liquidity method
+	// for arbitrage route building given a pair of denominations
+	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevDistributedProfits queries the profits the module has
+	// distributed to each destination
+	GetProtoRevDistributedProfits(context.Context, *QueryGetProtoRevDistributedProfitsRequest) (*QueryGetProtoRevDistributedProfitsResponse, error)
+	// GetProtoRevEpochStatisticsByDenom queries the per epoch statistics of the
+	// trades executed with a base denom within a range of day epochs
+	GetProtoRevEpochStatisticsByDenom(context.Context, *QueryGetProtoRevEpochStatisticsByDenomRequest) (*QueryGetProtoRevEpochStatisticsByDenomResponse, error)
+	// GetProtoRevEpochStatisticsByRoute queries the per epoch statistics of the
+	// trades executed on a route within a range of day epochs
+	GetProtoRevEpochStatisticsByRoute(context.Context, *QueryGetProtoRevEpochStatisticsByRouteRequest) (*QueryGetProtoRevEpochStatisticsByRouteResponse, error)
+	// SimulateBackrun simulates the backrun of a hypothetical swap without
+	// changing any state, returning the routes that would be considered, the
+	// most profitable route, its optimal amount in and its expected profit
+	SimulateBackrun(context.Context, *QuerySimulateBackrunRequest) (*QuerySimulateBackrunResponse, error)
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
 }
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
 }
-
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Profit != nil {
-		{
-			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
 }
-
-func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
 }
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevDistributedProfits(ctx context.Context, req *QueryGetProtoRevDistributedProfitsRequest) (*QueryGetProtoRevDistributedProfitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDistributedProfits not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevEpochStatisticsByDenom(ctx context.Context, req *QueryGetProtoRevEpochStatisticsByDenomRequest) (*QueryGetProtoRevEpochStatisticsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEpochStatisticsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevEpochStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevEpochStatisticsByRouteRequest) (*QueryGetProtoRevEpochStatisticsByRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEpochStatisticsByRoute not implemented")
+}
+func (*UnimplementedQueryServer) SimulateBackrun(ctx context.Context, req *QuerySimulateBackrunRequest) (*QuerySimulateBackrunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBackrun not implemented")
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevNumberOfTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Profits) > 0 {
-		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllProfitsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		dAtA4 := make([]byte, len(m.Route)*10)
-		var j3 int
-		for _, num := range m.Route {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevStatisticsByRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAdminAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBaseDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEnabledRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPool(ctx, req.(*QueryGetProtoRevPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetProtoRevDistributedProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDistributedProfitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDistributedProfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDistributedProfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDistributedProfits(ctx, req.(*QueryGetProtoRevDistributedProfitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
+func _Query_GetProtoRevEpochStatisticsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEpochStatisticsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEpochStatisticsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatisticsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEpochStatisticsByDenom(ctx, req.(*QueryGetProtoRevEpochStatisticsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevEpochStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEpochStatisticsByRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEpochStatisticsByRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEpochStatisticsByRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEpochStatisticsByRoute(ctx, req.(*QueryGetProtoRevEpochStatisticsByRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateBackrun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBackrunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBackrun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/SimulateBackrun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBackrun(ctx, req.(*QuerySimulateBackrunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.protorev.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetProtoRevNumberOfTrades",
+			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitsByDenom",
+			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllProfits",
+			Handler:    _Query_GetProtoRevAllProfits_Handler,
+		},
+		{
+			MethodName: "GetProtoRevStatisticsByRoute",
+			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllRouteStatistics",
+			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTokenPairArbRoutes",
+			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAdminAccount",
+			Handler:    _Query_GetProtoRevAdminAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDeveloperAccount",
+			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPoolWeights",
+			Handler:    _Query_GetProtoRevPoolWeights_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerTx",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBaseDenoms",
+			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEnabled",
+			Handler:    _Query_GetProtoRevEnabled_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPool",
+			Handler:    _Query_GetProtoRevPool_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDistributedProfits",
+			Handler:    _Query_GetProtoRevDistributedProfits_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEpochStatisticsByDenom",
+			Handler:    _Query_GetProtoRevEpochStatisticsByDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEpochStatisticsByRoute",
+			Handler:    _Query_GetProtoRevEpochStatisticsByRoute_Handler,
+		},
+		{
+			MethodName: "SimulateBackrun",
+			Handler:    _Query_SimulateBackrun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/protorev/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2562,34 +2913,20 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2599,20 +2936,30 @@ func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2622,27 +2969,20 @@ func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AdminAccount) > 0 {
-		i -= len(m.AdminAccount)
-		copy(dAtA[i:], m.AdminAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2652,20 +2992,30 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2675,27 +3025,27 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DeveloperAccount) > 0 {
-		i -= len(m.DeveloperAccount)
-		copy(dAtA[i:], m.DeveloperAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2705,20 +3055,32 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Profit != nil {
+		{
+			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2728,30 +3090,57 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2761,20 +3150,38 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		dAtA4 := make([]byte, len(m.Route)*10)
+		var j3 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2784,25 +3191,30 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2812,12 +3224,12 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2825,7 +3237,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2835,25 +3247,34 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2863,12 +3284,12 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2876,7 +3297,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2886,20 +3307,20 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2913,7 +3334,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2923,12 +3344,12 @@ func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2936,7 +3357,7 @@ func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2946,30 +3367,27 @@ func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2979,34 +3397,20 @@ func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OtherDenom) > 0 {
-		i -= len(m.OtherDenom)
-		copy(dAtA[i:], m.OtherDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3016,387 +3420,2203 @@ func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Profit != nil {
-		l = m.Profit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Profits) > 0 {
-		for _, e := range m.Profits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		l = 0
-		for _, e := range m.Route {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Statistics.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for _, e := range m.Statistics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AdminAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxPoolPointsPerTx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DeveloperAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PoolWeights.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
-	}
-	return n
-}
+	if len(m.BaseDenoms) > 0 {
+		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.OtherDenom) > 0 {
+		i -= len(m.OtherDenom)
+		copy(dAtA[i:], m.OtherDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for _, e := range m.BaseDenoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevDistributedProfitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDistributedProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDistributedProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevDistributedProfitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDistributedProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDistributedProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		n += 2
+	if len(m.DistributedProfits) > 0 {
+		for iNdEx := len(m.DistributedProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.OtherDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		dAtA8 := make([]byte, len(m.Route)*10)
+		var j7 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBackrunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBackrunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBackrunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBackrunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBackrunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBackrunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoProfit.Size()
+		i -= size
+		if _, err := m.OsmoProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OptimalInput.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BestRoute) > 0 {
+		for iNdEx := len(m.BestRoute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestRoute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StepSize.Size()
+		i -= size
+		if _, err := m.StepSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolPoints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profit != nil {
+		l = m.Profit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolWeights.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for _, e := range m.BaseDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OtherDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDistributedProfitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDistributedProfitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DistributedProfits) > 0 {
+		for _, e := range m.DistributedProfits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateBackrunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBackrunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BestRoute) > 0 {
+		for _, e := range m.BestRoute {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.OptimalInput.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OsmoProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SimulatedRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PoolPoints != 0 {
+		n += 1 + sovQuery(uint64(m.PoolPoints))
+	}
+	l = m.StepSize.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profit == nil {
+				m.Profit = &types.Coin{}
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, RouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,10 +5639,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3446,7 +5666,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3469,15 +5689,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3504,7 +5724,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3529,7 +5750,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3552,10 +5773,10 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3579,7 +5800,7 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3602,15 +5823,15 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3638,9 +5859,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3663,7 +5882,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,44 +5905,12 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3745,7 +5932,7 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3768,17 +5955,17 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3788,27 +5975,23 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profit == nil {
-				m.Profit = &types.Coin{}
-			}
-			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3831,7 +6014,7 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3854,10 +6037,10 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3881,7 +6064,7 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3904,15 +6087,15 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3939,8 +6122,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profits = append(m.Profits, types.Coin{})
-			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3965,7 +6147,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,88 +6170,12 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Route = append(m.Route, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Route) == 0 {
-					m.Route = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Route = append(m.Route, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4091,7 +6197,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4114,17 +6220,17 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4134,25 +6240,11 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4174,7 +6266,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4197,10 +6289,10 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4224,7 +6316,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4247,17 +6339,17 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4267,26 +6359,11 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Statistics = append(m.Statistics, RouteStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4308,7 +6385,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,10 +6408,10 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4358,7 +6435,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,15 +6458,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4416,8 +6493,8 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4442,7 +6519,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4465,10 +6542,10 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4492,7 +6569,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4515,17 +6592,17 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4535,24 +6612,12 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdminAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4574,7 +6639,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4597,12 +6662,76 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4624,7 +6753,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4647,17 +6776,17 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4667,24 +6796,11 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4706,7 +6822,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDistributedProfitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4729,10 +6845,10 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDistributedProfitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDistributedProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4756,7 +6872,7 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDistributedProfitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4779,15 +6895,15 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDistributedProfitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDistributedProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedProfits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4814,7 +6930,8 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DistributedProfits = append(m.DistributedProfits, DistributedProfits{})
+			if err := m.DistributedProfits[len(m.DistributedProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4839,7 +6956,7 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4862,12 +6979,82 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4889,7 +7076,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4912,17 +7099,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4932,61 +7119,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Statistics = append(m.Statistics, EpochDenomStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5008,7 +7160,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5031,17 +7183,93 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByRouteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			m.FromEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5051,7 +7279,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5077,7 +7324,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEpochStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5100,12 +7347,46 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEpochStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, EpochRouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5127,7 +7408,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateBackrunRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5150,15 +7431,34 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateBackrunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateBackrunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5185,10 +7485,41 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5211,7 +7542,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateBackrunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5234,67 +7565,51 @@ func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateBackrunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateBackrunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Routes = append(m.Routes, SimulatedRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestRoute", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5304,67 +7619,64 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.BestRoute = append(m.BestRoute, types1.SwapAmountInRoute{})
+			if err := m.BestRoute[len(m.BestRoute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimalInput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OptimalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5374,27 +7686,28 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoProfit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5422,7 +7735,9 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.OsmoProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5445,7 +7760,7 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulatedRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5468,17 +7783,51 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, types1.SwapAmountInRoute{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPoints", wireType)
 			}
-			m.PoolId = 0
+			m.PoolPoints = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5488,11 +7837,45 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PoolPoints |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StepSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -49,7 +49,7 @@ func (pw *PoolWeights) Validate() error {
 		return fmt.Errorf("pool weights cannot be nil")
 	}
 
-	if pw.BalancerWeight == 0 || pw.StableWeight == 0 || pw.ConcentratedWeight == 0 {
+	if pw.BalancerWeight == 0 || pw.StableWeight == 0 || pw.ConcentratedWeight == 0 || pw.CosmwasmWeight == 0 {
 		return fmt.Errorf("pool weights cannot be 0")
 	}
 
@@ -92,3 +92,104 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ---------------------- Profit Distribution Validation ---------------------- //
+// ValidatePoolProfitsForDistribution validates the profits made on each pool since the last distribution.
+func ValidatePoolProfitsForDistribution(poolProfits []PoolProfitsForDistribution) error {
+	seenPoolProfits := make(map[string]bool)
+	for _, poolProfit := range poolProfits {
+		if err := poolProfit.Profit.Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the pool profit is unique per denom
+		key := fmt.Sprintf("%s|%d", poolProfit.Profit.Denom, poolProfit.PoolId)
+		if seenPoolProfits[key] {
+			return fmt.Errorf("duplicate profits for pool %d in denom %s", poolProfit.PoolId, poolProfit.Profit.Denom)
+		}
+		seenPoolProfits[key] = true
+	}
+
+	return nil
+}
+
+// ValidateDistributedProfits validates the profits distributed to each destination.
+func ValidateDistributedProfits(distributedProfits []DistributedProfits) error {
+	seenDestinations := make(map[string]bool)
+	for _, profits := range distributedProfits {
+		isDestination := false
+		for _, destination := range DistributionDestinations {
+			isDestination = isDestination || profits.Destination == destination
+		}
+		if !isDestination {
+			return fmt.Errorf("invalid profit distribution destination %s", profits.Destination)
+		}
+
+		// Ensure that the destination is unique
+		if seenDestinations[profits.Destination] {
+			return fmt.Errorf("duplicate distributed profits for destination %s", profits.Destination)
+		}
+		seenDestinations[profits.Destination] = true
+
+		if err := sdk.Coins(profits.Amounts).Validate(); err != nil {
+			return err
+		}
+
+		if profits.OsmoValue.IsNil() || profits.OsmoValue.IsNegative() {
+			return fmt.Errorf("distributed profits osmo value must be non-negative for destination %s", profits.Destination)
+		}
+	}
+
+	return nil
+}
+
+// ---------------------- Epoch Statistics Validation ---------------------- //
+// ValidateEpochDenomStatistics validates the per epoch statistics of the trades executed with each base denom.
+func ValidateEpochDenomStatistics(allStatistics []EpochDenomStatistics) error {
+	seenStatistics := make(map[string]bool)
+	for _, statistics := range allStatistics {
+		if err := sdk.ValidateDenom(statistics.Denom); err != nil {
+			return err
+		}
+
+		if statistics.Profit.IsNil() || statistics.Profit.IsNegative() || statistics.NumberOfTrades.IsNil() || statistics.NumberOfTrades.IsNegative() {
+			return fmt.Errorf("profit and number of trades must be non-negative for denom %s in epoch %d", statistics.Denom, statistics.Epoch)
+		}
+
+		// Ensure that the statistics are unique per epoch
+		key := fmt.Sprintf("%d|%s", statistics.Epoch, statistics.Denom)
+		if seenStatistics[key] {
+			return fmt.Errorf("duplicate statistics for denom %s in epoch %d", statistics.Denom, statistics.Epoch)
+		}
+		seenStatistics[key] = true
+	}
+
+	return nil
+}
+
+// ValidateEpochRouteStatistics validates the per epoch statistics of the trades executed on each route.
+func ValidateEpochRouteStatistics(allStatistics []EpochRouteStatistics) error {
+	seenStatistics := make(map[string]bool)
+	for _, statistics := range allStatistics {
+		if len(statistics.Route) == 0 {
+			return fmt.Errorf("route must not be empty in epoch %d", statistics.Epoch)
+		}
+
+		if err := sdk.Coins(statistics.Profits).Validate(); err != nil {
+			return err
+		}
+
+		if statistics.NumberOfTrades.IsNil() || statistics.NumberOfTrades.IsNegative() {
+			return fmt.Errorf("number of trades must be non-negative for route %d in epoch %d", statistics.Route, statistics.Epoch)
+		}
+
+		// Ensure that the statistics are unique per epoch
+		key := fmt.Sprintf("%d|%s", statistics.Epoch, CreateRouteKey(statistics.Route))
+		if seenStatistics[key] {
+			return fmt.Errorf("duplicate statistics for route %d in epoch %d", statistics.Route, statistics.Epoch)
+		}
+		seenStatistics[key] = true
+	}
+
+	return nil
+}
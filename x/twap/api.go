@@ -1,10 +1,13 @@
 package twap
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
@@ -18,8 +21,8 @@ import (
 // the state machine will interpolate the accumulator values for those times
 // from the latest Twap accumulation record prior to the provided time.
 //
-// startTime must be within 48 hours of ctx.BlockTime(), if you need older TWAPs,
-// you will have to maintain the accumulator yourself.
+// startTime must be within the pool's record history keep period (48 hours by default) of ctx.BlockTime(),
+// if you need older TWAPs, you will have to maintain the accumulator yourself.
 //
 // endTime will be set in the function ArithmeticTwap() to ctx.BlockTime() which calls GetArithmeticTwap function if:
 // * it is not provided externally
@@ -28,7 +31,7 @@ import (
 // This function will error if:
 // * startTime > endTime
 // * endTime in the future
-// * startTime older than 48 hours OR pool creation
+// * startTime older than the pool's record history keep period OR pool creation
 // * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
 // * there were some computational errors during computing arithmetic twap within the time range of
 //   startRecord, endRecord - including the exact record times, which indicates that the result returned could be faulty
@@ -78,6 +81,312 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetRealizedVolatility returns the realized volatility of the price of the base asset in the quote asset
+// from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+// The realized volatility is the square root of the sum of the squared natural logarithmic returns of the
+// spot price between consecutive TWAP record updates within the time range. It is not annualized,
+// so callers should scale it according to the length of the time range, e.g. by sqrt(year / (endTime - startTime)).
+// Since the returns of a price and of its inverse square to the same value, swapping the base and quote assets
+// does not change the result.
+//
+// The time range constraints and errors are the same as for GetArithmeticTwap.
+// If startTime equals endTime, the realized volatility is zero.
+func (k Keeper) GetRealizedVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if startTime.Equal(endTime) {
+		// There are no returns within an empty time range. The generic TWAP computation
+		// returns the spot price in that case, so we only rely on it to validate the inputs.
+		if _, err := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetArithmeticStrategy()); err != nil {
+			return sdk.Dec{}, err
+		}
+		return sdk.ZeroDec(), nil
+	}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetVolatilityStrategy())
+}
+
+// GetTimeWeightedLiquidity returns the time weighted liquidity of pool `poolId` from (startTime, endTime),
+// in units of the quote asset. The liquidity is the value of the pool's base and quote asset reserves,
+// with the base asset reserves valued at the spot price.
+// Unlike an instantaneous liquidity snapshot, it cannot be inflated by adding liquidity right before reading it.
+// Records stored before the liquidity accumulators were introduced have zero liquidity,
+// so time ranges starting before their introduction underestimate the liquidity.
+//
+// The time range constraints and errors are the same as for GetArithmeticTwap.
+// If startTime equals endTime, the liquidity at that time is returned.
+func (k Keeper) GetTimeWeightedLiquidity(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if startTime.Equal(endTime) {
+		// The generic TWAP computation returns the spot price over an empty time range,
+		// so we only rely on it to validate the inputs, and read the liquidity from the record.
+		if _, err := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetArithmeticStrategy()); err != nil {
+			return sdk.Dec{}, err
+		}
+		var record types.TwapRecord
+		var err error
+		if endTime.Equal(ctx.BlockTime()) {
+			record, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+		} else {
+			record, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+		}
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if quoteAssetDenom == record.Asset0Denom {
+			return record.P0LastLiquidity, nil
+		}
+		return record.P1LastLiquidity, nil
+	}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetLiquidityStrategy())
+}
+
+// GetVwap returns the volume weighted average price of the base asset, in units of the quote asset,
+// from (startTime, endTime], as determined by the swaps between the two assets in AMM pool `poolId`.
+// That is the amount of quote asset over the amount of base asset swapped in the pool within the time range,
+// so that prices are weighted by how much was traded at them, unlike TWAPs which weight them by time.
+// Only swaps reported by the pool swap listeners are accounted for. Records stored before the volume
+// accumulators were introduced have zero volumes, so time ranges spanning that time leave out the earlier swaps.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * startTime older than the pool's record history keep period OR pool creation
+// * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
+// * no base asset was swapped against the quote asset in the pool within the time range
+func (k Keeper) GetVwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if startTime.After(endTime) {
+		return sdk.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	}
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	vwap, hasVolume := computeVwap(startRecord, endRecord, quoteAssetDenom)
+	if !hasVolume {
+		return sdk.Dec{}, types.NoSwapVolumeError{
+			PoolId:     poolId,
+			BaseDenom:  baseAssetDenom,
+			QuoteDenom: quoteAssetDenom,
+			StartTime:  startTime,
+			EndTime:    endTime,
+		}
+	}
+	return vwap, nil
+}
+
+// GetAggregateTwap returns the arithmetic TWAP of the base asset, in units of the quote asset,
+// from (startTime, endTime), aggregated across multiple pools trading the pair.
+// Each pool's TWAP is weighted by the pool's time weighted liquidity of the pair over the time range,
+// valued in the quote asset, so that manipulating the price of a single thin pool has a limited effect
+// on the result, and so that the weights cannot be inflated by adding liquidity right before the query.
+//
+// If poolIds is empty, all the pools with TWAP records for the pair are aggregated, leaving out the ones
+// that fail to serve a TWAP for the time range (e.g. created after startTime, or with a spot price error
+// within the time range). Otherwise, every given pool must serve a TWAP for the time range, and if a spot
+// price error occurred within the time range in any of them, the aggregated TWAP is returned along with
+// ErrSpotPriceErrorInRange.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * a pool id is given more than once
+// * a given pool fails to serve the TWAP, for the reasons listed in GetArithmeticTwap
+// * the aggregated pools have no liquidity of the pair
+//
+// It also returns the ids of the aggregated pools.
+func (k Keeper) GetAggregateTwap(
+	ctx sdk.Context,
+	poolIds []uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, []uint64, error) {
+	if startTime.After(endTime) {
+		return sdk.Dec{}, nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, nil, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	skipFailedPools := len(poolIds) == 0
+	if skipFailedPools {
+		var err error
+		poolIds, err = k.getPoolIdsForDenomPair(ctx, baseAssetDenom, quoteAssetDenom)
+		if err != nil {
+			return sdk.Dec{}, nil, err
+		}
+	} else {
+		seenPoolIds := make(map[uint64]struct{}, len(poolIds))
+		for _, poolId := range poolIds {
+			if _, ok := seenPoolIds[poolId]; ok {
+				return sdk.Dec{}, nil, types.DuplicatePoolIdError{PoolId: poolId}
+			}
+			seenPoolIds[poolId] = struct{}{}
+		}
+	}
+
+	return k.getAggregateTwap(ctx, poolIds, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetArithmeticStrategy(), skipFailedPools)
+}
+
+// GetRouteTwap returns the geometric TWAP of the base asset, in units of the token out denom of the last hop
+// of the route, from (startTime, endTime).
+// The route is given the same way as for a multihop swap, e.g. ATOM -> OSMO -> USDC is
+// the base asset ATOM with the routes [(ATOM/OSMO pool, OSMO), (OSMO/USDC pool, USDC)].
+// The result is the product of the geometric TWAPs of every hop, each being the TWAP of the
+// hop's token in denom in units of its token out denom.
+//
+// The time range constraints and errors are the same as for GetGeometricTwap, for each hop.
+// If a spot price error occurred within the time range in any hop, the route TWAP is returned
+// along with ErrSpotPriceErrorInRange.
+// This function will also error if the route is empty or has an invalid token out denom.
+func (k Keeper) GetRouteTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []poolmanagertypes.SwapAmountInRoute,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if err := poolmanagertypes.SwapAmountInRoutes(routes).Validate(); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	routeTwap := sdk.OneDec()
+	tokenInDenom := baseAssetDenom
+	var spotPriceErr error
+	for i, route := range routes {
+		twap, err := k.GetGeometricTwap(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom, startTime, endTime)
+		if err != nil {
+			err = fmt.Errorf("failed to get twap of hop %d, from %s to %s in pool %d: %w",
+				i, tokenInDenom, route.TokenOutDenom, route.PoolId, err)
+			if !errors.Is(err, types.ErrSpotPriceErrorInRange) {
+				return sdk.Dec{}, err
+			}
+			spotPriceErr = err
+		}
+		routeTwap = routeTwap.Mul(twap)
+		tokenInDenom = route.TokenOutDenom
+	}
+	return routeTwap, spotPriceErr
+}
+
+// GetTwapMetadata returns metadata on the records that the TWAPs of the base and quote assets
+// in pool `poolId` from (startTime, endTime) are computed from, so that callers can tell how fresh
+// and reliable they are. See types.TwapMetadata for the returned fields.
+// Counting the records iterates over all the records of the pair within the time range.
+//
+// The time range constraints and errors are the same as for GetArithmeticTwap, except that
+// a spot price error within the time range is reported in the metadata rather than returned.
+func (k Keeper) GetTwapMetadata(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (types.TwapMetadata, error) {
+	if startTime.After(endTime) {
+		return types.TwapMetadata{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return types.TwapMetadata{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	startRecord, err := k.getRecordAtOrBeforeTime(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+	endRecord, err := k.getRecordAtOrBeforeTime(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+	mostRecentRecord, err := k.getMostRecentRecordStoreRepresentation(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+	oldestRecord, err := k.getOldestRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+	recordCount, err := k.countRecordsInRange(ctx, poolId, baseAssetDenom, quoteAssetDenom, startRecord.Time, endTime)
+	if err != nil {
+		return types.TwapMetadata{}, err
+	}
+
+	// Interpolate the records the same way as the TWAP computation does, to detect spot price errors.
+	interpolatedStartRecord := interpolateRecord(startRecord, startTime)
+	interpolatedEndRecord := recordWithUpdatedAccumulators(endRecord, endTime)
+	if !endTime.Equal(ctx.BlockTime()) {
+		interpolatedEndRecord = interpolateRecord(endRecord, endTime)
+	}
+
+	return types.TwapMetadata{
+		PoolId:                poolId,
+		LastErrorInRange:      hasSpotPriceErrorInRange(interpolatedStartRecord, interpolatedEndRecord),
+		LastErrorTime:         mostRecentRecord.LastErrorTime,
+		RecordCount:           recordCount,
+		OldestRecordTime:      oldestRecord.Time,
+		TimeSinceLastUpdate:   ctx.BlockTime().Sub(mostRecentRecord.Time),
+		StartTimeInterpolated: !startRecord.Time.Equal(startTime),
+		EndTimeInterpolated:   !endRecord.Time.Equal(endTime),
+	}, nil
+}
+
+// GetRouteTwapMetadata returns the metadata of every hop of the route, as given for GetRouteTwap,
+// from (startTime, endTime). See GetTwapMetadata.
+func (k Keeper) GetRouteTwapMetadata(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	routes []poolmanagertypes.SwapAmountInRoute,
+	startTime time.Time,
+	endTime time.Time,
+) ([]types.TwapMetadata, error) {
+	metadata := make([]types.TwapMetadata, 0, len(routes))
+	tokenInDenom := baseAssetDenom
+	for i, route := range routes {
+		hopMetadata, err := k.GetTwapMetadata(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom, startTime, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get twap metadata of hop %d, from %s to %s in pool %d: %w",
+				i, tokenInDenom, route.TokenOutDenom, route.PoolId, err)
+		}
+		metadata = append(metadata, hopMetadata)
+		tokenInDenom = route.TokenOutDenom
+	}
+	return metadata, nil
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
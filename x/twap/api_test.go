@@ -1,7 +1,6 @@
 package twap_test
 
 import (
-	"errors"
 	"fmt"
 	"math/rand"
 	"time"
@@ -11,6 +10,8 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	sdkrand "github.com/osmosis-labs/osmosis/v16/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v16/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v16/x/twap"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
@@ -81,7 +82,7 @@ var (
 		sdk.ZeroDec(),                // TODO: choose correct
 	)
 
-	errSpotPrice = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	errSpotPrice = types.ErrSpotPriceErrorInRange
 )
 
 func (s *TestSuite) TestGetBeginBlockAccumulatorRecord() {
@@ -905,3 +906,792 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetRealizedVolatility() {
+	var (
+		// ln(2) with the precision of sdk.Dec.
+		ln2       = sdk.MustNewDecFromStr("0.693147180559945309")
+		tolerance = sdk.NewDecWithPrec(1, 16)
+
+		// the spot price went from 10 to 5, a squared log2 return of 1.
+		tPlus10sp5VolRecord = withSquaredLogReturnAccum(tPlus10sp5Record, sdk.OneDec())
+		// the spot price went from 5 to 2, a squared log2 return of log_{2}{2.5}^2.
+		log2OfTwoAndAHalf   = twap.TwapLog(sdk.NewDecWithPrec(25, 1))
+		tPlus20sp2VolRecord = withSquaredLogReturnAccum(tPlus20sp2Record, sdk.OneDec().Add(log2OfTwoAndAHalf.Mul(log2OfTwoAndAHalf)))
+	)
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expVol       sdk.Dec
+		expectError  error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expVol:       sdk.ZeroDec(),
+		},
+		"(1 record) start equals end": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, tPlusOne, baseQuoteBA),
+			expVol:       sdk.ZeroDec(),
+		},
+		"(2 record) start and end exact, different records": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expVol:       ln2,
+		},
+		"(2 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			expVol:       ln2,
+		},
+		"(2 record) start and end interpolated, swapped base and quote": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteAB),
+			expVol:       ln2,
+		},
+		"(2 record) time range before the price change": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(5*time.Second), baseQuoteBA),
+			expVol:       sdk.ZeroDec(),
+		},
+		"(3 record) start and end exactly at record times, both price changes": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord, tPlus20sp2VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			// sqrt(ln(2)^2 + ln(2.5)^2)
+			expVol: sdk.MustNewDecFromStr("1.148930685131473087"),
+		},
+		"(3 record) end time = now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5VolRecord, tPlus20sp2VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(10*time.Second), tPlusOneMin, baseQuoteBA),
+			// ln(2.5)
+			expVol: sdk.MustNewDecFromStr("0.916290731874155065"),
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"end time in the future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+		"start equals end, in the future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(tPlusOneMin, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			vol, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(test.expVol.Sub(vol).Abs().LTE(tolerance), "expected %s, got %s", test.expVol, vol)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetRealizedVolatility_Swaps() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	startTime := s.Ctx.BlockTime()
+
+	// the squared log return of a block is only accumulated for the last spot price of the block.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.RunBasicSwap(poolId)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	vol, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, poolId, denom0, denom1, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(vol.IsPositive())
+
+	// the realized volatility does not depend on the quote asset.
+	volReverse, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, poolId, denom1, denom0, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(vol, volReverse)
+}
+
+func (s *TestSuite) TestGetTimeWeightedLiquidity() {
+	var (
+		// liquidity of 100 in denom0 and 10 in denom1.
+		baseLiquidityRecord = withLastLiquidities(baseRecord, sdk.NewDec(100), sdk.NewDec(10))
+		// the liquidity doubled after 10 seconds.
+		tPlus10LiquidityRecord = withLiquidityAccums(withLastLiquidities(tPlus10sp5Record, sdk.NewDec(200), sdk.NewDec(20)),
+			OneSec.MulInt64(10*100), OneSec.MulInt64(10*10))
+	)
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expLiquidity sdk.Dec
+		expectError  error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expLiquidity: sdk.NewDec(100),
+		},
+		"(1 record) start equals end": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, tPlusOne, baseQuoteBA),
+			expLiquidity: sdk.NewDec(100),
+		},
+		"(1 record) start equals end, quote denom1": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, tPlusOne, baseQuoteAB),
+			expLiquidity: sdk.NewDec(10),
+		},
+		"(2 record) start and end exact, different records": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord, tPlus10LiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expLiquidity: sdk.NewDec(100),
+		},
+		"(2 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord, tPlus10LiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			// (5 * 100 + 5 * 200) / 10
+			expLiquidity: sdk.NewDec(150),
+		},
+		"(2 record) start and end interpolated, quote denom1": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord, tPlus10LiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteAB),
+			// (5 * 10 + 5 * 20) / 10
+			expLiquidity: sdk.NewDec(15),
+		},
+		"(2 record) end time = now": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord, tPlus10LiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(10*time.Second), tPlusOneMin, baseQuoteBA),
+			expLiquidity: sdk.NewDec(200),
+		},
+		"(2 record) start equals end = now": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord, tPlus10LiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOneMin, tPlusOneMin, baseQuoteBA),
+			expLiquidity: sdk.NewDec(200),
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"end time in the future": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+		"start equals end, in the future": {
+			recordsToSet: []types.TwapRecord{baseLiquidityRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(tPlusOneMin, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			liquidity, err := s.twapkeeper.GetTimeWeightedLiquidity(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expLiquidity, liquidity)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTimeWeightedLiquidity_JoinPool() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	startTime := s.Ctx.BlockTime()
+	// 1_000_000_000 denom0 and 1_000_000_000 denom1 at a spot price of 1.
+	initialLiquidity := sdk.NewDec(2_000_000_000)
+
+	// double the pool liquidity a minute later.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.FundAcc(s.TestAccs[0], defaultTwoAssetCoins)
+	_, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, s.TestAccs[0], poolId, gammtypes.InitPoolSharesSupply, defaultTwoAssetCoins)
+	s.Require().NoError(err)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	// liquidity added right before reading it does not count towards the time weighted liquidity.
+	liquidity, err := s.twapkeeper.GetTimeWeightedLiquidity(s.Ctx, poolId, denom1, denom0, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(initialLiquidity, liquidity)
+
+	// a minute later, the doubled liquidity accounts for half of the time range.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(2 * time.Minute)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	liquidity, err = s.twapkeeper.GetTimeWeightedLiquidity(s.Ctx, poolId, denom1, denom0, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().Equal(initialLiquidity.MulInt64(3).QuoInt64(2), liquidity)
+}
+
+func (s *TestSuite) TestGetVwap() {
+	var (
+		// 100 denom0 were swapped against 20 denom1 in the first 10 seconds,
+		tPlus10VolRecord = withVolumeAccums(tPlus10sp5Record, sdk.NewDec(100), sdk.NewDec(20))
+		// and 30 denom0 against 10 denom1 in the next 10 seconds.
+		tPlus20VolRecord = withVolumeAccums(tPlus20sp2Record, sdk.NewDec(130), sdk.NewDec(30))
+	)
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expVwap      sdk.Dec
+		expectError  error
+	}{
+		"(2 record) start and end exact, different records": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expVwap:      sdk.NewDec(5),
+		},
+		"(2 record) start and end exact, swapped base and quote": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteAB),
+			expVwap:      sdk.NewDecWithPrec(2, 1),
+		},
+		"(2 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			expVwap:      sdk.NewDec(5),
+		},
+		"(3 record) start and end exact, later records": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord, tPlus20VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			expVwap:      sdk.NewDec(3),
+		},
+		"(3 record) end time = now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord, tPlus20VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			// 130 / 30
+			expVwap: sdk.MustNewDecFromStr("4.333333333333333333"),
+		},
+		"(2 record) no swap within the time range": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(5*time.Second), baseQuoteBA),
+			expectError: types.NoSwapVolumeError{
+				PoolId: 1, BaseDenom: denom1, QuoteDenom: denom0, StartTime: baseTime, EndTime: baseTime.Add(5 * time.Second),
+			},
+		},
+		"(2 record) start equals end": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10VolRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(10*time.Second), baseTime.Add(10*time.Second), baseQuoteBA),
+			expectError: types.NoSwapVolumeError{
+				PoolId: 1, BaseDenom: denom1, QuoteDenom: denom0, StartTime: baseTime.Add(10 * time.Second), EndTime: baseTime.Add(10 * time.Second),
+			},
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"end time in the future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			vwap, err := s.twapkeeper.GetVwap(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expVwap, vwap)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetVwap_Swaps() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	startTime := s.Ctx.BlockTime()
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: denom1}}
+
+	// swap twice in the next block, only the latter moves the spot price recorded for the block.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	tokenIn := sdk.NewInt64Coin(denom0, 1_000_000)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn.Add(tokenIn)))
+	firstTokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	secondTokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, sdk.OneInt())
+	s.Require().NoError(err)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	vwap, err := s.twapkeeper.GetVwap(s.Ctx, poolId, denom0, denom1, startTime, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	expectedVwap := firstTokenOutAmount.Add(secondTokenOutAmount).ToDec().Quo(tokenIn.Amount.MulRaw(2).ToDec())
+	s.Require().Equal(expectedVwap, vwap)
+}
+
+func (s *TestSuite) TestGetAggregateTwap() {
+	var (
+		startTime = baseTime
+		ctxTime   = baseTime.Add(time.Minute)
+
+		// pool 1: spot price of denom0 in denom1 = 1, liquidity of 2_000_000_000 denom1.
+		poolOneCoins = defaultTwoAssetCoins
+		// pool 2: spot price of denom0 in denom1 = 4, liquidity of 8_000_000_000 denom1.
+		poolTwoCoins = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 4_000_000_000))
+		// pool 3: created after the start time, spot price of denom0 in denom1 = 100.
+		poolThreeCoins = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 100_000_000_000))
+		// pool 4: trades a different pair.
+		poolFourCoins = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom2, 1_000_000_000))
+
+		// (1 * 2_000_000_000 + 4 * 8_000_000_000) / 10_000_000_000
+		poolOneAndTwoTwap = sdk.MustNewDecFromStr("3.4")
+	)
+
+	tests := map[string]struct {
+		poolIds     []uint64
+		baseDenom   string
+		quoteDenom  string
+		startTime   time.Time
+		endTime     time.Time
+		expTwap     sdk.Dec
+		expPoolIds  []uint64
+		expectError error
+		expectErr   bool
+	}{
+		"all pools trading the pair, pool created after start time is left out": {
+			poolIds:    []uint64{},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			expTwap:    poolOneAndTwoTwap,
+			expPoolIds: []uint64{1, 2},
+		},
+		"all pools trading the pair, reversed base and quote": {
+			poolIds:    []uint64{},
+			baseDenom:  denom1,
+			quoteDenom: denom0,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			// (1 * 2_000_000_000 + 0.25 * 2_000_000_000) / 4_000_000_000
+			expTwap:    sdk.MustNewDecFromStr("0.625"),
+			expPoolIds: []uint64{1, 2},
+		},
+		"all pools trading the pair, end time before now": {
+			poolIds:    []uint64{},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime.Add(-time.Second),
+			expTwap:    poolOneAndTwoTwap,
+			expPoolIds: []uint64{1, 2},
+		},
+		"all pools trading the pair, time range after pool 3 creation": {
+			poolIds:    []uint64{},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime.Add(30 * time.Second),
+			endTime:    ctxTime,
+			// (1 * 2_000_000_000 + 4 * 8_000_000_000 + 100 * 200_000_000_000) / 210_000_000_000
+			expTwap:    sdk.NewDec(20_034_000_000_000).QuoInt64(210_000_000_000),
+			expPoolIds: []uint64{1, 2, 3},
+		},
+		"single pool": {
+			poolIds:    []uint64{1},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			expTwap:    sdk.OneDec(),
+			expPoolIds: []uint64{1},
+		},
+		"given pools": {
+			poolIds:    []uint64{2, 1},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			expTwap:    poolOneAndTwoTwap,
+			expPoolIds: []uint64{2, 1},
+		},
+		"given pool created after start time": {
+			poolIds:    []uint64{1, 3},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			expectErr:  true,
+		},
+		"given pool not trading the pair": {
+			poolIds:    []uint64{1, 4},
+			baseDenom:  denom0,
+			quoteDenom: denom1,
+			startTime:  startTime,
+			endTime:    ctxTime,
+			expectErr:  true,
+		},
+		"duplicate pool id": {
+			poolIds:     []uint64{1, 2, 1},
+			baseDenom:   denom0,
+			quoteDenom:  denom1,
+			startTime:   startTime,
+			endTime:     ctxTime,
+			expectError: types.DuplicatePoolIdError{PoolId: 1},
+		},
+		"no pool trading the pair": {
+			poolIds:     []uint64{},
+			baseDenom:   denom1,
+			quoteDenom:  denom2,
+			startTime:   startTime,
+			endTime:     ctxTime,
+			expectError: types.NoAggregateTwapLiquidityError{BaseDenom: denom1, QuoteDenom: denom2, PoolIds: []uint64{}},
+		},
+		"start time after end time": {
+			poolIds:     []uint64{},
+			baseDenom:   denom0,
+			quoteDenom:  denom1,
+			startTime:   ctxTime,
+			endTime:     startTime,
+			expectError: types.StartTimeAfterEndTimeError{StartTime: ctxTime, EndTime: startTime},
+		},
+		"end time in the future": {
+			poolIds:     []uint64{},
+			baseDenom:   denom0,
+			quoteDenom:  denom1,
+			startTime:   startTime,
+			endTime:     ctxTime.Add(time.Second),
+			expectError: types.EndTimeInFutureError{EndTime: ctxTime.Add(time.Second), BlockTime: ctxTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.PrepareBalancerPoolWithCoins(poolOneCoins...)
+			s.PrepareBalancerPoolWithCoins(poolTwoCoins...)
+			s.Ctx = s.Ctx.WithBlockTime(startTime.Add(30 * time.Second))
+			s.PrepareBalancerPoolWithCoins(poolThreeCoins...)
+			s.PrepareBalancerPoolWithCoins(poolFourCoins...)
+			s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+			twap, poolIds, err := s.twapkeeper.GetAggregateTwap(s.Ctx, test.poolIds, test.baseDenom, test.quoteDenom, test.startTime, test.endTime)
+
+			if test.expectError != nil || test.expectErr {
+				s.Require().Error(err)
+				if test.expectError != nil {
+					s.Require().Equal(test.expectError, err)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expTwap, twap)
+			s.Require().Equal(test.expPoolIds, poolIds)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetRouteTwap() {
+	var (
+		startTime = baseTime
+		ctxTime   = baseTime.Add(time.Minute)
+
+		// pool 1: spot price of denom0 in denom1 = 2.
+		poolOneCoins = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000))
+		// pool 2: spot price of denom1 in denom2 = 3.
+		poolTwoCoins = sdk.NewCoins(sdk.NewInt64Coin(denom1, 1_000_000_000), sdk.NewInt64Coin(denom2, 3_000_000_000))
+		// pool 3: spot price of denom0 in denom2 = 1.
+		poolThreeCoins = sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom2, 1_000_000_000))
+
+		// geometric twaps carry the error of the power approximation.
+		errTolerance = osmomath.ErrTolerance{MultiplicativeTolerance: sdk.NewDecWithPrec(1, 7)}
+	)
+
+	tests := map[string]struct {
+		baseDenom   string
+		routes      []poolmanagertypes.SwapAmountInRoute
+		endTime     time.Time
+		expTwap     sdk.Dec
+		expectError error
+		expectErr   bool
+	}{
+		"single hop": {
+			baseDenom: denom0,
+			routes:    []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: denom1}},
+			endTime:   ctxTime,
+			expTwap:   sdk.NewDec(2),
+		},
+		"two hops": {
+			baseDenom: denom0,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: denom1},
+				{PoolId: 2, TokenOutDenom: denom2},
+			},
+			endTime: ctxTime,
+			expTwap: sdk.NewDec(6),
+		},
+		"two hops, end time before now": {
+			baseDenom: denom0,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: denom1},
+				{PoolId: 2, TokenOutDenom: denom2},
+			},
+			endTime: ctxTime.Add(-time.Second),
+			expTwap: sdk.NewDec(6),
+		},
+		"two hops, reversed route": {
+			baseDenom: denom2,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 2, TokenOutDenom: denom1},
+				{PoolId: 1, TokenOutDenom: denom0},
+			},
+			endTime: ctxTime,
+			// 1/3 * 1/2
+			expTwap: sdk.OneDec().QuoInt64(6),
+		},
+		"single hop with a price of one": {
+			baseDenom: denom0,
+			routes:    []poolmanagertypes.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: denom2}},
+			endTime:   ctxTime,
+			expTwap:   sdk.OneDec(),
+		},
+		"two hops, one with a price of one": {
+			baseDenom: denom1,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: denom0},
+				{PoolId: 3, TokenOutDenom: denom2},
+			},
+			endTime: ctxTime,
+			expTwap: sdk.NewDecWithPrec(5, 1),
+		},
+		"empty route": {
+			baseDenom:   denom0,
+			routes:      []poolmanagertypes.SwapAmountInRoute{},
+			endTime:     ctxTime,
+			expectError: poolmanagertypes.ErrEmptyRoutes,
+		},
+		"hop pool does not contain the token in denom": {
+			baseDenom: denom0,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 2, TokenOutDenom: denom2},
+			},
+			endTime:   ctxTime,
+			expectErr: true,
+		},
+		"end time in the future": {
+			baseDenom: denom0,
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: denom1},
+			},
+			endTime:     ctxTime.Add(time.Second),
+			expectError: types.EndTimeInFutureError{EndTime: ctxTime.Add(time.Second), BlockTime: ctxTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.PrepareBalancerPoolWithCoins(poolOneCoins...)
+			s.PrepareBalancerPoolWithCoins(poolTwoCoins...)
+			s.PrepareBalancerPoolWithCoins(poolThreeCoins...)
+			s.Ctx = s.Ctx.WithBlockTime(ctxTime)
+
+			twap, err := s.twapkeeper.GetRouteTwap(s.Ctx, test.baseDenom, test.routes, startTime, test.endTime)
+
+			if test.expectError != nil || test.expectErr {
+				s.Require().Error(err)
+				if test.expectError != nil {
+					s.Require().ErrorIs(err, test.expectError)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(0, errTolerance.CompareBigDec(osmomath.BigDecFromSDKDec(test.expTwap), osmomath.BigDecFromSDKDec(twap)), "expected %s, got %s", test.expTwap, twap)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetTwapMetadata() {
+	var (
+		// spot price error at t+10, carried over by the next record.
+		tPlus10ErrRecord = withLastErrTime(tPlus10sp5Record, tPlus10sp5Record.Time)
+		tPlus20ErrRecord = withLastErrTime(tPlus20sp2Record, tPlus10sp5Record.Time)
+	)
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expMetadata  types.TwapMetadata
+		expectError  error
+		expectErr    bool
+	}{
+		"(3 record) start and end exact": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expMetadata: types.TwapMetadata{
+				PoolId:              1,
+				RecordCount:         2,
+				OldestRecordTime:    baseTime,
+				TimeSinceLastUpdate: 40 * time.Second,
+			},
+		},
+		"(3 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(15*time.Second), baseQuoteAB),
+			expMetadata: types.TwapMetadata{
+				PoolId:                1,
+				RecordCount:           2,
+				OldestRecordTime:      baseTime,
+				TimeSinceLastUpdate:   40 * time.Second,
+				StartTimeInterpolated: true,
+				EndTimeInterpolated:   true,
+			},
+		},
+		"(3 record) end time = now": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expMetadata: types.TwapMetadata{
+				PoolId:              1,
+				RecordCount:         3,
+				OldestRecordTime:    baseTime,
+				TimeSinceLastUpdate: 40 * time.Second,
+				EndTimeInterpolated: true,
+			},
+		},
+		"(3 record) start equals end": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(15*time.Second), baseTime.Add(15*time.Second), baseQuoteBA),
+			expMetadata: types.TwapMetadata{
+				PoolId:                1,
+				RecordCount:           1,
+				OldestRecordTime:      baseTime,
+				TimeSinceLastUpdate:   40 * time.Second,
+				StartTimeInterpolated: true,
+				EndTimeInterpolated:   true,
+			},
+		},
+		"(3 record) spot price error in range": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord, tPlus20ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(15*time.Second), baseQuoteBA),
+			expMetadata: types.TwapMetadata{
+				PoolId:              1,
+				LastErrorInRange:    true,
+				LastErrorTime:       tPlus10sp5Record.Time,
+				RecordCount:         2,
+				OldestRecordTime:    baseTime,
+				TimeSinceLastUpdate: 40 * time.Second,
+				EndTimeInterpolated: true,
+			},
+		},
+		"(3 record) spot price error before range": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10ErrRecord, tPlus20ErrRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(20*time.Second), tPlusOneMin, baseQuoteBA),
+			expMetadata: types.TwapMetadata{
+				PoolId:              1,
+				LastErrorTime:       tPlus10sp5Record.Time,
+				RecordCount:         1,
+				OldestRecordTime:    baseTime,
+				TimeSinceLastUpdate: 40 * time.Second,
+				EndTimeInterpolated: true,
+			},
+		},
+		"start time too old": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tMinOne, tPlusOneMin, baseQuoteBA),
+			expectErr:    true,
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"end time in the future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOne,
+			input:        makeSimpleTwapInput(baseTime, tPlusOneMin, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{EndTime: tPlusOneMin, BlockTime: tPlusOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			metadata, err := s.twapkeeper.GetTwapMetadata(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil || test.expectErr {
+				s.Require().Error(err)
+				if test.expectError != nil {
+					s.Require().Equal(test.expectError, err)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expMetadata, metadata)
+		})
+	}
+}
@@ -11,16 +11,38 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	poolmanagercli "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/cli"
 	poolmanager "github.com/osmosis-labs/osmosis/v16/x/poolmanager/client/queryproto"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
+const (
+	// FlagPoolIds is the flag to restrict the pools aggregated by the aggregate twap query,
+	// and to give the pools of the items of the batch twap query.
+	FlagPoolIds = "pool-ids"
+	// FlagBaseDenoms is the flag to give the base denoms of the items of the batch twap query.
+	FlagBaseDenoms = "base-denoms"
+	// FlagQuoteDenoms is the flag to give the quote denoms of the items of the batch twap query.
+	FlagQuoteDenoms = "quote-denoms"
+	// FlagStrategies is the flag to give the twap strategies of the items of the batch twap query.
+	FlagStrategies = "strategies"
+	// FlagIncludeMetadata is the flag to include the twap metadata in the result of the twap queries.
+	FlagIncludeMetadata = "include-metadata"
+)
+
 // GetQueryCmd returns the cli query commands for this module.
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryRealizedVolatilityCommand())
+	cmd.AddCommand(GetQueryAggregateCommand())
+	cmd.AddCommand(GetQueryRouteCommand())
+	cmd.AddCommand(GetQueryTimeWeightedLiquidityCommand())
+	cmd.AddCommand(GetQueryVwapCommand())
+	cmd.AddCommand(GetQueryBatchCommand())
 
 	return cmd
 }
@@ -44,6 +66,10 @@ Example:
 			if err != nil {
 				return err
 			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -55,11 +81,12 @@ Example:
 
 			queryClient := queryproto.NewQueryClient(clientCtx)
 			res, err := queryClient.ArithmeticTwap(cmd.Context(), &queryproto.ArithmeticTwapRequest{
-				PoolId:     poolId,
-				BaseAsset:  baseDenom,
-				QuoteAsset: quoteDenom,
-				StartTime:  startTime,
-				EndTime:    &endTime,
+				PoolId:          poolId,
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
 			})
 			if err != nil {
 				return err
@@ -69,6 +96,7 @@ Example:
 		},
 	}
 
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -92,6 +120,10 @@ Example:
 			if err != nil {
 				return err
 			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -106,11 +138,12 @@ Example:
 			}
 
 			res, err := queryClient.GeometricTwap(cmd.Context(), &queryproto.GeometricTwapRequest{
-				PoolId:     poolId,
-				BaseAsset:  baseDenom,
-				QuoteAsset: quoteDenom,
-				StartTime:  startTime,
-				EndTime:    &endTime,
+				PoolId:          poolId,
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
 			})
 			if err != nil {
 				return err
@@ -120,11 +153,402 @@ Example:
 		},
 	}
 
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// GetQueryRealizedVolatilityCommand returns a realized volatility query command.
+func GetQueryRealizedVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query realized volatility",
+		Long: osmocli.FormatLongDescDirect(`Query realized volatility of the price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.RealizedVolatility(cmd.Context(), &queryproto.RealizedVolatilityRequest{
+				PoolId:          poolId,
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryTimeWeightedLiquidityCommand returns a time weighted liquidity query command.
+func GetQueryTimeWeightedLiquidityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity [poolid] [base denom] [start time] [end time]",
+		Short: "Query time weighted liquidity",
+		Long: osmocli.FormatLongDescDirect(`Query time weighted liquidity of the pool, in units of the quote denom. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} liquidity 1 uosmo 1667088000 24h
+{{.CommandPrefix}} liquidity 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.TimeWeightedLiquidity(cmd.Context(), &queryproto.TimeWeightedLiquidityRequest{
+				PoolId:          poolId,
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryVwapCommand returns a volume weighted average price query command.
+func GetQueryVwapCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vwap [poolid] [base denom] [start time] [end time]",
+		Short: "Query volume weighted average price",
+		Long: osmocli.FormatLongDescDirect(`Query volume weighted average price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} vwap 1 uosmo 1667088000 24h
+{{.CommandPrefix}} vwap 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			poolId, baseDenom, startTime, endTime, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, poolId, baseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.Vwap(cmd.Context(), &queryproto.VwapRequest{
+				PoolId:          poolId,
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryAggregateCommand returns an aggregate twap query command.
+func GetQueryAggregateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate [base denom] [quote denom] [start time] [end time]",
+		Short: "Query liquidity weighted arithmetic twap across pools",
+		Long: osmocli.FormatLongDescDirect(`Query arithmetic twap of a denom pair, aggregated across all the pools trading it and weighted by their liquidity.
+The aggregated pools can be restricted with --pool-ids. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} aggregate uosmo uion 1667088000 24h
+{{.CommandPrefix}} aggregate uosmo uion 1667088000 1667174400 --pool-ids=1,2
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom := strings.TrimSpace(args[0])
+			quoteDenom := strings.TrimSpace(args[1])
+			startTime, endTime, err := twapQueryParseTimes(args[2], args[3])
+			if err != nil {
+				return err
+			}
+			poolIds, err := cmd.Flags().GetUintSlice(FlagPoolIds)
+			if err != nil {
+				return err
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &queryproto.AggregateTwapRequest{
+				BaseAsset:       baseDenom,
+				QuoteAsset:      quoteDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			}
+			for _, poolId := range poolIds {
+				req.PoolIds = append(req.PoolIds, uint64(poolId))
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.AggregateTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().UintSlice(FlagPoolIds, []uint{}, "ids of the pools to aggregate, all the pools trading the pair if empty")
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryRouteCommand returns a multihop route twap query command.
+func GetQueryRouteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route [base denom] [start time] [end time]",
+		Short: "Query geometric twap along a multihop route",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap of the base denom in terms of the last denom of a multihop route, which is the product of the geometric twaps of every hop.
+The route is given with --swap-route-pool-ids and --swap-route-denoms, like for a multihop swap. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} route ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 1667088000 24h --swap-route-pool-ids=1,678 --swap-route-denoms=uosmo,ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858
+`, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom := strings.TrimSpace(args[0])
+			startTime, endTime, err := twapQueryParseTimes(args[1], args[2])
+			if err != nil {
+				return err
+			}
+			poolIds, err := cmd.Flags().GetUintSlice(poolmanagercli.FlagSwapRoutePoolIds)
+			if err != nil {
+				return err
+			}
+			denoms, err := cmd.Flags().GetStringSlice(poolmanagercli.FlagSwapRouteDenoms)
+			if err != nil {
+				return err
+			}
+			if len(poolIds) != len(denoms) {
+				return fmt.Errorf("swap route pool ids and denoms mismatch, got %d pool ids and %d denoms", len(poolIds), len(denoms))
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &queryproto.RouteTwapRequest{
+				BaseAsset:       baseDenom,
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			}
+			for i, poolId := range poolIds {
+				req.Routes = append(req.Routes, poolmanagertypes.SwapAmountInRoute{PoolId: uint64(poolId), TokenOutDenom: denoms[i]})
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.RouteTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().UintSlice(poolmanagercli.FlagSwapRoutePoolIds, []uint{}, "swap route pool ids")
+	cmd.Flags().StringSlice(poolmanagercli.FlagSwapRouteDenoms, []string{}, "swap route token out denoms")
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryBatchCommand returns a batch twap query command.
+func GetQueryBatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [start time] [end time]",
+		Short: "Query twaps of many pools and pairs at once",
+		Long: osmocli.FormatLongDescDirect(`Query twaps of many pools and pairs over the same time range, with errors reported per item.
+The items are given with --pool-ids, --base-denoms, --quote-denoms and optionally --strategies (arithmetic or geometric, arithmetic by default).
+Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} batch 1667088000 24h --pool-ids=1,1 --base-denoms=uosmo,uion --quote-denoms=uion,uosmo --strategies=arithmetic,geometric
+`, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startTime, endTime, err := twapQueryParseTimes(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			poolIds, err := cmd.Flags().GetUintSlice(FlagPoolIds)
+			if err != nil {
+				return err
+			}
+			baseDenoms, err := cmd.Flags().GetStringSlice(FlagBaseDenoms)
+			if err != nil {
+				return err
+			}
+			quoteDenoms, err := cmd.Flags().GetStringSlice(FlagQuoteDenoms)
+			if err != nil {
+				return err
+			}
+			strategies, err := cmd.Flags().GetStringSlice(FlagStrategies)
+			if err != nil {
+				return err
+			}
+			if len(poolIds) != len(baseDenoms) || len(poolIds) != len(quoteDenoms) {
+				return fmt.Errorf("pool ids, base denoms and quote denoms mismatch, got %d pool ids, %d base denoms and %d quote denoms",
+					len(poolIds), len(baseDenoms), len(quoteDenoms))
+			}
+			if len(strategies) != 0 && len(strategies) != len(poolIds) {
+				return fmt.Errorf("strategies mismatch, got %d strategies for %d items", len(strategies), len(poolIds))
+			}
+			includeMetadata, err := cmd.Flags().GetBool(FlagIncludeMetadata)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &queryproto.BatchTwapRequest{
+				StartTime:       startTime,
+				EndTime:         &endTime,
+				IncludeMetadata: includeMetadata,
+			}
+			for i, poolId := range poolIds {
+				strategy := queryproto.Arithmetic
+				if len(strategies) != 0 {
+					strategy, err = parseTwapStrategy(strategies[i])
+					if err != nil {
+						return err
+					}
+				}
+				req.Items = append(req.Items, queryproto.BatchTwapItem{
+					PoolId:     uint64(poolId),
+					BaseAsset:  strings.TrimSpace(baseDenoms[i]),
+					QuoteAsset: strings.TrimSpace(quoteDenoms[i]),
+					Strategy:   strategy,
+				})
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchTwap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().UintSlice(FlagPoolIds, []uint{}, "pool ids of the items")
+	cmd.Flags().StringSlice(FlagBaseDenoms, []string{}, "base denoms of the items")
+	cmd.Flags().StringSlice(FlagQuoteDenoms, []string{}, "quote denoms of the items")
+	cmd.Flags().StringSlice(FlagStrategies, []string{}, "twap strategies of the items, arithmetic or geometric")
+	cmd.Flags().Bool(FlagIncludeMetadata, false, "include the twap metadata in the result")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTwapStrategy parses a twap strategy name, case insensitively.
+func parseTwapStrategy(arg string) (queryproto.TwapStrategy, error) {
+	for name, value := range queryproto.TwapStrategy_value {
+		if strings.EqualFold(name, strings.TrimSpace(arg)) {
+			return queryproto.TwapStrategy(value), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown twap strategy %s, expected arithmetic or geometric", arg)
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -160,24 +584,32 @@ func twapQueryParseArgs(args []string) (poolId uint64, baseDenom string, startTi
 	// <DENOM PARSE>
 	baseDenom = strings.TrimSpace(args[1])
 
+	startTime, endTime, err = twapQueryParseTimes(args[2], args[3])
+	if err != nil {
+		return
+	}
+	return poolId, baseDenom, startTime, endTime, nil
+}
+
+func twapQueryParseTimes(startArg, endArg string) (startTime time.Time, endTime time.Time, err error) {
 	// <UNIX TIME PARSE>
-	startTime, err = osmocli.ParseUnixTime(args[2], "start time")
+	startTime, err = osmocli.ParseUnixTime(startArg, "start time")
 	if err != nil {
 		return
 	}
 
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err = osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err = osmocli.ParseUnixTime(endArg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(endArg)
 		if err2 != nil {
 			err = err2
 			return
 		}
 		endTime = startTime.Add(duration)
 	}
-	return poolId, baseDenom, startTime, endTime, nil
+	return startTime, endTime, nil
 }
@@ -20,6 +20,46 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) Vwap(grpcCtx context.Context,
+	req *queryproto.VwapRequest,
+) (*queryproto.VwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Vwap(ctx, *req)
+}
+
+func (q Querier) TimeWeightedLiquidity(grpcCtx context.Context,
+	req *queryproto.TimeWeightedLiquidityRequest,
+) (*queryproto.TimeWeightedLiquidityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TimeWeightedLiquidity(ctx, *req)
+}
+
+func (q Querier) RouteTwap(grpcCtx context.Context,
+	req *queryproto.RouteTwapRequest,
+) (*queryproto.RouteTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RouteTwap(ctx, *req)
+}
+
+func (q Querier) RealizedVolatility(grpcCtx context.Context,
+	req *queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RealizedVolatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -50,6 +90,16 @@ func (q Querier) GeometricTwap(grpcCtx context.Context,
 	return q.Q.GeometricTwap(ctx, *req)
 }
 
+func (q Querier) BatchTwap(grpcCtx context.Context,
+	req *queryproto.BatchTwapRequest,
+) (*queryproto.BatchTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BatchTwap(ctx, *req)
+}
+
 func (q Querier) ArithmeticTwapToNow(grpcCtx context.Context,
 	req *queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
@@ -70,3 +120,13 @@ func (q Querier) ArithmeticTwap(grpcCtx context.Context,
 	return q.Q.ArithmeticTwap(ctx, *req)
 }
 
+func (q Querier) AggregateTwap(grpcCtx context.Context,
+	req *queryproto.AggregateTwapRequest,
+) (*queryproto.AggregateTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AggregateTwap(ctx, *req)
+}
+
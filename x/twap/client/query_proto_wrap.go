@@ -1,12 +1,14 @@
 package client
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v16/x/twap"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -26,16 +28,24 @@ func (q Querier) ArithmeticTwap(ctx sdk.Context,
 	}
 
 	twap, err := q.K.GetArithmeticTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
 
-	return &queryproto.ArithmeticTwapResponse{ArithmeticTwap: twap}, err
+	return &queryproto.ArithmeticTwapResponse{ArithmeticTwap: twap, Metadata: metadata}, err
 }
 
 func (q Querier) ArithmeticTwapToNow(ctx sdk.Context,
 	req queryproto.ArithmeticTwapToNowRequest,
 ) (*queryproto.ArithmeticTwapToNowResponse, error) {
 	twap, err := q.K.GetArithmeticTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, ctx.BlockTime())
 
-	return &queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap}, err
+	return &queryproto.ArithmeticTwapToNowResponse{ArithmeticTwap: twap, Metadata: metadata}, err
 }
 
 func (q Querier) GeometricTwap(ctx sdk.Context,
@@ -49,16 +59,94 @@ func (q Querier) GeometricTwap(ctx sdk.Context,
 	}
 
 	twap, err := q.K.GetGeometricTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
 
-	return &queryproto.GeometricTwapResponse{GeometricTwap: twap}, err
+	return &queryproto.GeometricTwapResponse{GeometricTwap: twap, Metadata: metadata}, err
 }
 
 func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	req queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
 	twap, err := q.K.GetGeometricTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, ctx.BlockTime())
 
-	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
+	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap, Metadata: metadata}, err
+}
+
+func (q Querier) RealizedVolatility(ctx sdk.Context,
+	req queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetRealizedVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.RealizedVolatilityResponse{RealizedVolatility: volatility, Metadata: metadata}, err
+}
+
+func (q Querier) AggregateTwap(ctx sdk.Context,
+	req queryproto.AggregateTwapRequest,
+) (*queryproto.AggregateTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, poolIds, err := q.K.GetAggregateTwap(ctx, req.PoolIds, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	var metadata []types.TwapMetadata
+	if req.IncludeMetadata {
+		metadata = make([]types.TwapMetadata, 0, len(poolIds))
+		for _, poolId := range poolIds {
+			poolMetadata, err := q.K.GetTwapMetadata(ctx, poolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+			if err != nil {
+				return nil, err
+			}
+			metadata = append(metadata, poolMetadata)
+		}
+	}
+
+	return &queryproto.AggregateTwapResponse{AggregateTwap: twap, Metadata: metadata}, nil
+}
+
+func (q Querier) RouteTwap(ctx sdk.Context,
+	req queryproto.RouteTwapRequest,
+) (*queryproto.RouteTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetRouteTwap(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !req.IncludeMetadata {
+		return &queryproto.RouteTwapResponse{RouteTwap: twap}, nil
+	}
+	metadata, err := q.K.GetRouteTwapMetadata(ctx, req.BaseAsset, req.Routes, req.StartTime, *req.EndTime)
+
+	return &queryproto.RouteTwapResponse{RouteTwap: twap, Metadata: metadata}, err
 }
 
 func (q Querier) Params(ctx sdk.Context,
@@ -67,3 +155,106 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) TimeWeightedLiquidity(ctx sdk.Context,
+	req queryproto.TimeWeightedLiquidityRequest,
+) (*queryproto.TimeWeightedLiquidityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	liquidity, err := q.K.GetTimeWeightedLiquidity(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.TimeWeightedLiquidityResponse{TimeWeightedLiquidity: liquidity, Metadata: metadata}, err
+}
+
+func (q Querier) Vwap(ctx sdk.Context,
+	req queryproto.VwapRequest,
+) (*queryproto.VwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	vwap, err := q.K.GetVwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := q.twapMetadata(ctx, req.IncludeMetadata, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.VwapResponse{Vwap: vwap, Metadata: metadata}, err
+}
+
+func (q Querier) BatchTwap(ctx sdk.Context,
+	req queryproto.BatchTwapRequest,
+) (*queryproto.BatchTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+	if len(req.Items) > types.MaxBatchTwapItems {
+		return nil, types.TooManyBatchTwapItemsError{NumItems: len(req.Items), MaxItems: types.MaxBatchTwapItems}
+	}
+
+	// Errors are reported per item, so that a single failing item does not fail the whole query.
+	results := make([]queryproto.BatchTwapResult, 0, len(req.Items))
+	for _, item := range req.Items {
+		twap, metadata, err := q.batchTwapItem(ctx, item, req.IncludeMetadata, req.StartTime, *req.EndTime)
+		if err != nil {
+			results = append(results, queryproto.BatchTwapResult{Twap: sdk.ZeroDec(), Error: err.Error()})
+			continue
+		}
+		results = append(results, queryproto.BatchTwapResult{Twap: twap, Metadata: metadata})
+	}
+
+	return &queryproto.BatchTwapResponse{Results: results}, nil
+}
+
+// batchTwapItem returns the twap of the given strategy for an item of a batch twap query,
+// along with its metadata if includeMetadata is set.
+func (q Querier) batchTwapItem(ctx sdk.Context,
+	item queryproto.BatchTwapItem, includeMetadata bool, startTime time.Time, endTime time.Time,
+) (sdk.Dec, types.TwapMetadata, error) {
+	var twap sdk.Dec
+	var err error
+	switch item.Strategy {
+	case queryproto.Arithmetic:
+		twap, err = q.K.GetArithmeticTwap(ctx, item.PoolId, item.BaseAsset, item.QuoteAsset, startTime, endTime)
+	case queryproto.Geometric:
+		twap, err = q.K.GetGeometricTwap(ctx, item.PoolId, item.BaseAsset, item.QuoteAsset, startTime, endTime)
+	default:
+		err = fmt.Errorf("unknown twap strategy %d", item.Strategy)
+	}
+	if err != nil {
+		return sdk.Dec{}, types.TwapMetadata{}, err
+	}
+
+	metadata, err := q.twapMetadata(ctx, includeMetadata, item.PoolId, item.BaseAsset, item.QuoteAsset, startTime, endTime)
+	if err != nil {
+		return sdk.Dec{}, types.TwapMetadata{}, err
+	}
+	return twap, metadata, nil
+}
+
+// twapMetadata returns the metadata of the twap of the base and quote assets in the pool
+// from (startTime, endTime) if includeMetadata is set, and empty metadata otherwise,
+// as computing it iterates over all the records of the time range.
+func (q Querier) twapMetadata(ctx sdk.Context,
+	includeMetadata bool, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time,
+) (types.TwapMetadata, error) {
+	if !includeMetadata {
+		return types.TwapMetadata{}, nil
+	}
+	return q.K.GetTwapMetadata(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+}
@@ -9,8 +9,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v16/app/apptesting"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v16/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
 type QueryTestSuite struct {
@@ -43,6 +45,16 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 
 		// Set current block time one hour from initial.
 		ctx = suite.Ctx.WithBlockTime(newBlockTime)
+
+		// The pool only has the records created along with it. The metadata is only returned
+		// by the queries that include it.
+		expectedMetadata = types.TwapMetadata{
+			PoolId:              poolID,
+			RecordCount:         1,
+			OldestRecordTime:    validStartTime,
+			TimeSinceLastUpdate: time.Hour,
+			EndTimeInterpolated: true,
+		}
 	)
 
 	testCases := []struct {
@@ -166,11 +178,12 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			}
 
 			result, err := client.ArithmeticTwap(ctx, queryproto.ArithmeticTwapRequest{
-				PoolId:     tc.poolId,
-				BaseAsset:  tc.baseAssetDenom,
-				QuoteAsset: tc.quoteAssetDenom,
-				StartTime:  startTime,
-				EndTime:    tc.endTime,
+				PoolId:          tc.poolId,
+				BaseAsset:       tc.baseAssetDenom,
+				QuoteAsset:      tc.quoteAssetDenom,
+				StartTime:       startTime,
+				EndTime:         tc.endTime,
+				IncludeMetadata: true,
 			})
 
 			if tc.expectErr {
@@ -178,6 +191,7 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			} else {
 				suite.Require().NoError(err, "unexpected error - ArithmeticTwap")
 				suite.Require().Equal(tc.result, result.ArithmeticTwap.String())
+				suite.Require().Equal(expectedMetadata, result.Metadata)
 			}
 
 			resultToNow, err := client.ArithmeticTwapToNow(ctx, queryproto.ArithmeticTwapToNowRequest{
@@ -192,6 +206,7 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			} else {
 				suite.Require().NoError(err, "unexpected error - ArithmeticTwapToNow")
 				suite.Require().Equal(tc.result, resultToNow.ArithmeticTwap.String())
+				suite.Require().Equal(types.TwapMetadata{}, resultToNow.Metadata)
 			}
 		})
 
@@ -204,11 +219,12 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			}
 
 			result, err := client.GeometricTwap(ctx, queryproto.GeometricTwapRequest{
-				PoolId:     tc.poolId,
-				BaseAsset:  tc.baseAssetDenom,
-				QuoteAsset: tc.quoteAssetDenom,
-				StartTime:  startTime,
-				EndTime:    tc.endTime,
+				PoolId:          tc.poolId,
+				BaseAsset:       tc.baseAssetDenom,
+				QuoteAsset:      tc.quoteAssetDenom,
+				StartTime:       startTime,
+				EndTime:         tc.endTime,
+				IncludeMetadata: true,
 			})
 
 			if tc.expectErr {
@@ -216,6 +232,7 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			} else {
 				suite.Require().NoError(err, "unexpected error - GeometricTwap")
 				suite.Require().Equal(result.GeometricTwap.String(), result.GeometricTwap.String())
+				suite.Require().Equal(expectedMetadata, result.Metadata)
 			}
 
 			resultToNow, err := client.GeometricTwapToNow(ctx, queryproto.GeometricTwapToNowRequest{
@@ -230,7 +247,178 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 			} else {
 				suite.Require().NoError(err, "unexpected error - GeometricTwapToNow")
 				suite.Require().Equal(result.GeometricTwap.String(), resultToNow.GeometricTwap.String())
+				suite.Require().Equal(types.TwapMetadata{}, resultToNow.Metadata)
+			}
+		})
+	}
+}
+
+func (suite *QueryTestSuite) TestQueryTwapSpotPriceErrorInRange() {
+	suite.SetupTest()
+
+	var (
+		coins = sdk.NewCoins(
+			sdk.NewInt64Coin("tokenA", 1000),
+			sdk.NewInt64Coin("tokenB", 2000),
+		)
+		poolID       = suite.PrepareBalancerPoolWithCoins(coins...)
+		startTime    = suite.Ctx.BlockTime()
+		newBlockTime = startTime.Add(time.Hour)
+		routes       = []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: "tokenB"}}
+
+		// Set current block time one hour from initial.
+		ctx = suite.Ctx.WithBlockTime(newBlockTime)
+	)
+
+	// Record a spot price error at the time of the records created along with the pool.
+	records, err := suite.App.TwapKeeper.GetAllMostRecentRecordsForPool(suite.Ctx, poolID)
+	suite.Require().NoError(err)
+	for _, record := range records {
+		record.LastErrorTime = record.Time
+		suite.App.TwapKeeper.StoreNewRecord(suite.Ctx, record)
+	}
+
+	q := client.Querier{K: *suite.App.TwapKeeper}
+
+	_, err = q.ArithmeticTwap(ctx, queryproto.ArithmeticTwapRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime, IncludeMetadata: true})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.ArithmeticTwapToNow(ctx, queryproto.ArithmeticTwapToNowRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.GeometricTwap(ctx, queryproto.GeometricTwapRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime, IncludeMetadata: true})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.GeometricTwapToNow(ctx, queryproto.GeometricTwapToNowRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.RealizedVolatility(ctx, queryproto.RealizedVolatilityRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.TimeWeightedLiquidity(ctx, queryproto.TimeWeightedLiquidityRequest{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.AggregateTwap(ctx, queryproto.AggregateTwapRequest{PoolIds: []uint64{poolID}, BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	_, err = q.RouteTwap(ctx, queryproto.RouteTwapRequest{BaseAsset: "tokenA", Routes: routes, StartTime: startTime})
+	suite.Require().ErrorIs(err, types.ErrSpotPriceErrorInRange)
+
+	batchTwap, err := q.BatchTwap(ctx, queryproto.BatchTwapRequest{
+		Items:     []queryproto.BatchTwapItem{{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.Arithmetic}},
+		StartTime: startTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]queryproto.BatchTwapResult{{Twap: sdk.ZeroDec(), Error: types.ErrSpotPriceErrorInRange.Error()}}, batchTwap.Results)
+
+	// The spot price error is reported in the metadata of the pair.
+	metadata, err := suite.App.TwapKeeper.GetTwapMetadata(ctx, poolID, "tokenA", "tokenB", startTime, newBlockTime)
+	suite.Require().NoError(err)
+	suite.Require().True(metadata.LastErrorInRange)
+
+	// Aggregating all the pools of the pair leaves out the pool with the spot price error.
+	_, err = q.AggregateTwap(ctx, queryproto.AggregateTwapRequest{BaseAsset: "tokenA", QuoteAsset: "tokenB", StartTime: startTime})
+	suite.Require().Error(err)
+}
+
+func (suite *QueryTestSuite) TestQueryBatchTwap() {
+	suite.SetupTest()
+
+	var (
+		coins = sdk.NewCoins(
+			sdk.NewInt64Coin("tokenA", 1000),
+			sdk.NewInt64Coin("tokenB", 2000),
+		)
+		poolID       = suite.PrepareBalancerPoolWithCoins(coins...)
+		startTime    = suite.Ctx.BlockTime()
+		newBlockTime = startTime.Add(time.Hour)
+
+		// Set current block time one hour from initial.
+		ctx = suite.Ctx.WithBlockTime(newBlockTime)
+
+		expectedMetadata = types.TwapMetadata{
+			PoolId:              poolID,
+			RecordCount:         1,
+			OldestRecordTime:    startTime,
+			TimeSinceLastUpdate: time.Hour,
+			EndTimeInterpolated: true,
+		}
+	)
+
+	testCases := []struct {
+		name            string
+		items           []queryproto.BatchTwapItem
+		includeMetadata bool
+		expectedResults []queryproto.BatchTwapResult
+		expectErr       bool
+	}{
+		{
+			name: "arithmetic and geometric twaps",
+			items: []queryproto.BatchTwapItem{
+				{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.Arithmetic},
+				{PoolId: poolID, BaseAsset: "tokenB", QuoteAsset: "tokenA", Strategy: queryproto.Geometric},
+			},
+			includeMetadata: true,
+			expectedResults: []queryproto.BatchTwapResult{
+				{Twap: sdk.NewDec(2), Metadata: expectedMetadata},
+				{Twap: sdk.NewDecWithPrec(5, 1), Metadata: expectedMetadata},
+			},
+		},
+		{
+			name: "failing items do not fail the others",
+			items: []queryproto.BatchTwapItem{
+				{PoolId: poolID + 1, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.Arithmetic},
+				{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.Arithmetic},
+				{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenA", Strategy: queryproto.Arithmetic},
+				{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.TwapStrategy(2)},
+			},
+			includeMetadata: true,
+			expectedResults: []queryproto.BatchTwapResult{
+				{Twap: sdk.ZeroDec(), Error: "getTwapRecord: querying for assets tokenA tokenB that are not in pool id 2"},
+				{Twap: sdk.NewDec(2), Metadata: expectedMetadata},
+				{Twap: sdk.ZeroDec(), Error: "both assets cannot be of the same denom: assetA: tokenA, assetB: tokenA"},
+				{Twap: sdk.ZeroDec(), Error: "unknown twap strategy 2"},
+			},
+		},
+		{
+			name: "metadata is not included by default",
+			items: []queryproto.BatchTwapItem{
+				{PoolId: poolID, BaseAsset: "tokenA", QuoteAsset: "tokenB", Strategy: queryproto.Arithmetic},
+			},
+			expectedResults: []queryproto.BatchTwapResult{
+				{Twap: sdk.NewDec(2)},
+			},
+		},
+		{
+			name:            "no items",
+			items:           []queryproto.BatchTwapItem{},
+			expectedResults: []queryproto.BatchTwapResult{},
+		},
+		{
+			name:      "too many items",
+			items:     make([]queryproto.BatchTwapItem, types.MaxBatchTwapItems+1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			result, err := client.BatchTwap(ctx, queryproto.BatchTwapRequest{
+				Items:           tc.items,
+				StartTime:       startTime,
+				IncludeMetadata: tc.includeMetadata,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
 			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedResults, result.Results)
 		})
 	}
 }
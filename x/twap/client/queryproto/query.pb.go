@@ -16,6 +16,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types2 "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	types1 "github.com/osmosis-labs/osmosis/v16/x/twap/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -39,12 +40,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TwapStrategy is the type of TWAP computed for an item of a batch twap query.
+type TwapStrategy int32
+
+const (
+	Arithmetic TwapStrategy = 0
+	Geometric  TwapStrategy = 1
+)
+
+var TwapStrategy_name = map[int32]string{
+	0: "Arithmetic",
+	1: "Geometric",
+}
+
+var TwapStrategy_value = map[string]int32{
+	"Arithmetic": 0,
+	"Geometric":  1,
+}
+
+func (x TwapStrategy) String() string {
+	return proto.EnumName(TwapStrategy_name, int32(x))
+}
+
+func (TwapStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{0}
+}
+
 type ArithmeticTwapRequest struct {
 	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
 	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
 	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
 func (m *ArithmeticTwapRequest) Reset()         { *m = ArithmeticTwapRequest{} }
@@ -115,8 +145,16 @@ func (m *ArithmeticTwapRequest) GetEndTime() *time.Time {
 	return nil
 }
 
+func (m *ArithmeticTwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
 type ArithmeticTwapResponse struct {
 	ArithmeticTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
+	Metadata       types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ArithmeticTwapResponse) Reset()         { *m = ArithmeticTwapResponse{} }
@@ -152,11 +190,21 @@ func (m *ArithmeticTwapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArithmeticTwapResponse proto.InternalMessageInfo
 
+func (m *ArithmeticTwapResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.TwapMetadata{}
+}
+
 type ArithmeticTwapToNowRequest struct {
 	PoolId     uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	BaseAsset  string    `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
 	QuoteAsset string    `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	StartTime  time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,5,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
 func (m *ArithmeticTwapToNowRequest) Reset()         { *m = ArithmeticTwapToNowRequest{} }
@@ -220,8 +268,16 @@ func (m *ArithmeticTwapToNowRequest) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *ArithmeticTwapToNowRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
 type ArithmeticTwapToNowResponse struct {
 	ArithmeticTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
+	Metadata       types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ArithmeticTwapToNowResponse) Reset()         { *m = ArithmeticTwapToNowResponse{} }
@@ -257,12 +313,22 @@ func (m *ArithmeticTwapToNowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArithmeticTwapToNowResponse proto.InternalMessageInfo
 
+func (m *ArithmeticTwapToNowResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.TwapMetadata{}
+}
+
 type GeometricTwapRequest struct {
 	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
 	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
 	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
 func (m *GeometricTwapRequest) Reset()         { *m = GeometricTwapRequest{} }
@@ -333,8 +399,16 @@ func (m *GeometricTwapRequest) GetEndTime() *time.Time {
 	return nil
 }
 
+func (m *GeometricTwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
 type GeometricTwapResponse struct {
 	GeometricTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=geometric_twap,json=geometricTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_twap" yaml:"geometric_twap"`
+	Metadata      types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *GeometricTwapResponse) Reset()         { *m = GeometricTwapResponse{} }
@@ -370,11 +444,21 @@ func (m *GeometricTwapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeometricTwapResponse proto.InternalMessageInfo
 
+func (m *GeometricTwapResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.TwapMetadata{}
+}
+
 type GeometricTwapToNowRequest struct {
 	PoolId     uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	BaseAsset  string    `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
 	QuoteAsset string    `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	StartTime  time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,5,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
 func (m *GeometricTwapToNowRequest) Reset()         { *m = GeometricTwapToNowRequest{} }
@@ -438,8 +522,16 @@ func (m *GeometricTwapToNowRequest) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *GeometricTwapToNowRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
 type GeometricTwapToNowResponse struct {
 	GeometricTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=geometric_twap,json=geometricTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"geometric_twap" yaml:"geometric_twap"`
+	Metadata      types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *GeometricTwapToNowResponse) Reset()         { *m = GeometricTwapToNowResponse{} }
@@ -475,21 +567,36 @@ func (m *GeometricTwapToNowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeometricTwapToNowResponse proto.InternalMessageInfo
 
-type ParamsRequest struct {
+func (m *GeometricTwapToNowResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.TwapMetadata{}
 }
 
-func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
-func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*ParamsRequest) ProtoMessage()    {}
-func (*ParamsRequest) Descriptor() ([]byte, []int) {
+type RealizedVolatilityRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
+}
+
+func (m *RealizedVolatilityRequest) Reset()         { *m = RealizedVolatilityRequest{} }
+func (m *RealizedVolatilityRequest) String() string { return proto.CompactTextString(m) }
+func (*RealizedVolatilityRequest) ProtoMessage()    {}
+func (*RealizedVolatilityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_141a22dba58615af, []int{8}
 }
-func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *RealizedVolatilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RealizedVolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RealizedVolatilityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -499,34 +606,77 @@ func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsRequest.Merge(m, src)
+func (m *RealizedVolatilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RealizedVolatilityRequest.Merge(m, src)
 }
-func (m *ParamsRequest) XXX_Size() int {
+func (m *RealizedVolatilityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+func (m *RealizedVolatilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RealizedVolatilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_RealizedVolatilityRequest proto.InternalMessageInfo
 
-type ParamsResponse struct {
-	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *RealizedVolatilityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
-func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*ParamsResponse) ProtoMessage()    {}
-func (*ParamsResponse) Descriptor() ([]byte, []int) {
+func (m *RealizedVolatilityRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *RealizedVolatilityRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *RealizedVolatilityRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *RealizedVolatilityRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *RealizedVolatilityRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
+}
+
+type RealizedVolatilityResponse struct {
+	RealizedVolatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=realized_volatility,json=realizedVolatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_volatility" yaml:"realized_volatility"`
+	Metadata           types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *RealizedVolatilityResponse) Reset()         { *m = RealizedVolatilityResponse{} }
+func (m *RealizedVolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*RealizedVolatilityResponse) ProtoMessage()    {}
+func (*RealizedVolatilityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_141a22dba58615af, []int{9}
 }
-func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *RealizedVolatilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RealizedVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RealizedVolatilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -536,904 +686,5113 @@ func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ParamsResponse.Merge(m, src)
+func (m *RealizedVolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RealizedVolatilityResponse.Merge(m, src)
 }
-func (m *ParamsResponse) XXX_Size() int {
+func (m *RealizedVolatilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+func (m *RealizedVolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RealizedVolatilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_RealizedVolatilityResponse proto.InternalMessageInfo
 
-func (m *ParamsResponse) GetParams() types1.Params {
+func (m *RealizedVolatilityResponse) GetMetadata() types1.TwapMetadata {
 	if m != nil {
-		return m.Params
+		return m.Metadata
 	}
-	return types1.Params{}
+	return types1.TwapMetadata{}
 }
 
-func init() {
-	proto.RegisterType((*ArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapRequest")
-	proto.RegisterType((*ArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapResponse")
-	proto.RegisterType((*ArithmeticTwapToNowRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowRequest")
-	proto.RegisterType((*ArithmeticTwapToNowResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowResponse")
-	proto.RegisterType((*GeometricTwapRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapRequest")
-	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
-	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
-	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
+type AggregateTwapRequest struct {
+	// pool_ids are the pools whose TWAPs are aggregated. If empty, all the pools
+	// with a TWAP for the asset pair over the time range are aggregated.
+	PoolIds    []uint64   `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
-func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
-
-var fileDescriptor_141a22dba58615af = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xc7, 0x3d, 0x2e, 0x98, 0x7a, 0x10, 0x46, 0x9d, 0x02, 0x85, 0x05, 0x76, 0xad, 0x85, 0x22,
-	0x17, 0xc3, 0x2e, 0xa6, 0x52, 0x0f, 0xa8, 0x17, 0xac, 0x4a, 0xb4, 0x52, 0x55, 0xb5, 0x2b, 0x54,
-	0x55, 0xbd, 0x58, 0x63, 0x7b, 0xb2, 0xac, 0xe2, 0xdd, 0x59, 0xef, 0x8e, 0x21, 0xbe, 0xe6, 0x92,
-	0x43, 0x72, 0x40, 0x89, 0x72, 0xc8, 0x21, 0xb9, 0xe7, 0x90, 0xef, 0xc1, 0x29, 0x41, 0xca, 0x25,
-	0xca, 0xc1, 0x89, 0x20, 0x9f, 0x80, 0x4f, 0x10, 0xed, 0xcc, 0xac, 0xe3, 0x75, 0x56, 0xc4, 0x9c,
-	0x10, 0x52, 0x4e, 0x66, 0xde, 0xfb, 0xbf, 0xf7, 0x7e, 0xf3, 0x66, 0xdf, 0x0c, 0xb0, 0x48, 0x43,
-	0x97, 0x86, 0x4e, 0x68, 0xb2, 0x23, 0xec, 0x9b, 0x87, 0x95, 0x3a, 0x61, 0xb8, 0x62, 0xb6, 0x3b,
-	0x24, 0xe8, 0x1a, 0x7e, 0x40, 0x19, 0x45, 0x33, 0x52, 0x61, 0x44, 0x0a, 0x43, 0x2a, 0x94, 0x19,
-	0x9b, 0xda, 0x94, 0x0b, 0xcc, 0xe8, 0x2f, 0xa1, 0x55, 0xd6, 0x52, 0xb3, 0x45, 0x8b, 0x5a, 0x40,
-	0x1a, 0x34, 0x68, 0x4a, 0x9d, 0x9e, 0xaa, 0xb3, 0x89, 0x47, 0xa2, 0x42, 0x42, 0xa3, 0x36, 0xb8,
-	0xc8, 0xac, 0xe3, 0x90, 0xf4, 0x25, 0x0d, 0xea, 0x78, 0xd2, 0xbf, 0x3e, 0xe8, 0xe7, 0xc0, 0x7d,
-	0x95, 0x8f, 0x6d, 0xc7, 0xc3, 0xcc, 0xa1, 0xb1, 0x76, 0xc9, 0xa6, 0xd4, 0x6e, 0x11, 0x13, 0xfb,
-	0x8e, 0x89, 0x3d, 0x8f, 0x32, 0xee, 0x8c, 0x2b, 0x2d, 0x48, 0x2f, 0x5f, 0xd5, 0x3b, 0xb7, 0x4c,
-	0xec, 0x75, 0x63, 0x97, 0x28, 0x52, 0x13, 0x3b, 0x15, 0x0b, 0xe9, 0xd2, 0x86, 0xa3, 0x98, 0xe3,
-	0x92, 0x90, 0x61, 0xd7, 0x17, 0x02, 0xfd, 0x59, 0x16, 0xce, 0xee, 0x06, 0x0e, 0x3b, 0x70, 0x09,
-	0x73, 0x1a, 0xfb, 0x47, 0xd8, 0xb7, 0x48, 0xbb, 0x43, 0x42, 0x86, 0x7e, 0x80, 0x13, 0x3e, 0xa5,
-	0xad, 0x9a, 0xd3, 0x9c, 0x07, 0x45, 0x50, 0x1a, 0xb3, 0x72, 0xd1, 0xf2, 0x8f, 0x26, 0x5a, 0x86,
-	0x30, 0xda, 0x4e, 0x0d, 0x87, 0x21, 0x61, 0xf3, 0xd9, 0x22, 0x28, 0xe5, 0xad, 0x7c, 0x64, 0xd9,
-	0x8d, 0x0c, 0x48, 0x83, 0x93, 0xed, 0x0e, 0x65, 0xb1, 0xff, 0x1b, 0xee, 0x87, 0xdc, 0x24, 0x04,
-	0xff, 0x41, 0x18, 0x32, 0x1c, 0xb0, 0x5a, 0xc4, 0x32, 0x3f, 0x56, 0x04, 0xa5, 0xc9, 0x6d, 0xc5,
-	0x10, 0xa0, 0x46, 0x0c, 0x6a, 0xec, 0xc7, 0xa0, 0xd5, 0xe5, 0x93, 0x9e, 0x96, 0xb9, 0xe8, 0x69,
-	0xdf, 0x75, 0xb1, 0xdb, 0xda, 0xd1, 0x3f, 0xc5, 0xea, 0xc7, 0xef, 0x34, 0x60, 0xe5, 0xb9, 0x21,
-	0x92, 0x23, 0x0b, 0x7e, 0x4b, 0xbc, 0xa6, 0xc8, 0x3b, 0xfe, 0xc5, 0xbc, 0x8b, 0x27, 0x3d, 0x0d,
-	0x5c, 0xf4, 0xb4, 0x69, 0x91, 0x37, 0x8e, 0x14, 0x59, 0x27, 0x88, 0xd7, 0x8c, 0xa4, 0xfa, 0x7d,
-	0x00, 0xe7, 0x86, 0x1b, 0x14, 0xfa, 0xd4, 0x0b, 0x09, 0x6a, 0xc3, 0x69, 0xdc, 0xf7, 0xd4, 0xa2,
-	0xaf, 0x84, 0x77, 0x2a, 0x5f, 0xfd, 0x3d, 0x22, 0x7e, 0xdb, 0xd3, 0xd6, 0x6c, 0x87, 0x1d, 0x74,
-	0xea, 0x46, 0x83, 0xba, 0xf2, 0x58, 0xe4, 0xcf, 0x66, 0xd8, 0xbc, 0x6d, 0xb2, 0xae, 0x4f, 0x42,
-	0xe3, 0x37, 0xd2, 0xb8, 0xe8, 0x69, 0x73, 0x82, 0x61, 0x28, 0x9d, 0x6e, 0x15, 0x70, 0xa2, 0xb4,
-	0xfe, 0x0a, 0x40, 0x25, 0x49, 0xb3, 0x4f, 0xff, 0xa2, 0x47, 0x37, 0xf7, 0xcc, 0xf4, 0x63, 0x00,
-	0x17, 0x53, 0x77, 0x74, 0x7d, 0x4d, 0x7e, 0x9a, 0x85, 0x33, 0x7b, 0x84, 0xba, 0x84, 0x05, 0x5f,
-	0x47, 0x22, 0x65, 0x24, 0xee, 0x01, 0x38, 0x3b, 0xd4, 0x1f, 0x79, 0x58, 0x1e, 0x2c, 0xd8, 0xb1,
-	0x63, 0xf0, 0xac, 0xf6, 0xae, 0x7c, 0x56, 0xb3, 0x82, 0x20, 0x99, 0x4d, 0xb7, 0xa6, 0xec, 0xc1,
-	0xba, 0xfa, 0x4b, 0x00, 0x17, 0x12, 0x24, 0x37, 0x7d, 0x1a, 0x1e, 0x00, 0xa8, 0xa4, 0x6d, 0xe8,
-	0x9a, 0xfa, 0x3b, 0x0d, 0xa7, 0xfe, 0xc6, 0x01, 0x76, 0x43, 0xd9, 0x52, 0xfd, 0x4f, 0x58, 0x88,
-	0x0d, 0x12, 0x69, 0x07, 0xe6, 0x7c, 0x6e, 0xe1, 0x28, 0x93, 0xdb, 0x4b, 0x46, 0xda, 0x53, 0x6c,
-	0x88, 0xa8, 0xea, 0x58, 0x04, 0x6a, 0xc9, 0x88, 0xed, 0x87, 0x39, 0x38, 0xfe, 0x4f, 0xf4, 0x28,
-	0xa2, 0x2e, 0xcc, 0x09, 0x05, 0x5a, 0xb9, 0x2c, 0x5e, 0x62, 0x28, 0xab, 0x97, 0x8b, 0x04, 0x9a,
-	0xbe, 0x7a, 0xf7, 0xf5, 0x87, 0x47, 0x59, 0x15, 0x2d, 0x99, 0xa9, 0x2f, 0xb9, 0x2c, 0xf8, 0x04,
-	0xc0, 0x42, 0xf2, 0x02, 0x42, 0xe5, 0xf4, 0xf4, 0xa9, 0xef, 0xa4, 0xb2, 0x31, 0x9a, 0x58, 0x32,
-	0x6d, 0x70, 0xa6, 0x35, 0xb4, 0x9a, 0xce, 0x34, 0x04, 0xf2, 0x02, 0xc0, 0xef, 0x53, 0x2e, 0x47,
-	0xb4, 0x35, 0x4a, 0xcd, 0xc1, 0x59, 0x50, 0x2a, 0x57, 0x88, 0x90, 0xa8, 0x15, 0x8e, 0x5a, 0x46,
-	0x3f, 0x8d, 0x82, 0x2a, 0xb8, 0x1e, 0x03, 0x38, 0x95, 0xf8, 0x7c, 0xd1, 0x7a, 0x7a, 0xdd, 0xb4,
-	0xeb, 0x55, 0x29, 0x8f, 0xa4, 0x95, 0x74, 0x65, 0x4e, 0xf7, 0x23, 0x5a, 0x49, 0xa7, 0x4b, 0x52,
-	0x3c, 0x07, 0x10, 0x7d, 0x3e, 0x56, 0xc8, 0x1c, 0xa1, 0x60, 0xa2, 0x8b, 0x5b, 0xa3, 0x07, 0x48,
-	0xcc, 0x2d, 0x8e, 0xb9, 0x8e, 0x4a, 0x23, 0x60, 0xf2, 0xc8, 0xea, 0xbf, 0x27, 0x67, 0x2a, 0x38,
-	0x3d, 0x53, 0xc1, 0xfb, 0x33, 0x15, 0x1c, 0x9f, 0xab, 0x99, 0xd3, 0x73, 0x35, 0xf3, 0xe6, 0x5c,
-	0xcd, 0xfc, 0xff, 0xeb, 0xc0, 0x74, 0xcb, 0x6c, 0x9b, 0x2d, 0x5c, 0x0f, 0xfb, 0xa9, 0x0f, 0x2b,
-	0xbf, 0x98, 0x77, 0x44, 0x81, 0x46, 0xcb, 0x21, 0x1e, 0x13, 0xff, 0x72, 0x8a, 0x1b, 0x29, 0xc7,
-	0x7f, 0x7e, 0xfe, 0x18, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xf8, 0x39, 0xb1, 0x4d, 0x0b, 0x00, 0x00,
+func (m *AggregateTwapRequest) Reset()         { *m = AggregateTwapRequest{} }
+func (m *AggregateTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateTwapRequest) ProtoMessage()    {}
+func (*AggregateTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error)
-	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
-	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
-	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+func (m *AggregateTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *AggregateTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *AggregateTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateTwapRequest.Merge(m, src)
+}
+func (m *AggregateTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateTwapRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AggregateTwapRequest proto.InternalMessageInfo
+
+func (m *AggregateTwapRequest) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error) {
-	out := new(ArithmeticTwapResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AggregateTwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error) {
-	out := new(ArithmeticTwapToNowResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AggregateTwapRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error) {
-	out := new(GeometricTwapResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AggregateTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error) {
-	out := new(GeometricTwapToNowResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwapToNow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AggregateTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	ArithmeticTwap(context.Context, *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error)
-	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
-	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
-	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+func (m *AggregateTwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type AggregateTwapResponse struct {
+	AggregateTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=aggregate_twap,json=aggregateTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"aggregate_twap" yaml:"aggregate_twap"`
+	// metadata holds the metadata of every aggregated pool.
+	Metadata []types1.TwapMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *AggregateTwapResponse) Reset()         { *m = AggregateTwapResponse{} }
+func (m *AggregateTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregateTwapResponse) ProtoMessage()    {}
+func (*AggregateTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{11}
 }
-func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+func (m *AggregateTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ArithmeticTwapToNow(ctx context.Context, req *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwapToNow not implemented")
+func (m *AggregateTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *GeometricTwapRequest) (*GeometricTwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwap not implemented")
+func (m *AggregateTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateTwapResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
+func (m *AggregateTwapResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *AggregateTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateTwapResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_AggregateTwapResponse proto.InternalMessageInfo
+
+func (m *AggregateTwapResponse) GetMetadata() []types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArithmeticTwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ArithmeticTwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*ArithmeticTwapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type RouteTwapRequest struct {
+	BaseAsset string `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	// routes are the hops from the base asset to the quote asset, which is the
+	// token out denom of the last hop.
+	Routes    []types2.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	StartTime time.Time                  `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   *time.Time                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,5,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
-func _Query_ArithmeticTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArithmeticTwapToNowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ArithmeticTwapToNow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ArithmeticTwapToNow(ctx, req.(*ArithmeticTwapToNowRequest))
+func (m *RouteTwapRequest) Reset()         { *m = RouteTwapRequest{} }
+func (m *RouteTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*RouteTwapRequest) ProtoMessage()    {}
+func (*RouteTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{12}
+}
+func (m *RouteTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RouteTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteTwapRequest.Merge(m, src)
+}
+func (m *RouteTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteTwapRequest.DiscardUnknown(m)
 }
 
-func _Query_GeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeometricTwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeometricTwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeometricTwap(ctx, req.(*GeometricTwapRequest))
+var xxx_messageInfo_RouteTwapRequest proto.InternalMessageInfo
+
+func (m *RouteTwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeometricTwapToNowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeometricTwapToNow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwapToNow",
+func (m *RouteTwapRequest) GetRoutes() []types2.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeometricTwapToNow(ctx, req.(*GeometricTwapToNowRequest))
+	return nil
+}
+
+func (m *RouteTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.twap.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ArithmeticTwap",
-			Handler:    _Query_ArithmeticTwap_Handler,
-		},
-		{
-			MethodName: "ArithmeticTwapToNow",
-			Handler:    _Query_ArithmeticTwapToNow_Handler,
-		},
-		{
-			MethodName: "GeometricTwap",
-			Handler:    _Query_GeometricTwap_Handler,
-		},
-		{
-			MethodName: "GeometricTwapToNow",
-			Handler:    _Query_GeometricTwapToNow_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/twap/v1beta1/query.proto",
+func (m *RouteTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (m *ArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RouteTwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RouteTwapResponse struct {
+	RouteTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=route_twap,json=routeTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"route_twap" yaml:"route_twap"`
+	// metadata holds the metadata of every hop of the route.
+	Metadata []types1.TwapMetadata `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *ArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
-		if err1 != nil {
-			return 0, err1
+func (m *RouteTwapResponse) Reset()         { *m = RouteTwapResponse{} }
+func (m *RouteTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*RouteTwapResponse) ProtoMessage()    {}
+func (*RouteTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{13}
+}
+func (m *RouteTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintQuery(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RouteTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteTwapResponse.Merge(m, src)
+}
+func (m *RouteTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteTwapResponse.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RouteTwapResponse proto.InternalMessageInfo
+
+func (m *RouteTwapResponse) GetMetadata() []types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ParamsRequest struct {
 }
 
-func (m *ArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ArithmeticTwap.Size()
-		i -= size
-		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{14}
 }
-
-func (m *ArithmeticTwapToNowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ArithmeticTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{15}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapToNowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() types1.Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return types1.Params{}
 }
 
-func (m *ArithmeticTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TimeWeightedLiquidityRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
-func (m *ArithmeticTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ArithmeticTwap.Size()
-		i -= size
-		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TimeWeightedLiquidityRequest) Reset()         { *m = TimeWeightedLiquidityRequest{} }
+func (m *TimeWeightedLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*TimeWeightedLiquidityRequest) ProtoMessage()    {}
+func (*TimeWeightedLiquidityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{16}
+}
+func (m *TimeWeightedLiquidityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWeightedLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWeightedLiquidityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *TimeWeightedLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWeightedLiquidityRequest.Merge(m, src)
+}
+func (m *TimeWeightedLiquidityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWeightedLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWeightedLiquidityRequest.DiscardUnknown(m)
 }
 
-func (m *GeometricTwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeWeightedLiquidityRequest proto.InternalMessageInfo
+
+func (m *TimeWeightedLiquidityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TimeWeightedLiquidityRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
 }
 
-func (m *GeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintQuery(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
+func (m *TimeWeightedLiquidityRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
 	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TimeWeightedLiquidityRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return time.Time{}
+}
+
+func (m *TimeWeightedLiquidityRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GeometricTwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimeWeightedLiquidityRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TimeWeightedLiquidityResponse struct {
+	// time_weighted_liquidity is the value of the pool's base and quote asset
+	// reserves, in units of the quote asset, averaged over the time range.
+	TimeWeightedLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=time_weighted_liquidity,json=timeWeightedLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"time_weighted_liquidity" yaml:"time_weighted_liquidity"`
+	Metadata              types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *GeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GeometricTwap.Size()
-		i -= size
-		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TimeWeightedLiquidityResponse) Reset()         { *m = TimeWeightedLiquidityResponse{} }
+func (m *TimeWeightedLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*TimeWeightedLiquidityResponse) ProtoMessage()    {}
+func (*TimeWeightedLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{17}
+}
+func (m *TimeWeightedLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWeightedLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWeightedLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *TimeWeightedLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWeightedLiquidityResponse.Merge(m, src)
+}
+func (m *TimeWeightedLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWeightedLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWeightedLiquidityResponse.DiscardUnknown(m)
 }
 
-func (m *GeometricTwapToNowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeWeightedLiquidityResponse proto.InternalMessageInfo
+
+func (m *TimeWeightedLiquidityResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return types1.TwapMetadata{}
 }
 
-func (m *GeometricTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VwapRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result, which
+	// iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,6,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
 }
 
-func (m *GeometricTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+func (m *VwapRequest) Reset()         { *m = VwapRequest{} }
+func (m *VwapRequest) String() string { return proto.CompactTextString(m) }
+func (*VwapRequest) ProtoMessage()    {}
+func (*VwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{18}
+}
+func (m *VwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *VwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VwapRequest.Merge(m, src)
+}
+func (m *VwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VwapRequest proto.InternalMessageInfo
+
+func (m *VwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *VwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GeometricTwapToNowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VwapRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GeometricTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.GeometricTwap.Size()
-		i -= size
-		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VwapResponse struct {
+	Vwap     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=vwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vwap" yaml:"vwap"`
+	Metadata types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *VwapResponse) Reset()         { *m = VwapResponse{} }
+func (m *VwapResponse) String() string { return proto.CompactTextString(m) }
+func (*VwapResponse) ProtoMessage()    {}
+func (*VwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{19}
+}
+func (m *VwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VwapResponse.Merge(m, src)
+}
+func (m *VwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VwapResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VwapResponse proto.InternalMessageInfo
+
+func (m *VwapResponse) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return types1.TwapMetadata{}
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BatchTwapItem struct {
+	PoolId     uint64       `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string       `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string       `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Strategy   TwapStrategy `protobuf:"varint,4,opt,name=strategy,proto3,enum=osmosis.twap.v1beta1.TwapStrategy" json:"strategy,omitempty"`
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *BatchTwapItem) Reset()         { *m = BatchTwapItem{} }
+func (m *BatchTwapItem) String() string { return proto.CompactTextString(m) }
+func (*BatchTwapItem) ProtoMessage()    {}
+func (*BatchTwapItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{20}
+}
+func (m *BatchTwapItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTwapItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTwapItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BatchTwapItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTwapItem.Merge(m, src)
+}
+func (m *BatchTwapItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTwapItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTwapItem.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BatchTwapItem proto.InternalMessageInfo
+
+func (m *BatchTwapItem) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *ArithmeticTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *BatchTwapItem) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
 	}
-	return n
+	return ""
 }
 
-func (m *ArithmeticTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTwapItem) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
 	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ArithmeticTwapToNowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *BatchTwapItem) GetStrategy() TwapStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return Arithmetic
+}
+
+type BatchTwapRequest struct {
+	Items     []BatchTwapItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	StartTime time.Time       `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   *time.Time      `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// include_metadata is whether to return the metadata of the result of every
+	// item, which iterates over all the records of the time range.
+	IncludeMetadata bool `protobuf:"varint,4,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty" yaml:"include_metadata"`
+}
+
+func (m *BatchTwapRequest) Reset()         { *m = BatchTwapRequest{} }
+func (m *BatchTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTwapRequest) ProtoMessage()    {}
+func (*BatchTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{21}
+}
+func (m *BatchTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *BatchTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTwapRequest.Merge(m, src)
+}
+func (m *BatchTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTwapRequest.DiscardUnknown(m)
 }
 
-func (m *ArithmeticTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BatchTwapRequest proto.InternalMessageInfo
+
+func (m *BatchTwapRequest) GetItems() []BatchTwapItem {
+	if m != nil {
+		return m.Items
 	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *GeometricTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	return time.Time{}
+}
+
+func (m *BatchTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *BatchTwapRequest) GetIncludeMetadata() bool {
+	if m != nil {
+		return m.IncludeMetadata
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+// BatchTwapResult is the result of an item of a batch twap query. If the twap
+// could not be computed, error is set and twap and metadata are empty.
+// Otherwise, metadata is only set if include_metadata is set in the request.
+type BatchTwapResult struct {
+	Twap     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap" yaml:"twap"`
+	Metadata types1.TwapMetadata                    `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+	Error    string                                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchTwapResult) Reset()         { *m = BatchTwapResult{} }
+func (m *BatchTwapResult) String() string { return proto.CompactTextString(m) }
+func (*BatchTwapResult) ProtoMessage()    {}
+func (*BatchTwapResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{22}
+}
+func (m *BatchTwapResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTwapResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTwapResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *BatchTwapResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTwapResult.Merge(m, src)
+}
+func (m *BatchTwapResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTwapResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTwapResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTwapResult proto.InternalMessageInfo
+
+func (m *BatchTwapResult) GetMetadata() types1.TwapMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return types1.TwapMetadata{}
 }
 
-func (m *GeometricTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchTwapResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	l = m.GeometricTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *GeometricTwapToNowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type BatchTwapResponse struct {
+	// results holds the result of every item, in the order of the request.
+	Results []BatchTwapResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *BatchTwapResponse) Reset()         { *m = BatchTwapResponse{} }
+func (m *BatchTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchTwapResponse) ProtoMessage()    {}
+func (*BatchTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{23}
+}
+func (m *BatchTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+}
+func (m *BatchTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTwapResponse.Merge(m, src)
+}
+func (m *BatchTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTwapResponse proto.InternalMessageInfo
+
+func (m *BatchTwapResponse) GetResults() []BatchTwapResult {
+	if m != nil {
+		return m.Results
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.twap.v1beta1.TwapStrategy", TwapStrategy_name, TwapStrategy_value)
+	proto.RegisterType((*ArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapRequest")
+	proto.RegisterType((*ArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapResponse")
+	proto.RegisterType((*ArithmeticTwapToNowRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowRequest")
+	proto.RegisterType((*ArithmeticTwapToNowResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapToNowResponse")
+	proto.RegisterType((*GeometricTwapRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapRequest")
+	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
+	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
+	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
+	proto.RegisterType((*RealizedVolatilityRequest)(nil), "osmosis.twap.v1beta1.RealizedVolatilityRequest")
+	proto.RegisterType((*RealizedVolatilityResponse)(nil), "osmosis.twap.v1beta1.RealizedVolatilityResponse")
+	proto.RegisterType((*AggregateTwapRequest)(nil), "osmosis.twap.v1beta1.AggregateTwapRequest")
+	proto.RegisterType((*AggregateTwapResponse)(nil), "osmosis.twap.v1beta1.AggregateTwapResponse")
+	proto.RegisterType((*RouteTwapRequest)(nil), "osmosis.twap.v1beta1.RouteTwapRequest")
+	proto.RegisterType((*RouteTwapResponse)(nil), "osmosis.twap.v1beta1.RouteTwapResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
+	proto.RegisterType((*TimeWeightedLiquidityRequest)(nil), "osmosis.twap.v1beta1.TimeWeightedLiquidityRequest")
+	proto.RegisterType((*TimeWeightedLiquidityResponse)(nil), "osmosis.twap.v1beta1.TimeWeightedLiquidityResponse")
+	proto.RegisterType((*VwapRequest)(nil), "osmosis.twap.v1beta1.VwapRequest")
+	proto.RegisterType((*VwapResponse)(nil), "osmosis.twap.v1beta1.VwapResponse")
+	proto.RegisterType((*BatchTwapItem)(nil), "osmosis.twap.v1beta1.BatchTwapItem")
+	proto.RegisterType((*BatchTwapRequest)(nil), "osmosis.twap.v1beta1.BatchTwapRequest")
+	proto.RegisterType((*BatchTwapResult)(nil), "osmosis.twap.v1beta1.BatchTwapResult")
+	proto.RegisterType((*BatchTwapResponse)(nil), "osmosis.twap.v1beta1.BatchTwapResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
+
+var fileDescriptor_141a22dba58615af = []byte{
+	// 1523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0x6c, 0x9c, 0xbf, 0x97, 0x3a, 0x49, 0xa7, 0x49, 0x9b, 0x6e, 0x53, 0x3b, 0xdd, 0xb6,
+	0x69, 0x48, 0x5a, 0x3b, 0x3f, 0x12, 0x87, 0x8a, 0x1f, 0xc5, 0x14, 0x4a, 0xa5, 0x80, 0xda, 0x6d,
+	0x15, 0x50, 0x2f, 0xd6, 0xc4, 0x1e, 0x36, 0x2b, 0xec, 0x5d, 0x67, 0x77, 0x9c, 0x60, 0x24, 0x2e,
+	0x48, 0x48, 0x3d, 0x16, 0x21, 0x40, 0x9c, 0x39, 0x00, 0x07, 0x8e, 0x1c, 0x91, 0x38, 0x96, 0x03,
+	0x52, 0x01, 0x09, 0x21, 0x84, 0x0c, 0xb4, 0xc0, 0x01, 0x09, 0x09, 0x45, 0xe2, 0xc6, 0x01, 0xed,
+	0xcc, 0xec, 0x66, 0xbd, 0x5d, 0x27, 0x76, 0x64, 0x1f, 0x2a, 0xe5, 0x64, 0xef, 0xbc, 0xef, 0xbd,
+	0xf7, 0xbd, 0x37, 0x9f, 0x67, 0x76, 0xc6, 0x30, 0x6d, 0xbb, 0x65, 0xdb, 0x35, 0xdd, 0x2c, 0xdb,
+	0x26, 0x95, 0xec, 0xd6, 0xe2, 0x3a, 0x65, 0x64, 0x31, 0xbb, 0x59, 0xa5, 0x4e, 0x2d, 0x53, 0x71,
+	0x6c, 0x66, 0xe3, 0x71, 0x89, 0xc8, 0x78, 0x88, 0x8c, 0x44, 0xa8, 0xe3, 0x86, 0x6d, 0xd8, 0x1c,
+	0x90, 0xf5, 0xbe, 0x09, 0xac, 0x3a, 0x13, 0x1b, 0xcd, 0x7b, 0xc8, 0x3b, 0xb4, 0x60, 0x3b, 0x45,
+	0x89, 0xd3, 0x62, 0x71, 0x06, 0xb5, 0xa8, 0x97, 0x48, 0x60, 0x2e, 0xfa, 0x98, 0x8a, 0x6d, 0x97,
+	0xca, 0xc4, 0x22, 0x06, 0x75, 0x02, 0xa8, 0xcb, 0x43, 0xda, 0x55, 0x46, 0x25, 0x3a, 0x55, 0xe0,
+	0xf0, 0xec, 0x3a, 0x71, 0x69, 0x80, 0x2a, 0xd8, 0xa6, 0x25, 0xed, 0x73, 0x61, 0x3b, 0x2f, 0x2f,
+	0x40, 0x55, 0x88, 0x61, 0x5a, 0x84, 0x99, 0xb6, 0x8f, 0x9d, 0x32, 0x6c, 0xdb, 0x28, 0xd1, 0x2c,
+	0xa9, 0x98, 0x59, 0x62, 0x59, 0x36, 0xe3, 0x46, 0x9f, 0xd7, 0x49, 0x69, 0xe5, 0x4f, 0xeb, 0xd5,
+	0xd7, 0xb2, 0xc4, 0xaa, 0xf9, 0x26, 0x91, 0x24, 0x2f, 0xfa, 0x22, 0x1e, 0xa4, 0x29, 0x1d, 0xf5,
+	0x62, 0x66, 0x99, 0xba, 0x8c, 0x94, 0x2b, 0x02, 0xa0, 0xfd, 0xa9, 0xc0, 0xc4, 0x8a, 0x63, 0xb2,
+	0x8d, 0x32, 0x65, 0x66, 0xe1, 0xd6, 0x36, 0xa9, 0xe8, 0x74, 0xb3, 0x4a, 0x5d, 0x86, 0x4f, 0xc0,
+	0x80, 0xd7, 0x82, 0xbc, 0x59, 0x9c, 0x44, 0xd3, 0x68, 0x36, 0xa1, 0xf7, 0x7b, 0x8f, 0xd7, 0x8a,
+	0xf8, 0x34, 0x80, 0x57, 0x4e, 0x9e, 0xb8, 0x2e, 0x65, 0x93, 0xca, 0x34, 0x9a, 0x1d, 0xd2, 0x87,
+	0xbc, 0x91, 0x15, 0x6f, 0x00, 0xa7, 0x61, 0x78, 0xb3, 0x6a, 0x33, 0xdf, 0xde, 0xcb, 0xed, 0xc0,
+	0x87, 0x04, 0xe0, 0x55, 0x00, 0x97, 0x11, 0x87, 0xe5, 0x3d, 0x2e, 0x93, 0x89, 0x69, 0x34, 0x3b,
+	0xbc, 0xa4, 0x66, 0x04, 0xd1, 0x8c, 0x4f, 0x34, 0x73, 0xcb, 0x27, 0x9a, 0x3b, 0x7d, 0xaf, 0x9e,
+	0xee, 0xd9, 0xa9, 0xa7, 0x8f, 0xd6, 0x48, 0xb9, 0x74, 0x59, 0xdb, 0xf5, 0xd5, 0xee, 0xfe, 0x92,
+	0x46, 0xfa, 0x10, 0x1f, 0xf0, 0xe0, 0x58, 0x87, 0x41, 0x6a, 0x15, 0x45, 0xdc, 0xbe, 0x7d, 0xe3,
+	0x9e, 0xba, 0x57, 0x4f, 0xa3, 0x9d, 0x7a, 0x7a, 0x54, 0xc4, 0xf5, 0x3d, 0x45, 0xd4, 0x01, 0x6a,
+	0x15, 0x79, 0xcc, 0x17, 0x60, 0xcc, 0xb4, 0x0a, 0xa5, 0x6a, 0x91, 0xe6, 0xcb, 0x94, 0x91, 0x22,
+	0x61, 0x64, 0xb2, 0x7f, 0x1a, 0xcd, 0x0e, 0xe6, 0x4e, 0xed, 0xd4, 0xd3, 0x27, 0x84, 0x6f, 0x14,
+	0xa1, 0xe9, 0xa3, 0x72, 0xe8, 0x25, 0x7f, 0xe4, 0x5b, 0x04, 0xc7, 0xa3, 0x8d, 0x76, 0x2b, 0xb6,
+	0xe5, 0x52, 0xbc, 0x09, 0xa3, 0x24, 0xb0, 0xe4, 0x3d, 0x6d, 0xf2, 0x8e, 0x0f, 0xe5, 0x5e, 0xf4,
+	0x2a, 0xff, 0xa9, 0x9e, 0x9e, 0x31, 0x4c, 0xb6, 0x51, 0x5d, 0xcf, 0x14, 0xec, 0xb2, 0x9c, 0x5e,
+	0xf9, 0x71, 0xc9, 0x2d, 0xbe, 0x9e, 0x65, 0xb5, 0x0a, 0x75, 0x33, 0x57, 0x68, 0x61, 0xa7, 0x9e,
+	0x3e, 0x2e, 0xf8, 0x44, 0xc2, 0x69, 0xfa, 0x08, 0x69, 0x48, 0x8d, 0xaf, 0xc0, 0x60, 0x50, 0x8d,
+	0xc2, 0x3b, 0xa5, 0x65, 0xe2, 0x7e, 0x70, 0x19, 0x0f, 0xed, 0xd7, 0x90, 0x4b, 0x78, 0x7c, 0xf4,
+	0xc0, 0x53, 0xfb, 0x50, 0x01, 0xb5, 0xb1, 0xa6, 0x5b, 0xf6, 0xcb, 0xf6, 0xf6, 0x63, 0xac, 0xa0,
+	0xb8, 0xd9, 0xee, 0x3b, 0xc0, 0x6c, 0xff, 0x80, 0xe0, 0x54, 0x6c, 0x67, 0x1e, 0xf7, 0x29, 0xff,
+	0x43, 0x81, 0xf1, 0xab, 0xd4, 0x2e, 0x53, 0xe6, 0x1c, 0x2e, 0x17, 0x5d, 0x5c, 0x2e, 0xbe, 0x41,
+	0x30, 0x11, 0xe9, 0xb3, 0x94, 0x8e, 0x05, 0x23, 0x86, 0x6f, 0x08, 0x2b, 0xe7, 0x6a, 0xdb, 0xca,
+	0x99, 0x10, 0x6c, 0x1a, 0xa3, 0x69, 0x7a, 0xd2, 0x08, 0xe7, 0xed, 0x90, 0x6e, 0x3e, 0x50, 0xe0,
+	0x64, 0x43, 0x3d, 0x87, 0x2b, 0x85, 0x18, 0xf9, 0x0e, 0x81, 0x1a, 0xd7, 0x98, 0xc7, 0x7a, 0xb6,
+	0xff, 0x52, 0xe0, 0xa4, 0x4e, 0x49, 0xc9, 0x7c, 0x93, 0x16, 0xd7, 0xec, 0x12, 0x61, 0x66, 0xc9,
+	0x64, 0xb5, 0xc3, 0xa5, 0xa2, 0x2b, 0x4b, 0xc5, 0x6f, 0x08, 0xd4, 0xb8, 0x66, 0x4b, 0x05, 0xbd,
+	0x05, 0xc7, 0x1c, 0x69, 0xcd, 0x6f, 0x05, 0x66, 0x29, 0xa3, 0xd5, 0xb6, 0x65, 0xa4, 0x0a, 0x5e,
+	0x31, 0x21, 0x35, 0x1d, 0x3b, 0x8f, 0xd0, 0xe8, 0x90, 0xa0, 0xfe, 0x53, 0x60, 0x7c, 0xc5, 0x30,
+	0x1c, 0x6a, 0x10, 0x46, 0xc3, 0xdb, 0x4e, 0x06, 0x06, 0xa5, 0x96, 0xdc, 0x49, 0x34, 0xdd, 0x3b,
+	0x9b, 0xc8, 0x1d, 0xdb, 0x6d, 0xbc, 0x6f, 0xd1, 0xf4, 0x01, 0xa1, 0x30, 0xf7, 0x50, 0x62, 0x07,
+	0xde, 0x8d, 0x22, 0xed, 0xdf, 0x5d, 0x9f, 0x88, 0x6f, 0xe8, 0xc8, 0xfa, 0xd4, 0x18, 0x4d, 0xd3,
+	0x93, 0x24, 0x9c, 0x37, 0x22, 0xa7, 0xde, 0x03, 0xca, 0xe9, 0x5f, 0x05, 0xc6, 0x74, 0xef, 0x18,
+	0x17, 0x96, 0x52, 0xa3, 0x34, 0x50, 0x54, 0x1a, 0xab, 0xd0, 0xcf, 0x4f, 0x7e, 0xae, 0xcc, 0x9b,
+	0x09, 0xf2, 0x86, 0x4e, 0x8a, 0x41, 0xfa, 0x9b, 0xdb, 0xa4, 0xb2, 0x52, 0xb6, 0xab, 0x16, 0xbb,
+	0x66, 0xf1, 0x4c, 0x92, 0x83, 0x8c, 0x11, 0xd1, 0x51, 0x6f, 0x97, 0x74, 0x94, 0xe8, 0xa2, 0x8e,
+	0x0e, 0xb2, 0xd9, 0x7d, 0x89, 0xe0, 0x68, 0xa8, 0xef, 0x52, 0x43, 0xeb, 0x00, 0xbc, 0x2b, 0x61,
+	0xfd, 0x3c, 0xd7, 0xb6, 0x7e, 0x64, 0x67, 0x76, 0x23, 0x69, 0xfa, 0x90, 0x63, 0x57, 0x3b, 0xaa,
+	0x9b, 0x51, 0x48, 0x5e, 0x27, 0x0e, 0x29, 0xbb, 0x52, 0x33, 0xda, 0x2a, 0x8c, 0xf8, 0x03, 0xb2,
+	0x98, 0xcb, 0xd0, 0x5f, 0xe1, 0x23, 0xbc, 0x90, 0xe1, 0xa5, 0xa9, 0xf8, 0x34, 0xc2, 0xcb, 0x17,
+	0x85, 0xf0, 0xd0, 0xfe, 0x56, 0x60, 0xca, 0xeb, 0xf7, 0x2b, 0xd4, 0x34, 0x36, 0x18, 0x2d, 0xae,
+	0x9a, 0x9b, 0x55, 0xb3, 0x78, 0xb8, 0x73, 0x76, 0x6b, 0x59, 0xfb, 0x07, 0xc1, 0xe9, 0x26, 0xfd,
+	0x96, 0xb3, 0x79, 0x07, 0xc1, 0x09, 0x8f, 0x40, 0x7e, 0x5b, 0x42, 0xf2, 0x25, 0x1f, 0x23, 0x85,
+	0x7a, 0xbd, 0x6d, 0xa1, 0xa6, 0x04, 0xbf, 0x26, 0x61, 0x35, 0x7d, 0x82, 0xc5, 0x51, 0xea, 0xd0,
+	0x46, 0xfa, 0xb3, 0x02, 0xc3, 0x6b, 0x87, 0xc7, 0xb6, 0x6e, 0x29, 0xea, 0x13, 0x04, 0x47, 0xd6,
+	0xc2, 0x6b, 0xdb, 0x0d, 0x48, 0x6c, 0xed, 0xae, 0x6a, 0x4f, 0xb7, 0x2d, 0x96, 0x61, 0x91, 0x7a,
+	0x8b, 0xaf, 0x67, 0x3c, 0x54, 0x87, 0x84, 0xf0, 0x29, 0x82, 0x64, 0x8e, 0xb0, 0xc2, 0x86, 0x87,
+	0xba, 0xc6, 0x68, 0xb9, 0x7b, 0x52, 0x78, 0x06, 0x06, 0x5d, 0xe6, 0x10, 0x46, 0x8d, 0x1a, 0x17,
+	0xc2, 0xc8, 0x5e, 0x84, 0x6f, 0x4a, 0xa4, 0x1e, 0xf8, 0x68, 0x5f, 0x2b, 0x30, 0x16, 0x50, 0xf5,
+	0x85, 0xfb, 0x2c, 0xf4, 0x99, 0x8c, 0x96, 0xc5, 0x5b, 0xdf, 0xf0, 0xd2, 0xd9, 0xf8, 0x88, 0x0d,
+	0x15, 0xca, 0x1e, 0x08, 0xbf, 0x88, 0x40, 0x95, 0x2e, 0x09, 0xb4, 0xb7, 0x8b, 0x02, 0x4d, 0x1c,
+	0x40, 0xa0, 0x5f, 0x21, 0x18, 0x0d, 0xf5, 0xd2, 0xad, 0x96, 0x98, 0xa7, 0x51, 0xd6, 0x01, 0x8d,
+	0x8a, 0x3d, 0x37, 0xc1, 0x3a, 0xa6, 0x51, 0x3c, 0x0e, 0x7d, 0xd4, 0x71, 0x6c, 0x47, 0x6a, 0x4a,
+	0x3c, 0x68, 0xb7, 0xe1, 0x68, 0xb8, 0x02, 0xf1, 0x3b, 0x7b, 0x1e, 0x06, 0x1c, 0x5e, 0x8d, 0x2f,
+	0x88, 0xf3, 0xfb, 0x08, 0x42, 0xd4, 0x2e, 0x53, 0xfa, 0xbe, 0x73, 0xcb, 0x70, 0x24, 0x2c, 0x42,
+	0x3c, 0x02, 0xb0, 0x7b, 0x8d, 0x37, 0xd6, 0x83, 0x93, 0x30, 0x14, 0x1c, 0xd6, 0xc7, 0x90, 0x9a,
+	0xb8, 0xf3, 0x71, 0xaa, 0x67, 0xe9, 0xdd, 0x24, 0xf4, 0xdd, 0xf0, 0xee, 0xf6, 0x71, 0x0d, 0xfa,
+	0xc5, 0xc6, 0x8e, 0xcf, 0xee, 0xb5, 0xed, 0x4b, 0x0d, 0xab, 0xe7, 0xf6, 0x06, 0x89, 0xd2, 0xb4,
+	0x73, 0x6f, 0x7f, 0xff, 0xfb, 0x7b, 0x4a, 0x0a, 0x4f, 0x65, 0x63, 0xff, 0xbe, 0x90, 0x09, 0x3f,
+	0x42, 0x30, 0xd2, 0x78, 0xe3, 0x88, 0xe7, 0xe3, 0xc3, 0xc7, 0x5e, 0xf7, 0xab, 0x17, 0x5b, 0x03,
+	0x4b, 0x4e, 0x17, 0x39, 0xa7, 0x19, 0x7c, 0x2e, 0x9e, 0x53, 0x84, 0xc8, 0xe7, 0x08, 0x8e, 0xc5,
+	0xdc, 0x86, 0xe2, 0x85, 0x56, 0x72, 0x86, 0x2f, 0x8a, 0xd4, 0xc5, 0x36, 0x3c, 0x24, 0xd5, 0x45,
+	0x4e, 0x75, 0x1e, 0x3f, 0xd1, 0x0a, 0x55, 0xc1, 0xeb, 0x7d, 0x04, 0xc9, 0x86, 0x3b, 0x19, 0x3c,
+	0x17, 0x9f, 0x37, 0xee, 0x26, 0x54, 0x9d, 0x6f, 0x09, 0x2b, 0xd9, 0xcd, 0x73, 0x76, 0xe7, 0xf1,
+	0xd9, 0x78, 0x76, 0x8d, 0x2c, 0x3e, 0x43, 0x80, 0x1f, 0xbd, 0x2b, 0xc2, 0xd9, 0x16, 0x12, 0x36,
+	0x74, 0x71, 0xa1, 0x75, 0x07, 0x49, 0x73, 0x81, 0xd3, 0x9c, 0xc3, 0xb3, 0x2d, 0xd0, 0x14, 0xa4,
+	0x3c, 0xae, 0x8f, 0xde, 0x4a, 0x34, 0xe3, 0xda, 0xf4, 0xb2, 0x48, 0x5d, 0x68, 0xdd, 0xa1, 0x35,
+	0xae, 0x31, 0xa4, 0xbc, 0xf9, 0x6e, 0x38, 0xde, 0x36, 0x9b, 0xef, 0xb8, 0x2b, 0x08, 0x75, 0xbe,
+	0x25, 0x6c, 0x6b, 0xf3, 0xdd, 0xc8, 0xe2, 0x1d, 0x04, 0x43, 0xc1, 0x71, 0x09, 0xcf, 0x34, 0xe9,
+	0x44, 0xe4, 0x1c, 0xab, 0x5e, 0xd8, 0x17, 0x27, 0xb9, 0x5c, 0xe0, 0x5c, 0xce, 0xe0, 0x74, 0x93,
+	0x46, 0x05, 0x99, 0xbf, 0x40, 0x30, 0x11, 0xfb, 0x9e, 0x8c, 0x97, 0x9a, 0xac, 0xea, 0x7b, 0x1c,
+	0x62, 0xd4, 0xe5, 0xb6, 0x7c, 0x24, 0xd7, 0x65, 0xce, 0xf5, 0x12, 0x9e, 0x8f, 0xe7, 0x1a, 0xcf,
+	0x6e, 0x13, 0x12, 0xde, 0xcb, 0x18, 0x3e, 0x13, 0x9f, 0x31, 0xf4, 0x1e, 0xac, 0x6a, 0x7b, 0x41,
+	0x24, 0x07, 0x8d, 0x73, 0x98, 0xc2, 0x6a, 0x3c, 0x87, 0x35, 0x7f, 0xca, 0x82, 0x3d, 0xa6, 0xd9,
+	0x94, 0x45, 0x5f, 0x66, 0xd4, 0x0b, 0xfb, 0xe2, 0x5a, 0x9b, 0xb2, 0xc0, 0x21, 0xb7, 0x76, 0xef,
+	0x41, 0x0a, 0xdd, 0x7f, 0x90, 0x42, 0xbf, 0x3e, 0x48, 0xa1, 0xbb, 0x0f, 0x53, 0x3d, 0xf7, 0x1f,
+	0xa6, 0x7a, 0x7e, 0x7c, 0x98, 0xea, 0xb9, 0xfd, 0x54, 0x68, 0x5f, 0x97, 0x41, 0x2e, 0x95, 0xc8,
+	0xba, 0x1b, 0x44, 0xdc, 0x5a, 0x7c, 0x32, 0xfb, 0x86, 0x88, 0x5b, 0x28, 0x99, 0xd4, 0x62, 0xe2,
+	0x8f, 0x6b, 0xf1, 0xea, 0xd2, 0xcf, 0x3f, 0x96, 0xff, 0x1f, 0x00, 0x73, 0x60, 0x35, 0x5f, 0xc1,
+	0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error)
+	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
+	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
+	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+	RealizedVolatility(ctx context.Context, in *RealizedVolatilityRequest, opts ...grpc.CallOption) (*RealizedVolatilityResponse, error)
+	AggregateTwap(ctx context.Context, in *AggregateTwapRequest, opts ...grpc.CallOption) (*AggregateTwapResponse, error)
+	RouteTwap(ctx context.Context, in *RouteTwapRequest, opts ...grpc.CallOption) (*RouteTwapResponse, error)
+	TimeWeightedLiquidity(ctx context.Context, in *TimeWeightedLiquidityRequest, opts ...grpc.CallOption) (*TimeWeightedLiquidityResponse, error)
+	Vwap(ctx context.Context, in *VwapRequest, opts ...grpc.CallOption) (*VwapResponse, error)
+	BatchTwap(ctx context.Context, in *BatchTwapRequest, opts ...grpc.CallOption) (*BatchTwapResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ArithmeticTwap(ctx context.Context, in *ArithmeticTwapRequest, opts ...grpc.CallOption) (*ArithmeticTwapResponse, error) {
+	out := new(ArithmeticTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *GeometricTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error) {
+	out := new(ArithmeticTwapToNowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GeometricTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error) {
+	out := new(GeometricTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error) {
+	out := new(GeometricTwapToNowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/GeometricTwapToNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RealizedVolatility(ctx context.Context, in *RealizedVolatilityRequest, opts ...grpc.CallOption) (*RealizedVolatilityResponse, error) {
+	out := new(RealizedVolatilityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/RealizedVolatility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateTwap(ctx context.Context, in *AggregateTwapRequest, opts ...grpc.CallOption) (*AggregateTwapResponse, error) {
+	out := new(AggregateTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/AggregateTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteTwap(ctx context.Context, in *RouteTwapRequest, opts ...grpc.CallOption) (*RouteTwapResponse, error) {
+	out := new(RouteTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/RouteTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeWeightedLiquidity(ctx context.Context, in *TimeWeightedLiquidityRequest, opts ...grpc.CallOption) (*TimeWeightedLiquidityResponse, error) {
+	out := new(TimeWeightedLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/TimeWeightedLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vwap(ctx context.Context, in *VwapRequest, opts ...grpc.CallOption) (*VwapResponse, error) {
+	out := new(VwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/Vwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchTwap(ctx context.Context, in *BatchTwapRequest, opts ...grpc.CallOption) (*BatchTwapResponse, error) {
+	out := new(BatchTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/BatchTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	ArithmeticTwap(context.Context, *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error)
+	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
+	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
+	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+	RealizedVolatility(context.Context, *RealizedVolatilityRequest) (*RealizedVolatilityResponse, error)
+	AggregateTwap(context.Context, *AggregateTwapRequest) (*AggregateTwapResponse, error)
+	RouteTwap(context.Context, *RouteTwapRequest) (*RouteTwapResponse, error)
+	TimeWeightedLiquidity(context.Context, *TimeWeightedLiquidityRequest) (*TimeWeightedLiquidityResponse, error)
+	Vwap(context.Context, *VwapRequest) (*VwapResponse, error)
+	BatchTwap(context.Context, *BatchTwapRequest) (*BatchTwapResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *ArithmeticTwapRequest) (*ArithmeticTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwapToNow(ctx context.Context, req *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwapToNow not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *GeometricTwapRequest) (*GeometricTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwap not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
+}
+func (*UnimplementedQueryServer) RealizedVolatility(ctx context.Context, req *RealizedVolatilityRequest) (*RealizedVolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedVolatility not implemented")
+}
+func (*UnimplementedQueryServer) AggregateTwap(ctx context.Context, req *AggregateTwapRequest) (*AggregateTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateTwap not implemented")
+}
+func (*UnimplementedQueryServer) RouteTwap(ctx context.Context, req *RouteTwapRequest) (*RouteTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteTwap not implemented")
+}
+func (*UnimplementedQueryServer) TimeWeightedLiquidity(ctx context.Context, req *TimeWeightedLiquidityRequest) (*TimeWeightedLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeWeightedLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) Vwap(ctx context.Context, req *VwapRequest) (*VwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vwap not implemented")
+}
+func (*UnimplementedQueryServer) BatchTwap(ctx context.Context, req *BatchTwapRequest) (*BatchTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTwap not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArithmeticTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*ArithmeticTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArithmeticTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArithmeticTwapToNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwapToNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwapToNow(ctx, req.(*ArithmeticTwapToNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometricTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwap(ctx, req.(*GeometricTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeometricTwapToNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwapToNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/GeometricTwapToNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwapToNow(ctx, req.(*GeometricTwapToNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RealizedVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RealizedVolatilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedVolatility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/RealizedVolatility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedVolatility(ctx, req.(*RealizedVolatilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/AggregateTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateTwap(ctx, req.(*AggregateTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/RouteTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteTwap(ctx, req.(*RouteTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeWeightedLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeWeightedLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeWeightedLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/TimeWeightedLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeWeightedLiquidity(ctx, req.(*TimeWeightedLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/Vwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vwap(ctx, req.(*VwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/BatchTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchTwap(ctx, req.(*BatchTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.twap.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwap",
+			Handler:    _Query_ArithmeticTwap_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwapToNow",
+			Handler:    _Query_ArithmeticTwapToNow_Handler,
+		},
+		{
+			MethodName: "GeometricTwap",
+			Handler:    _Query_GeometricTwap_Handler,
+		},
+		{
+			MethodName: "GeometricTwapToNow",
+			Handler:    _Query_GeometricTwapToNow_Handler,
+		},
+		{
+			MethodName: "RealizedVolatility",
+			Handler:    _Query_RealizedVolatility_Handler,
+		},
+		{
+			MethodName: "AggregateTwap",
+			Handler:    _Query_AggregateTwap_Handler,
+		},
+		{
+			MethodName: "RouteTwap",
+			Handler:    _Query_RouteTwap_Handler,
+		},
+		{
+			MethodName: "TimeWeightedLiquidity",
+			Handler:    _Query_TimeWeightedLiquidity_Handler,
+		},
+		{
+			MethodName: "Vwap",
+			Handler:    _Query_Vwap_Handler,
+		},
+		{
+			MethodName: "BatchTwap",
+			Handler:    _Query_BatchTwap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/twap/v1beta1/query.proto",
+}
+
+func (m *ArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapToNowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArithmeticTwapToNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArithmeticTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArithmeticTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapToNowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapToNowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapToNowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeometricTwapToNowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeometricTwapToNowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RealizedVolatilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RealizedVolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RealizedVolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RealizedVolatilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RealizedVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RealizedVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RealizedVolatility.Size()
+		i -= size
+		if _, err := m.RealizedVolatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolIds) > 0 {
+		dAtA17 := make([]byte, len(m.PoolIds)*10)
+		var j16 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.AggregateTwap.Size()
+		i -= size
+		if _, err := m.AggregateTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintQuery(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x22
+	}
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.RouteTwap.Size()
+		i -= size
+		if _, err := m.RouteTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWeightedLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWeightedLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWeightedLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintQuery(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWeightedLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWeightedLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWeightedLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TimeWeightedLiquidity.Size()
+		i -= size
+		if _, err := m.TimeWeightedLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintQuery(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintQuery(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Vwap.Size()
+		i -= size
+		if _, err := m.Vwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTwapItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTwapItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTwapItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeMetadata {
+		i--
+		if m.IncludeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndTime != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintQuery(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintQuery(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTwapResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTwapResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTwapResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *ArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *ArithmeticTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *GeometricTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *GeometricTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RealizedVolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *RealizedVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RealizedVolatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AggregateTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *AggregateTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RouteTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *RouteTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RouteTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TimeWeightedLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *TimeWeightedLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimeWeightedLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *VwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *VwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *BatchTwapItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovQuery(uint64(m.Strategy))
+	}
+	return n
+}
+
+func (m *BatchTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchTwapResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RealizedVolatilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RealizedVolatilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RealizedVolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RealizedVolatilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RealizedVolatilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RealizedVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedVolatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedVolatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *AggregateTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,17 +5815,17 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateTwap", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1476,12 +5835,111 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, types1.TwapMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
@@ -1513,11 +5971,114 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types2.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1527,29 +6088,67 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.IncludeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1559,28 +6158,29 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RouteTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1607,10 +6207,8 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Metadata = append(m.Metadata, types1.TwapMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1635,7 +6233,7 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,17 +6256,67 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1678,23 +6326,22 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1719,7 +6366,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *TimeWeightedLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +6389,10 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeWeightedLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeWeightedLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1864,6 +6511,62 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1885,7 +6588,7 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *TimeWeightedLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,15 +6611,15 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeWeightedLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeWeightedLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWeightedLiquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,7 +6647,40 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimeWeightedLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1969,7 +6705,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *VwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1992,10 +6728,10 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2150,6 +6886,26 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2171,7 +6927,7 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *VwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,15 +6950,15 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2230,7 +6986,40 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2255,7 +7044,7 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchTwapItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2278,10 +7067,10 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTwapItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTwapItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2368,10 +7157,10 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			m.Strategy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2381,25 +7170,11 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Strategy |= TwapStrategy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2421,7 +7196,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2444,17 +7219,17 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2464,26 +7239,115 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, BatchTwapItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeMetadata = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2505,7 +7369,7 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchTwapResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,12 +7392,111 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTwapResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTwapResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2555,7 +7518,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2578,15 +7541,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2613,7 +7576,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, BatchTwapResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,8 +14,18 @@ type (
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	VolatilityStrategy     = volatility
+	LiquidityStrategy      = liquidity
 )
 
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	k.trackSwapVolume(ctx, poolId, input, output)
+}
+
+func (k Keeper) GetBlockVolume(ctx sdk.Context, poolId uint64, denom0, denom1, denom string) sdk.Dec {
+	return k.getBlockVolume(ctx, poolId, denom0, denom1, denom)
+}
+
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
 	return k.getMostRecentRecordStoreRepresentation(ctx, poolId, asset0Denom, asset1Denom)
 }
@@ -48,8 +58,8 @@ func (k Keeper) UpdateRecords(ctx sdk.Context, poolId uint64) error {
 	return k.updateRecords(ctx, poolId)
 }
 
-func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes)
 }
 
 func (k Keeper) PruneRecords(ctx sdk.Context) error {
@@ -72,6 +82,14 @@ func (gs geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	return gs.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (vs volatility) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return vs.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func (ls liquidity) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	return ls.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -84,6 +102,10 @@ func TwapLog(x sdk.Dec) sdk.Dec {
 	return twapLog(x)
 }
 
+func SquaredLogReturn(prevPrice, newPrice sdk.Dec) sdk.Dec {
+	return squaredLogReturn(prevPrice, newPrice)
+}
+
 // twapPow exponentiates 2 to the given exponent.
 // Used as a test-helper for the power function used in geometric twap.
 func TwapPow(exponent sdk.Dec) sdk.Dec {
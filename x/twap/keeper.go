@@ -93,3 +93,13 @@ func (k Keeper) GetGeometricStrategy() *geometric {
 func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
+
+// GetVolatilityStrategy gets volatility TWAP keeper.
+func (k Keeper) GetVolatilityStrategy() *volatility {
+	return &volatility{k}
+}
+
+// GetLiquidityStrategy gets liquidity TWAP keeper.
+func (k Keeper) GetLiquidityStrategy() *liquidity {
+	return &liquidity{k}
+}
@@ -62,6 +62,13 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -84,6 +91,13 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogReturnAccumulator: sdk.ZeroDec(),
+				P0LastLiquidity:             sdk.ZeroDec(),
+				P1LastLiquidity:             sdk.ZeroDec(),
+				P0LiquidityAccumulator:      sdk.ZeroDec(),
+				P1LiquidityAccumulator:      sdk.ZeroDec(),
+				Asset0VolumeAccumulator:     sdk.ZeroDec(),
+				Asset1VolumeAccumulator:     sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -96,6 +110,13 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogReturnAccumulator: sdk.ZeroDec(),
+				P0LastLiquidity:             sdk.ZeroDec(),
+				P1LastLiquidity:             sdk.ZeroDec(),
+				P0LiquidityAccumulator:      sdk.ZeroDec(),
+				P1LiquidityAccumulator:      sdk.ZeroDec(),
+				Asset0VolumeAccumulator:     sdk.ZeroDec(),
+				Asset1VolumeAccumulator:     sdk.ZeroDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -111,6 +132,13 @@ var (
 		P0ArithmeticTwapAccumulator: sdk.OneDec(),
 		P1ArithmeticTwapAccumulator: sdk.OneDec(),
 		GeometricTwapAccumulator:    sdk.OneDec(),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -128,6 +156,13 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogReturnAccumulator: sdk.ZeroDec(),
+				P0LastLiquidity:             sdk.ZeroDec(),
+				P1LastLiquidity:             sdk.ZeroDec(),
+				P0LiquidityAccumulator:      sdk.ZeroDec(),
+				P1LiquidityAccumulator:      sdk.ZeroDec(),
+				Asset0VolumeAccumulator:     sdk.ZeroDec(),
+				Asset1VolumeAccumulator:     sdk.ZeroDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -140,6 +175,13 @@ var (
 				P0ArithmeticTwapAccumulator: sdk.OneDec(),
 				P1ArithmeticTwapAccumulator: sdk.OneDec(),
 				GeometricTwapAccumulator:    sdk.OneDec(),
+				SquaredLogReturnAccumulator: sdk.ZeroDec(),
+				P0LastLiquidity:             sdk.ZeroDec(),
+				P1LastLiquidity:             sdk.ZeroDec(),
+				P0LiquidityAccumulator:      sdk.ZeroDec(),
+				P1LiquidityAccumulator:      sdk.ZeroDec(),
+				Asset0VolumeAccumulator:     sdk.ZeroDec(),
+				Asset1VolumeAccumulator:     sdk.ZeroDec(),
 			},
 		})
 
@@ -169,6 +211,29 @@ func withSp1(twap types.TwapRecord, sp sdk.Dec) types.TwapRecord {
 	return twap
 }
 
+func withSquaredLogReturnAccum(twap types.TwapRecord, accum sdk.Dec) types.TwapRecord {
+	twap.SquaredLogReturnAccumulator = accum
+	return twap
+}
+
+func withLastLiquidities(twap types.TwapRecord, liquidity0, liquidity1 sdk.Dec) types.TwapRecord {
+	twap.P0LastLiquidity = liquidity0
+	twap.P1LastLiquidity = liquidity1
+	return twap
+}
+
+func withLiquidityAccums(twap types.TwapRecord, accum0, accum1 sdk.Dec) types.TwapRecord {
+	twap.P0LiquidityAccumulator = accum0
+	twap.P1LiquidityAccumulator = accum1
+	return twap
+}
+
+func withVolumeAccums(twap types.TwapRecord, accum0, accum1 sdk.Dec) types.TwapRecord {
+	twap.Asset0VolumeAccumulator = accum0
+	twap.Asset1VolumeAccumulator = accum1
+	return twap
+}
+
 // TestTWAPInitGenesis tests that genesis is initialized correctly
 // with different parameters and state.
 // Asserts that the most recent records are set correctly.
@@ -226,6 +291,13 @@ func (suite *TestSuite) TestTwapInitGenesis() {
 						P0ArithmeticTwapAccumulator: sdk.OneDec(),
 						P1ArithmeticTwapAccumulator: sdk.OneDec(),
 						GeometricTwapAccumulator:    sdk.OneDec(),
+						SquaredLogReturnAccumulator: sdk.ZeroDec(),
+						P0LastLiquidity:             sdk.ZeroDec(),
+						P1LastLiquidity:             sdk.ZeroDec(),
+						P0LiquidityAccumulator:      sdk.ZeroDec(),
+						P1LiquidityAccumulator:      sdk.ZeroDec(),
+						Asset0VolumeAccumulator:     sdk.ZeroDec(),
+						Asset1VolumeAccumulator:     sdk.ZeroDec(),
 					},
 				}),
 
@@ -423,6 +495,13 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 }
 
@@ -444,6 +523,13 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -471,6 +557,13 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 }
 
@@ -501,6 +594,13 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum sdk.De
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 }
 
@@ -513,6 +613,13 @@ func newExpRecord(accum0, accum1, geomAccum sdk.Dec) types.TwapRecord {
 		P0ArithmeticTwapAccumulator: accum0.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(sdk.ZeroDec()),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 }
 
@@ -531,6 +638,13 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
@@ -555,6 +669,13 @@ func newThreeAssetExpRecord(poolId uint64, accumA, accumB, accumC, geomAccumAB,
 		P0ArithmeticTwapAccumulator: accumA.Add(sdk.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(sdk.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(sdk.ZeroDec()),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
+		P0LastLiquidity:             sdk.ZeroDec(),
+		P1LastLiquidity:             sdk.ZeroDec(),
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
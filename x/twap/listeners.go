@@ -50,6 +50,7 @@ func (hook *gammhook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddres
 // AfterCFMMSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
 func (hook *gammhook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	hook.k.trackChangedPool(ctx, poolId)
+	hook.k.trackSwapVolume(ctx, poolId, input, output)
 }
 
 func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
@@ -82,4 +83,5 @@ func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Con
 
 func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	l.k.trackChangedPool(ctx, poolId)
+	l.k.trackSwapVolume(ctx, poolId, input, output)
 }
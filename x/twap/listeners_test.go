@@ -69,6 +69,9 @@ func (s *TestSuite) TestAfterPoolCreatedHook() {
 					if poolType == poolmanagertypes.Concentrated {
 						expectedRecord.LastErrorTime = s.Ctx.BlockTime()
 					}
+					// the volumes swapped in the pool creation block are accumulated.
+					expectedRecord.Asset0VolumeAccumulator = s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1, denomPair.Denom0)
+					expectedRecord.Asset1VolumeAccumulator = s.twapkeeper.GetBlockVolume(s.Ctx, poolId, denomPair.Denom0, denomPair.Denom1, denomPair.Denom1)
 					expectedRecords = append(expectedRecords, expectedRecord)
 				}
 
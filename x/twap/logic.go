@@ -18,6 +18,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	}
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
+	liquidity0, liquidity1 := getLiquidities(ctx, k, poolId, denom0, denom1, sp0, sp1)
 	return types.TwapRecord{
 		PoolId:                      poolId,
 		Asset0Denom:                 denom0,
@@ -29,7 +30,14 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
 		GeometricTwapAccumulator:    sdk.ZeroDec(),
+		SquaredLogReturnAccumulator: sdk.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+		P0LastLiquidity:             liquidity0,
+		P1LastLiquidity:             liquidity1,
+		P0LiquidityAccumulator:      sdk.ZeroDec(),
+		P1LiquidityAccumulator:      sdk.ZeroDec(),
+		Asset0VolumeAccumulator:     sdk.ZeroDec(),
+		Asset1VolumeAccumulator:     sdk.ZeroDec(),
 	}, nil
 }
 
@@ -71,6 +79,29 @@ func getSpotPrices(
 	return sp0, sp1, latestErrTime
 }
 
+// getLiquidities returns the value of the pool's denom0 and denom1 reserves, in terms of denom0 and denom1 respectively,
+// given the spot prices sp0 and sp1 as returned by getSpotPrices.
+// The liquidities are capped at types.MaxLiquidity, and are zero if the pool liquidity cannot be retrieved.
+func getLiquidities(
+	ctx sdk.Context,
+	k types.PoolManagerInterface,
+	poolId uint64,
+	denom0, denom1 string,
+	sp0, sp1 sdk.Dec,
+) (liquidity0 sdk.Dec, liquidity1 sdk.Dec) {
+	poolLiquidity, err := k.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	amount0 := poolLiquidity.AmountOf(denom0).ToDec()
+	amount1 := poolLiquidity.AmountOf(denom1).ToDec()
+
+	// sp0 = denom0 quote, denom1 base, so it values denom1 in terms of denom0, and vice versa for sp1.
+	liquidity0 = sdk.MinDec(amount0.Add(amount1.Mul(sp0)), types.MaxLiquidity)
+	liquidity1 = sdk.MinDec(amount1.Add(amount0.Mul(sp1)), types.MaxLiquidity)
+	return liquidity0, liquidity1
+}
+
 // mustTrackCreatedPool is a wrapper around afterCreatePool that panics on error.
 func (k Keeper) mustTrackCreatedPool(ctx sdk.Context, poolId uint64) {
 	err := k.afterCreatePool(ctx, poolId)
@@ -183,11 +214,28 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newSp0, newSp1, lastErrorTime := getSpotPrices(
 		ctx, k.poolmanagerKeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.LastErrorTime)
 
+	// accumulate the squared logarithmic return from the last spot price to the last price of this block.
+	// A record updated in its pool creation block has no prior price to return from, as the record
+	// is then meant to reflect the pool state at the end of the creation block.
+	if record.Height != ctx.BlockHeight() {
+		newRecord.SquaredLogReturnAccumulator = newRecord.SquaredLogReturnAccumulator.Add(squaredLogReturn(record.P0LastSpotPrice, newSp0))
+	}
+
 	// set last spot price to be last price of this block. This is what will get used in interpolation.
 	newRecord.P0LastSpotPrice = newSp0
 	newRecord.P1LastSpotPrice = newSp1
 	newRecord.LastErrorTime = lastErrorTime
 
+	// likewise for the liquidities, valued at the last spot prices of this block.
+	newRecord.P0LastLiquidity, newRecord.P1LastLiquidity = getLiquidities(
+		ctx, k.poolmanagerKeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, newSp0, newSp1)
+
+	// accumulate the volumes swapped between the pair in this block.
+	newRecord.Asset0VolumeAccumulator = newRecord.Asset0VolumeAccumulator.Add(
+		k.getBlockVolume(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Asset0Denom))
+	newRecord.Asset1VolumeAccumulator = newRecord.Asset1VolumeAccumulator.Add(
+		k.getBlockVolume(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Asset1Denom))
+
 	return newRecord, nil
 }
 
@@ -196,11 +244,18 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 // Such record is preserved for each pool.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
+//
+// Pools with a record history keep period override longer than recordHistoryKeepPeriod
+// have their records pruned with respect to their own keep period instead.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
+	params := k.GetParams(ctx)
 
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	poolLastKeptTimes := make(map[uint64]time.Time, len(params.PoolRecordHistoryKeepPeriods))
+	for _, poolKeepPeriod := range params.PoolRecordHistoryKeepPeriods {
+		poolLastKeptTimes[poolKeepPeriod.PoolId] = ctx.BlockTime().Add(-params.PoolRecordHistoryKeepPeriod(poolKeepPeriod.PoolId))
+	}
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -226,6 +281,10 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p1NewAccum := types.SpotPriceMulDuration(record.P1LastSpotPrice, timeDelta)
 	newRecord.P1ArithmeticTwapAccumulator = newRecord.P1ArithmeticTwapAccumulator.Add(p1NewAccum)
 
+	// record.LastLiquidity is similarly treated as the effective liquidity until the new time.
+	newRecord.P0LiquidityAccumulator = newRecord.P0LiquidityAccumulator.Add(record.P0LastLiquidity.MulInt64(timeDelta))
+	newRecord.P1LiquidityAccumulator = newRecord.P1LiquidityAccumulator.Add(record.P1LastLiquidity.MulInt64(timeDelta))
+
 	// If the last spot price is zero, then the logarithm is undefined.
 	// As a result, we cannot update the geometric accumulator.
 	// We set the last error time to be the new time, and return the record.
@@ -252,12 +311,17 @@ func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, t time.Tim
 	if err != nil {
 		return types.TwapRecord{}, err
 	}
+	return interpolateRecord(record, t), nil
+}
+
+// interpolateRecord returns the given record with its accumulators updated to time `t`.
+// If the record errored at its time, the interpolated record inherits the error at time `t`.
+func interpolateRecord(record types.TwapRecord, t time.Time) types.TwapRecord {
 	// if it had errored on the last record, make this record inherit the error
 	if record.Time.Equal(record.LastErrorTime) {
 		record.LastErrorTime = t
 	}
-	record = recordWithUpdatedAccumulators(record, t)
-	return record, nil
+	return recordWithUpdatedAccumulators(record, t)
 }
 
 func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, assetB string) (types.TwapRecord, error) {
@@ -281,10 +345,8 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (sdk.Dec, error) {
 	// see if we need to return an error, due to spot price issues
 	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	if hasSpotPriceErrorInRange(startRecord, endRecord) {
+		err = types.ErrSpotPriceErrorInRange
 	}
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
@@ -298,6 +360,112 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// hasSpotPriceErrorInRange returns true if a spot price error occurred between the two records,
+// including at the time of the start record.
+// precondition: endRecord.Time >= startRecord.Time
+func hasSpotPriceErrorInRange(startRecord types.TwapRecord, endRecord types.TwapRecord) bool {
+	return !endRecord.LastErrorTime.Before(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time)
+}
+
+// computeVwap returns the volume weighted average price of the base asset in units of the quote asset
+// between the two records, i.e. the amount of quote asset over the amount of base asset swapped between them.
+// Returns false if no base asset was swapped between the records, as the price is then undefined.
+func computeVwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, bool) {
+	baseVolume := endRecord.Asset1VolumeAccumulator.Sub(startRecord.Asset1VolumeAccumulator)
+	quoteVolume := endRecord.Asset0VolumeAccumulator.Sub(startRecord.Asset0VolumeAccumulator)
+	if quoteAsset != startRecord.Asset0Denom {
+		baseVolume, quoteVolume = quoteVolume, baseVolume
+	}
+
+	if !baseVolume.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return quoteVolume.Quo(baseVolume), true
+}
+
+// getAggregateTwap computes the average of the TWAPs of the given pools, weighted by each pool's
+// time weighted liquidity of the denom pair, valued in the quote asset.
+// If skipFailedPools is true, the pools failing to serve a TWAP are left out of the average,
+// otherwise their error is returned.
+// Returns the ids of the aggregated pools along with the average.
+// Returns an error if the total weight is zero.
+func (k Keeper) getAggregateTwap(
+	ctx sdk.Context,
+	poolIds []uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+	skipFailedPools bool,
+) (sdk.Dec, []uint64, error) {
+	weightedTwapSum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	aggregatedPoolIds := []uint64{}
+	var spotPriceErr error
+	for _, poolId := range poolIds {
+		twap, weight, err := k.getTwapAndLiquidity(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+		if err != nil {
+			if skipFailedPools {
+				continue
+			}
+			if !errors.Is(err, types.ErrSpotPriceErrorInRange) {
+				return sdk.Dec{}, nil, err
+			}
+			spotPriceErr = err
+		}
+
+		weightedTwapSum = weightedTwapSum.Add(twap.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+		aggregatedPoolIds = append(aggregatedPoolIds, poolId)
+	}
+
+	if !totalWeight.IsPositive() {
+		return sdk.Dec{}, nil, types.NoAggregateTwapLiquidityError{BaseDenom: baseAssetDenom, QuoteDenom: quoteAssetDenom, PoolIds: poolIds}
+	}
+	return weightedTwapSum.Quo(totalWeight), aggregatedPoolIds, spotPriceErr
+}
+
+// getTwapAndLiquidity returns the TWAP of the given strategy and the time weighted liquidity of the pool
+// from (startTime, endTime). If a spot price error occurred within the time range, both are returned
+// along with ErrSpotPriceErrorInRange.
+func (k Keeper) getTwapAndLiquidity(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+) (sdk.Dec, sdk.Dec, error) {
+	twap, twapErr := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+	if twapErr != nil && !errors.Is(twapErr, types.ErrSpotPriceErrorInRange) {
+		return sdk.Dec{}, sdk.Dec{}, twapErr
+	}
+	liquidity, err := k.GetTimeWeightedLiquidity(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil && !errors.Is(err, types.ErrSpotPriceErrorInRange) {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if twapErr != nil {
+		return twap, liquidity, twapErr
+	}
+	return twap, liquidity, err
+}
+
+// squaredLogReturn returns the squared base 2 logarithmic return from the previous to the new spot price,
+// i.e. (log_{2}{newPrice} - log_{2}{prevPrice})^2.
+// Returns zero if either price is zero, as the logarithm is then undefined. Such prices
+// come with a spot price error, which is surfaced to the callers through the last error time.
+func squaredLogReturn(prevPrice, newPrice sdk.Dec) sdk.Dec {
+	if prevPrice.IsZero() || newPrice.IsZero() || prevPrice.Equal(newPrice) {
+		return sdk.ZeroDec()
+	}
+
+	logReturn := twapLog(newPrice).Sub(twapLog(prevPrice))
+	return logReturn.Mul(logReturn)
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price sdk.Dec) sdk.Dec {
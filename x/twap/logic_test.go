@@ -177,8 +177,14 @@ func (s *TestSuite) TestUpdateRecord() {
 	updateTime := time.Unix(3, 0).UTC()
 	baseTimeMinusOne := time.Unix(1, 0).UTC()
 
-	zeroAccumNoErrSp10Record := newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec, zeroDec)
+	zeroAccumNoErrSp10Record := withLastLiquidities(newRecord(poolId, baseTime, sdk.NewDec(10), zeroDec, zeroDec, zeroDec), sdk.NewDec(100), sdk.NewDec(50))
 	sp10OneTimeUnitAccumRecord := newExpRecord(OneSec.MulInt64(10), OneSec.QuoInt64(10), geometricTenSecAccum)
+	// the spot price changes from 10 to 1, a squared log return of log_{2}{10}^2.
+	sp10OneTimeUnitAccumRecord.SquaredLogReturnAccumulator = logTen.Mul(logTen)
+	// the liquidities of the start record are accumulated over one time unit.
+	sp10OneTimeUnitAccumRecord = withLiquidityAccums(sp10OneTimeUnitAccumRecord, OneSec.MulInt64(100), OneSec.MulInt64(50))
+	// both pool assets have the same amount.
+	poolAssetAmount := defaultTwoAssetCoins[0].Amount.ToDec()
 	// all tests occur with updateTime = base time + time.Unix(1, 0)
 	tests := map[string]struct {
 		record           types.TwapRecord
@@ -202,7 +208,8 @@ func (s *TestSuite) TestUpdateRecord() {
 			record:           zeroAccumNoErrSp10Record,
 			spotPriceResult0: spotPriceResOneErrNilDec,
 			spotPriceResult1: spotPriceResOne,
-			expRecord:        withSp0(withLastErrTime(sp10OneTimeUnitAccumRecord, updateTime), sdk.ZeroDec()),
+			// no squared log return is accumulated for a zero spot price.
+			expRecord: withSquaredLogReturnAccum(withSp0(withLastErrTime(sp10OneTimeUnitAccumRecord, updateTime), sdk.ZeroDec()), sdk.ZeroDec()),
 		},
 		"0 accum start, sp1 err at update with nil dec": {
 			record:           zeroAccumNoErrSp10Record,
@@ -235,6 +242,9 @@ func (s *TestSuite) TestUpdateRecord() {
 			if (test.expRecord.P1LastSpotPrice == sdk.Dec{}) {
 				test.expRecord.P1LastSpotPrice = test.spotPriceResult1.Sp
 			}
+			// the liquidities are valued at the last spot prices.
+			test.expRecord.P0LastLiquidity = poolAssetAmount.Add(poolAssetAmount.Mul(test.expRecord.P0LastSpotPrice))
+			test.expRecord.P1LastLiquidity = poolAssetAmount.Add(poolAssetAmount.Mul(test.expRecord.P1LastSpotPrice))
 			test.expRecord.Height = s.Ctx.BlockHeight()
 			test.expRecord.Time = s.Ctx.BlockTime()
 
@@ -629,6 +639,61 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_PoolRecordHistoryKeepPeriod tests that pools with a record history keep period
+// override longer than the global one keep their records for longer, while shorter overrides are ignored.
+func (s *TestSuite) TestPruneRecords_PoolRecordHistoryKeepPeriod() {
+	recordHistoryKeepPeriod := s.twapkeeper.RecordHistoryKeepPeriod(s.Ctx)
+
+	pool3OlderMin2SRecord, // kept as after pool 3 last kept time
+		pool2OlderMin1SRecordAB, pool2OlderMin1SRecordAC, pool2OlderMin1SRecordBC, // deleted as pool 2 override is shorter than the global keep period
+		pool1OlderBaseRecord,     // kept as newest under keep period
+		pool4OlderPlus1SRecord := // kept as newest under keep period
+		s.createTestRecordsFromTime(baseTime.Add(2 * -recordHistoryKeepPeriod))
+
+	pool3Min2SRecord, // kept as after pool 3 last kept time
+		pool2Min1SRecordAB, pool2Min1SRecordAC, pool2Min1SRecordBC, // kept as newest under keep period
+		pool1BaseRecord,     // kept as it is at the keep period boundary
+		pool4Plus1SRecord := // kept as it is above the keep period boundary
+		s.createTestRecordsFromTime(baseTime.Add(-recordHistoryKeepPeriod))
+
+	recordsToPreSet := []types.TwapRecord{
+		pool2OlderMin1SRecordAB, pool2OlderMin1SRecordAC, pool2OlderMin1SRecordBC,
+		pool4Plus1SRecord,
+		pool4OlderPlus1SRecord,
+		pool1OlderBaseRecord,
+		pool2Min1SRecordAB, pool2Min1SRecordAC, pool2Min1SRecordBC,
+		pool1BaseRecord,
+		pool3Min2SRecord,
+		pool3OlderMin2SRecord,
+	}
+
+	expectedKeptRecords := []types.TwapRecord{
+		pool3OlderMin2SRecord,
+		pool1OlderBaseRecord,
+		pool4OlderPlus1SRecord,
+		pool3Min2SRecord,
+		pool2Min1SRecordAB, pool2Min1SRecordAC, pool2Min1SRecordBC,
+		pool1BaseRecord,
+		pool4Plus1SRecord,
+	}
+	s.SetupTest()
+	s.preSetRecords(recordsToPreSet)
+
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.PoolRecordHistoryKeepPeriods = []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: 2, RecordHistoryKeepPeriod: recordHistoryKeepPeriod / 2},
+		{PoolId: 3, RecordHistoryKeepPeriod: 3 * recordHistoryKeepPeriod},
+	}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	ctx := s.Ctx.WithBlockTime(baseTime)
+
+	err := s.twapkeeper.PruneRecords(ctx)
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords(expectedKeptRecords)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -1479,6 +1544,30 @@ func (s *TestSuite) TestTwapLog() {
 	}
 }
 
+func (s *TestSuite) TestSquaredLogReturn() {
+	testcases := []struct {
+		name      string
+		prevPrice sdk.Dec
+		newPrice  sdk.Dec
+		expected  sdk.Dec
+	}{
+		{"unchanged price", sdk.NewDec(10), sdk.NewDec(10), sdk.ZeroDec()},
+		{"price doubled", sdk.NewDec(8), sdk.NewDec(16), sdk.OneDec()},
+		{"price halved", sdk.NewDec(16), sdk.NewDec(8), sdk.OneDec()},
+		{"price quadrupled", sdk.NewDec(2), sdk.NewDec(8), sdk.NewDec(4)},
+		{"price went down 10x", sdk.NewDec(10), sdk.OneDec(), logTen.Mul(logTen)},
+		{"zero previous price", sdk.ZeroDec(), sdk.NewDec(10), sdk.ZeroDec()},
+		{"zero new price", sdk.NewDec(10), sdk.ZeroDec(), sdk.ZeroDec()},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			result := twap.SquaredLogReturn(tc.prevPrice, tc.newPrice)
+			s.Require().Equal(tc.expected, result)
+		})
+	}
+}
+
 func testCaseFromDeltas(s *TestSuite, startAccum, accumDiff sdk.Dec, timeDelta time.Duration, expectedTwap sdk.Dec) computeTwapTestCase {
 	return computeTwapTestCase{
 		newOneSidedRecord(baseTime, startAccum, true),
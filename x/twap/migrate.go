@@ -2,6 +2,8 @@ package twap
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
 )
 
 // MigrateExistingPools iterates through all pools and creates state entry for the twap module.
@@ -14,3 +16,29 @@ func (k Keeper) MigrateExistingPools(ctx sdk.Context, latestPoolId uint64) error
 	}
 	return nil
 }
+
+// MigrateMostRecentRecordsLiquidities sets the last liquidities of the most recent records, stored before
+// they were introduced, to the current pool liquidities valued at their last spot prices, since the pools
+// have not changed after these records.
+// Historical records are left as is, since parsing defaults their missing accumulators and liquidities
+// to zero. Realized volatilities and time weighted liquidities can thus only be computed over time
+// ranges after the migration.
+func (k Keeper) MigrateMostRecentRecordsLiquidities(ctx sdk.Context) error {
+	mostRecentRecords, err := types.GetAllMostRecentTwaps(ctx.KVStore(k.storeKey))
+	if err != nil {
+		return err
+	}
+	for _, record := range mostRecentRecords {
+		record.P0LastLiquidity, record.P1LastLiquidity = getLiquidities(
+			ctx, k.poolmanagerKeeper, record.PoolId, record.Asset0Denom, record.Asset1Denom, record.P0LastSpotPrice, record.P1LastSpotPrice)
+		k.StoreNewRecord(ctx, record)
+	}
+	return nil
+}
+
+// MigrateParamsPoolRecordHistoryKeepPeriods sets the pool record history keep periods param,
+// introduced after genesis, to its default of no overrides.
+func (k Keeper) MigrateParamsPoolRecordHistoryKeepPeriods(ctx sdk.Context) error {
+	k.paramSpace.Set(ctx, types.KeyPoolRecordHistoryKeepPeriods, types.DefaultParams().PoolRecordHistoryKeepPeriods)
+	return nil
+}
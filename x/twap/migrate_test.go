@@ -3,7 +3,9 @@ package twap_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/osmosis-labs/osmosis/v16/x/twap/types"
@@ -69,6 +71,86 @@ func (s *TestSuite) TestMigrateExistingPoolsError() {
 	s.Require().Error(err)
 }
 
+func (s *TestSuite) TestMigrateMostRecentRecordsLiquidities() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	nonExistentPoolId := poolId + 1
+	records := []types.TwapRecord{withPoolId(baseRecord, poolId), withPoolId(tPlus10sp5Record, poolId), withPoolId(baseRecord, nonExistentPoolId)}
+	mostRecentRecords := records[1:]
+
+	// write the records as encoded before the squared log return accumulator and liquidity fields were introduced.
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	for _, record := range records {
+		bz := legacyTwapRecordBz(s, record, preSquaredLogReturnAccumulatorFieldsBz)
+		store.Set(types.FormatHistoricalTimeIndexTWAPKey(record.Time, record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+		store.Set(types.FormatHistoricalPoolIndexTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), bz)
+	}
+	for _, record := range mostRecentRecords {
+		store.Set(types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom), legacyTwapRecordBz(s, record, preSquaredLogReturnAccumulatorFieldsBz))
+	}
+
+	err := s.twapkeeper.MigrateMostRecentRecordsLiquidities(s.Ctx)
+	s.Require().NoError(err)
+
+	// the older record is not rewritten, and parses with zero accumulators and liquidities.
+	historicalRecordKey := types.FormatHistoricalTimeIndexTWAPKey(records[0].Time, records[0].PoolId, records[0].Asset0Denom, records[0].Asset1Denom)
+	s.Require().Equal(legacyTwapRecordBz(s, records[0], preSquaredLogReturnAccumulatorFieldsBz), store.Get(historicalRecordKey))
+	historicalRecord, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, baseTime, denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(records[0], historicalRecord)
+
+	// the most recent records get the pool liquidities valued at their last spot prices,
+	// which are zero for a pool that does not exist.
+	poolAssetAmount := defaultTwoAssetCoins[0].Amount.ToDec()
+	expectedMostRecentRecords := []types.TwapRecord{
+		withLastLiquidities(mostRecentRecords[0],
+			poolAssetAmount.Add(poolAssetAmount.Mul(mostRecentRecords[0].P0LastSpotPrice)),
+			poolAssetAmount.Add(poolAssetAmount.Mul(mostRecentRecords[0].P1LastSpotPrice))),
+		mostRecentRecords[1],
+	}
+	for _, expectedRecord := range expectedMostRecentRecords {
+		actualRecord, err := s.twapkeeper.GetMostRecentRecordStoreRepresentation(s.Ctx, expectedRecord.PoolId, expectedRecord.Asset0Denom, expectedRecord.Asset1Denom)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedRecord, actualRecord)
+
+		historicalRecord, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, expectedRecord.PoolId, expectedRecord.Time, expectedRecord.Asset0Denom, expectedRecord.Asset1Denom)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedRecord, historicalRecord)
+	}
+}
+
+func (s *TestSuite) TestMigrateParamsPoolRecordHistoryKeepPeriods() {
+	// remove the param, as it is absent from the state of chains started before it was introduced.
+	paramsStore := prefix.NewStore(s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramsStore.Delete(types.KeyPoolRecordHistoryKeepPeriods)
+	s.Require().Panics(func() { s.twapkeeper.GetParams(s.Ctx) })
+
+	err := s.twapkeeper.MigrateParamsPoolRecordHistoryKeepPeriods(s.Ctx)
+	s.Require().NoError(err)
+
+	params := s.twapkeeper.GetParams(s.Ctx)
+	s.Require().Empty(params.PoolRecordHistoryKeepPeriods)
+	s.Require().Equal(types.DefaultParams().RecordHistoryKeepPeriod, params.RecordHistoryKeepPeriod)
+}
+
+var (
+	// fields 17 and 18, the volume accumulators, both zero: wire type 2, length 1, "0".
+	preVolumeFieldsBz = []byte{0x8a, 0x01, 0x01, '0', 0x92, 0x01, 0x01, '0'}
+	// fields 13 to 16, the liquidities and liquidity accumulators, all zero, followed by the fields above.
+	preLiquidityFieldsBz = append([]byte{0x6a, 0x01, '0', 0x72, 0x01, '0', 0x7a, 0x01, '0', 0x82, 0x01, 0x01, '0'}, preVolumeFieldsBz...)
+	// field 12, the squared log return accumulator, followed by the fields above.
+	preSquaredLogReturnAccumulatorFieldsBz = append([]byte{0x62, 0x01, '0'}, preLiquidityFieldsBz...)
+)
+
+// legacyTwapRecordBz returns the encoding of the given record without its trailing fields introduced
+// after the legacy encoding, given as legacyFieldsBz.
+func legacyTwapRecordBz(s *TestSuite, record types.TwapRecord, legacyFieldsBz []byte) []byte {
+	bz, err := proto.Marshal(&record)
+	s.Require().NoError(err)
+
+	s.Require().Equal(legacyFieldsBz, bz[len(bz)-len(legacyFieldsBz):])
+	return bz[:len(bz)-len(legacyFieldsBz)]
+}
+
 // TestTwapRecord_GeometricTwap_MarshalUnmarshal this test proves that migrations
 // to initialize geometric twap accumulators are not required.
 // This is because proto marshalling will initialize the field to the zero value.
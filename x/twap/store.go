@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -27,7 +28,7 @@ var sentinelExistsValue = []byte{1}
 // to track that this pool changed this block.
 // This tracking is for use in EndBlock, to create new TWAP records.
 func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolPrefix)
 	poolIdBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(poolIdBz, poolId)
 
@@ -38,7 +39,7 @@ func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
 func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolPrefix)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -51,6 +52,37 @@ func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
 	return alteredPoolIds
 }
 
+// trackSwapVolume adds the amounts of a swap in the pool to the volumes of the swapped pair in this block,
+// kept in the transient store.
+// This tracking is for use in EndBlock, to accumulate the volumes in the new TWAP records.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	// swaps are from a single token in to a single token out.
+	if len(input) != 1 || len(output) != 1 {
+		return
+	}
+	denom0, denom1, err := types.LexicographicalOrderDenoms(input[0].Denom, output[0].Denom)
+	if err != nil {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	for _, coin := range []sdk.Coin{input[0], output[0]} {
+		volume := k.getBlockVolume(ctx, poolId, denom0, denom1, coin.Denom)
+		osmoutils.MustSetDec(store, types.FormatBlockVolumeKey(poolId, denom0, denom1, coin.Denom), volume.Add(coin.Amount.ToDec()))
+	}
+}
+
+// getBlockVolume returns the amount of denom swapped against the other denom of the (denom0, denom1) pair
+// in the pool this block.
+func (k Keeper) getBlockVolume(ctx sdk.Context, poolId uint64, denom0, denom1, denom string) sdk.Dec {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.FormatBlockVolumeKey(poolId, denom0, denom1, denom)
+	if !store.Has(key) {
+		return sdk.ZeroDec()
+	}
+	return osmoutils.MustGetDec(store, key)
+}
+
 // storeHistoricalTWAP writes a twap to the store, in all needed indexing.
 func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
@@ -73,7 +105,10 @@ func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // So, in order to have correct behavior for the desired guarantee,
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
-func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+//
+// poolLastKeptTimes overrides lastKeptTime for the pools it contains. Overrides are expected
+// to be before lastKeptTime, records of the pools after it are kept regardless.
+func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
 	store := ctx.KVStore(k.storeKey)
 
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
@@ -99,6 +134,11 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			return err
 		}
 
+		// Keep the records of pools with a longer keep period, that are after their last kept time.
+		if poolLastKeptTime, ok := poolLastKeptTimes[twapToRemove.PoolId]; ok && !twapToRemove.Time.Before(poolLastKeptTime) {
+			continue
+		}
+
 		poolKey := uniqueTriplet{
 			poolId: twapToRemove.PoolId,
 			asset0: twapToRemove.Asset0Denom,
@@ -151,6 +191,27 @@ func (k Keeper) GetAllMostRecentRecordsForPool(ctx sdk.Context, poolId uint64) (
 	return types.GetAllMostRecentTwapsForPool(store, poolId)
 }
 
+// getPoolIdsForDenomPair returns the ids of all the pools with a most recent twap record
+// for the given denom pair, in ascending order.
+// This iterates over the most recent twap records of all pools.
+func (k Keeper) getPoolIdsForDenomPair(ctx sdk.Context, denom0, denom1 string) ([]uint64, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(denom0, denom1)
+	if err != nil {
+		return nil, err
+	}
+	records, err := types.GetAllMostRecentTwaps(ctx.KVStore(k.storeKey))
+	if err != nil {
+		return nil, err
+	}
+	poolIds := []uint64{}
+	for _, record := range records {
+		if record.Asset0Denom == asset0Denom && record.Asset1Denom == asset1Denom {
+			poolIds = append(poolIds, record.PoolId)
+		}
+	}
+	return poolIds, nil
+}
+
 // getAllHistoricalTimeIndexedTWAPs returns all historical TWAPs indexed by time.
 func (k Keeper) getAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPTimeIndexPrefix), types.ParseTwapFromBz)
@@ -170,6 +231,42 @@ func (k Keeper) StoreNewRecord(ctx sdk.Context, twap types.TwapRecord) {
 	k.storeHistoricalTWAP(ctx, twap)
 }
 
+// getOldestRecord returns the oldest historical record in state for the provided (pool, asset0, asset1) triplet.
+func (k Keeper) getOldestRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.FormatHistoricalPoolIndexTimePrefix(poolId, asset0Denom, asset1Denom)
+	twap, err := osmoutils.GetFirstValueInRange(store, prefix, sdk.PrefixEndBytes(prefix), false, types.ParseTwapFromBz)
+	if err != nil {
+		return types.TwapRecord{}, fmt.Errorf(
+			"getOldestRecord: no records for assets %s %s in pool id %d", asset0Denom, asset1Denom, poolId)
+	}
+	return twap, nil
+}
+
+// countRecordsInRange returns the number of historical records in state for the provided (pool, asset0, asset1)
+// triplet, with a time t such that startTime <= t <= endTime.
+func (k Keeper) countRecordsInRange(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string, startTime time.Time, endTime time.Time) (uint64, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return 0, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	startKey := types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := types.FormatHistoricalPoolIndexTimeSuffix(poolId, asset0Denom, asset1Denom, endTime)
+	iter := store.Iterator(startKey, endKey)
+	defer iter.Close()
+
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
 // getRecordAtOrBeforeTime on a given input (id, t, asset0, asset1)
 // returns the TWAP record from state for (id, t', asset0, asset1),
 // where t' is such that:
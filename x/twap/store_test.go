@@ -49,6 +49,88 @@ func (s *TestSuite) TestTrackChangedPool() {
 	}
 }
 
+func (s *TestSuite) TestTrackSwapVolume() {
+	type swap struct {
+		poolId uint64
+		input  sdk.Coins
+		output sdk.Coins
+	}
+	type blockVolume struct {
+		poolId         uint64
+		denom0, denom1 string
+		denom          string
+		volume         sdk.Dec
+	}
+	tests := map[string]struct {
+		swaps           []swap
+		expectedVolumes []blockVolume
+	}{
+		"single swap": {
+			swaps: []swap{{1, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 50))}},
+			expectedVolumes: []blockVolume{
+				{1, denom0, denom1, denom0, sdk.NewDec(50)},
+				{1, denom0, denom1, denom1, sdk.NewDec(100)},
+			},
+		},
+		"swaps in both directions add up": {
+			swaps: []swap{
+				{1, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 50))},
+				{1, sdk.NewCoins(sdk.NewInt64Coin(denom0, 20)), sdk.NewCoins(sdk.NewInt64Coin(denom1, 30))},
+			},
+			expectedVolumes: []blockVolume{
+				{1, denom0, denom1, denom0, sdk.NewDec(70)},
+				{1, denom0, denom1, denom1, sdk.NewDec(130)},
+			},
+		},
+		"swaps in different pools and pairs are tracked separately": {
+			swaps: []swap{
+				{1, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 50))},
+				{1, sdk.NewCoins(sdk.NewInt64Coin(denom2, 10)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 5))},
+				{2, sdk.NewCoins(sdk.NewInt64Coin(denom1, 7)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 3))},
+			},
+			expectedVolumes: []blockVolume{
+				{1, denom0, denom1, denom0, sdk.NewDec(50)},
+				{1, denom0, denom1, denom1, sdk.NewDec(100)},
+				{1, denom0, denom2, denom0, sdk.NewDec(5)},
+				{1, denom0, denom2, denom2, sdk.NewDec(10)},
+				{1, denom1, denom2, denom1, sdk.ZeroDec()},
+				{2, denom0, denom1, denom0, sdk.NewDec(3)},
+				{2, denom0, denom1, denom1, sdk.NewDec(7)},
+			},
+		},
+		"swap with multiple coins in is ignored": {
+			swaps: []swap{{1, sdk.NewCoins(sdk.NewInt64Coin(denom1, 100), sdk.NewInt64Coin(denom2, 100)), sdk.NewCoins(sdk.NewInt64Coin(denom0, 50))}},
+			expectedVolumes: []blockVolume{
+				{1, denom0, denom1, denom0, sdk.ZeroDec()},
+				{1, denom0, denom1, denom1, sdk.ZeroDec()},
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, swap := range test.swaps {
+				s.twapkeeper.TrackSwapVolume(s.Ctx, swap.poolId, swap.input, swap.output)
+			}
+
+			for _, expected := range test.expectedVolumes {
+				volume := s.twapkeeper.GetBlockVolume(s.Ctx, expected.poolId, expected.denom0, expected.denom1, expected.denom)
+				s.Require().Equal(expected.volume, volume)
+			}
+
+			// the volumes do not register as changed pools.
+			s.Require().Empty(s.twapkeeper.GetChangedPools(s.Ctx))
+
+			// the volumes only live for the duration of the block.
+			s.Commit()
+			for _, expected := range test.expectedVolumes {
+				volume := s.twapkeeper.GetBlockVolume(s.Ctx, expected.poolId, expected.denom0, expected.denom1, expected.denom)
+				s.Require().Equal(sdk.ZeroDec(), volume)
+			}
+		})
+	}
+}
+
 // TestGetAllMostRecentRecordsForPool takes a list of records as test cases,
 // and runs storeNewRecord for everything in sequence.
 // Then it runs GetAllMostRecentRecordsForPool, and sees if its equal to expected
@@ -286,7 +368,8 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 		// across many test cases on purpose.
 		recordsToPreSet []types.TwapRecord
 
-		lastKeptTime time.Time
+		lastKeptTime      time.Time
+		poolLastKeptTimes map[uint64]time.Time
 
 		expectedKeptRecords []types.TwapRecord
 	}{
@@ -430,6 +513,35 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 				pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older
 			},
 		},
+		"base time; across pool 3 and pool 5; pool 5 last kept time at base time - 1s - 1ms; pool 3: 2 deleted and newest 2 kept. pool 5: 3 deleted and 15 kept": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin3Ms, // base time - 3ms; deleted
+				pool3BaseSecMin2Ms, // base time - 2ms; deleted
+				pool3BaseSecMin1Ms, // base time - 1ms; kept since newest before lastKeptTime
+				pool3BaseSecBaseMs, // base time; kept since at lastKeptTime
+
+				pool5Min2SBaseMsAB, pool5Min2SBaseMsAC, pool5Min2SBaseMsBC, // base time - 2s; kept since newest before pool last kept time
+				pool5Min1SBaseMsAB, pool5Min1SBaseMsAC, pool5Min1SBaseMsBC, // base time - 1s; kept since after pool last kept time
+				pool5BaseSecBaseMsAB, pool5BaseSecBaseMsAC, pool5BaseSecBaseMsBC, // base time; kept since after pool last kept time
+
+				pool5Min2SMin1MsAB, pool5Min2SMin1MsAC, pool5Min2SMin1MsBC, // base time - 2s - 1ms; deleted
+				pool5Min1SMin1MsAB, pool5Min1SMin1MsAC, pool5Min1SMin1MsBC, // base time - 1s - 1ms; kept since at pool last kept time
+				pool5BaseSecMin1MsAB, pool5BaseSecMin1MsAC, pool5BaseSecMin1MsBC, // base time - 1ms; kept since after pool last kept time
+			},
+
+			lastKeptTime:      baseTime,
+			poolLastKeptTimes: map[uint64]time.Time{5: baseTime.Add(-time.Second).Add(-time.Millisecond)},
+
+			expectedKeptRecords: []types.TwapRecord{
+				pool5Min2SBaseMsAB, pool5Min2SBaseMsAC, pool5Min2SBaseMsBC,
+				pool5Min1SMin1MsAB, pool5Min1SMin1MsAC, pool5Min1SMin1MsBC,
+				pool5Min1SBaseMsAB, pool5Min1SBaseMsAC, pool5Min1SBaseMsBC,
+				pool3BaseSecMin1Ms,
+				pool5BaseSecMin1MsAB, pool5BaseSecMin1MsAC, pool5BaseSecMin1MsBC,
+				pool3BaseSecBaseMs,
+				pool5BaseSecBaseMsAB, pool5BaseSecBaseMsAC, pool5BaseSecBaseMsBC,
+			},
+		},
 		"no pre-set records - no error": {
 			recordsToPreSet: []types.TwapRecord{},
 
@@ -446,7 +558,7 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 			ctx := s.Ctx
 			twapKeeper := s.twapkeeper
 
-			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime)
+			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime, tc.poolLastKeptTimes)
 			s.Require().NoError(err)
 
 			s.validateExpectedRecords(tc.expectedKeptRecords)
@@ -9,8 +9,11 @@ import (
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 )
 
+// ln2 is the natural logarithm of 2, used to convert base 2 logarithms to natural logarithms.
+var ln2 = sdk.MustNewDecFromStr("0.693147180559945309")
+
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have four strategies implementing the interface - arithmetic, geometric, volatility and liquidity.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -25,6 +28,14 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type volatility struct {
+	TwapKeeper Keeper
+}
+
+type liquidity struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
@@ -43,8 +54,9 @@ func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.T
 func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
 	accumDiff := endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator)
 
+	// the arithmetic mean of the log prices is zero, e.g. for a constant price of one, so the TWAP is 2^0.
 	if accumDiff.IsZero() {
-		return sdk.ZeroDec()
+		return sdk.OneDec()
 	}
 
 	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
@@ -72,3 +84,33 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.SDKDec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns the realized volatility between two records,
+// i.e. the square root of the sum of the squared natural logarithmic returns of the spot price
+// between the record updates in the time range. The result is not annualized.
+// The quote asset is irrelevant, since the returns of a price and of its inverse square to the same value.
+func (s *volatility) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	accumDiff := endRecord.SquaredLogReturnAccumulator.Sub(startRecord.SquaredLogReturnAccumulator)
+	if !accumDiff.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	// The accumulator holds squared base 2 logarithmic returns, and ln(x) = log_2(x) * ln(2).
+	realizedVariance := accumDiff.Mul(ln2).Mul(ln2)
+	// ApproxSqrt only errors on negative inputs, which is ruled out above.
+	realizedVolatility, _ := realizedVariance.ApproxSqrt()
+	return realizedVolatility
+}
+
+// computeTwap computes and returns the time weighted liquidity between
+// two records, valued in terms of the quote asset.
+func (s *liquidity) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) sdk.Dec {
+	var accumDiff sdk.Dec
+	if quoteAsset == startRecord.Asset0Denom {
+		accumDiff = endRecord.P0LiquidityAccumulator.Sub(startRecord.P0LiquidityAccumulator)
+	} else {
+		accumDiff = endRecord.P1LiquidityAccumulator.Sub(startRecord.P1LiquidityAccumulator)
+	}
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	return types.AccumDiffDivDuration(accumDiff, timeDelta)
+}
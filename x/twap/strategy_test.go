@@ -278,12 +278,19 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap() {
 			expTwap:     sdk.OneDec().Quo(gammtypes.MinSpotPrice),
 		},
 
-		"zero accum difference - return zero": {
+		"zero accum difference - return one": {
 			startRecord: newOneSidedGeometricRecord(baseTime, sdk.ZeroDec()),
 			endRecord:   newOneSidedGeometricRecord(baseRecord.Time.Add(time.Millisecond), sdk.ZeroDec()),
 			quoteAsset:  denom1,
 
-			expTwap: sdk.ZeroDec(),
+			expTwap: sdk.OneDec(),
+		},
+		"zero accum difference, denom0 quote - return one": {
+			startRecord: newOneSidedGeometricRecord(baseTime, geometricTenSecAccum),
+			endRecord:   newOneSidedGeometricRecord(baseRecord.Time.Add(time.Second), geometricTenSecAccum),
+			quoteAsset:  denom0,
+
+			expTwap: sdk.OneDec(),
 		},
 
 		"start record time with nanoseconds does not change result": {
@@ -377,3 +384,113 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap_ThreeAsset() {
 		})
 	}
 }
+
+func (s *TestSuite) TestComputeVolatilityStrategyTwap() {
+	// ln(2) with the precision of sdk.Dec.
+	ln2 := sdk.MustNewDecFromStr("0.693147180559945309")
+	tolerance := sdk.NewDecWithPrec(1, 16)
+
+	tests := map[string]struct {
+		startAccum sdk.Dec
+		endAccum   sdk.Dec
+		quoteAsset string
+		expTwap    sdk.Dec
+	}{
+		"zero accum difference - return zero": {
+			startAccum: sdk.ZeroDec(),
+			endAccum:   sdk.ZeroDec(),
+			quoteAsset: denom0,
+			expTwap:    sdk.ZeroDec(),
+		},
+		"one squared log2 return (price doubled) - return ln(2)": {
+			startAccum: sdk.ZeroDec(),
+			endAccum:   sdk.OneDec(),
+			quoteAsset: denom0,
+			expTwap:    ln2,
+		},
+		"one squared log2 return, quote asset does not change result": {
+			startAccum: sdk.ZeroDec(),
+			endAccum:   sdk.OneDec(),
+			quoteAsset: denom1,
+			expTwap:    ln2,
+		},
+		"non-zero start accumulator, four squared log2 returns - return 2 * ln(2)": {
+			startAccum: sdk.NewDec(3),
+			endAccum:   sdk.NewDec(7),
+			quoteAsset: denom0,
+			expTwap:    ln2.MulInt64(2),
+		},
+		"price changed by 10x - return ln(10)": {
+			startAccum: sdk.ZeroDec(),
+			endAccum:   logTen.Mul(logTen),
+			quoteAsset: denom0,
+			expTwap:    sdk.MustNewDecFromStr("2.302585092994045684"),
+		},
+		"invalid call: decreasing accumulator - return zero": {
+			startAccum: sdk.OneDec(),
+			endAccum:   sdk.ZeroDec(),
+			quoteAsset: denom0,
+			expTwap:    sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			volatilityStrategy := &twap.VolatilityStrategy{TwapKeeper: *s.App.TwapKeeper}
+			startRecord := withSquaredLogReturnAccum(newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1), tc.startAccum)
+			endRecord := withSquaredLogReturnAccum(newEmptyPriceRecord(basePoolId, tPlusOneMin, denom0, denom1), tc.endAccum)
+
+			actualTwap := volatilityStrategy.ComputeTwap(startRecord, endRecord, tc.quoteAsset)
+
+			s.Require().True(tc.expTwap.Sub(actualTwap).Abs().LTE(tolerance), "expected %s, got %s", tc.expTwap, actualTwap)
+		})
+	}
+}
+
+func (s *TestSuite) TestComputeLiquidityStrategyTwap() {
+	tests := map[string]struct {
+		startAccums [2]sdk.Dec
+		endAccums   [2]sdk.Dec
+		quoteAsset  string
+		expTwap     sdk.Dec
+	}{
+		"zero accum difference - return zero": {
+			startAccums: [2]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			endAccums:   [2]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			quoteAsset:  denom0,
+			expTwap:     sdk.ZeroDec(),
+		},
+		"quote asset 0 - return p0 liquidity": {
+			startAccums: [2]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			endAccums:   [2]sdk.Dec{OneSec.MulInt64(60 * 100), OneSec.MulInt64(60 * 10)},
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(100),
+		},
+		"quote asset 1 - return p1 liquidity": {
+			startAccums: [2]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			endAccums:   [2]sdk.Dec{OneSec.MulInt64(60 * 100), OneSec.MulInt64(60 * 10)},
+			quoteAsset:  denom1,
+			expTwap:     sdk.NewDec(10),
+		},
+		"non-zero start accumulators": {
+			startAccums: [2]sdk.Dec{OneSec.MulInt64(100), OneSec.MulInt64(10)},
+			endAccums:   [2]sdk.Dec{OneSec.MulInt64(100 + 60*50), OneSec.MulInt64(10 + 60*5)},
+			quoteAsset:  denom0,
+			expTwap:     sdk.NewDec(50),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			liquidityStrategy := &twap.LiquidityStrategy{TwapKeeper: *s.App.TwapKeeper}
+			startRecord := withLiquidityAccums(newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1), tc.startAccums[0], tc.startAccums[1])
+			endRecord := withLiquidityAccums(newEmptyPriceRecord(basePoolId, tPlusOneMin, denom0, denom1), tc.endAccums[0], tc.endAccums[1])
+
+			actualTwap := liquidityStrategy.ComputeTwap(startRecord, endRecord, tc.quoteAsset)
+
+			s.Require().Equal(tc.expTwap, actualTwap)
+		})
+	}
+}
@@ -79,6 +79,13 @@ type AppModule struct {
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	queryproto.RegisterQueryServer(cfg.QueryServer(), grpc.Querier{Q: twapclient.Querier{K: am.k}})
+
+	if err := cfg.RegisterMigration(types.ModuleName, 1, am.k.MigrateParamsPoolRecordHistoryKeepPeriods); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 1 to 2: %s", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, am.k.MigrateMostRecentRecordsLiquidities); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 2 to 3: %s", types.ModuleName, err))
+	}
 }
 
 func NewAppModule(twapKeeper twap.Keeper) AppModule {
@@ -134,4 +141,4 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	time "time"
 )
 
+// ErrSpotPriceErrorInRange is returned along with the TWAP when a spot price error occurred
+// within its time range, in which case the TWAP may be faulty.
+var ErrSpotPriceErrorInRange = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+
 type EndTimeInFutureError struct {
 	EndTime   time.Time
 	BlockTime time.Time
@@ -73,3 +78,43 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type NoAggregateTwapLiquidityError struct {
+	BaseDenom  string
+	QuoteDenom string
+	PoolIds    []uint64
+}
+
+func (e NoAggregateTwapLiquidityError) Error() string {
+	return fmt.Sprintf("no liquidity to weight the aggregate twap of %s in terms of %s; pools considered: %v", e.BaseDenom, e.QuoteDenom, e.PoolIds)
+}
+
+type DuplicatePoolIdError struct {
+	PoolId uint64
+}
+
+func (e DuplicatePoolIdError) Error() string {
+	return fmt.Sprintf("pool id %d is given more than once", e.PoolId)
+}
+
+type NoSwapVolumeError struct {
+	PoolId     uint64
+	BaseDenom  string
+	QuoteDenom string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+func (e NoSwapVolumeError) Error() string {
+	return fmt.Sprintf("no %s swapped against %s in pool %d between %s and %s, the vwap is undefined",
+		e.BaseDenom, e.QuoteDenom, e.PoolId, e.StartTime, e.EndTime)
+}
+
+type TooManyBatchTwapItemsError struct {
+	NumItems int
+	MaxItems int
+}
+
+func (e TooManyBatchTwapItemsError) Error() string {
+	return fmt.Sprintf("batch twap query has too many items. (got %d items, maximum %d)", e.NumItems, e.MaxItems)
+}
@@ -15,4 +15,6 @@ type PoolManagerInterface interface {
 		quoteAssetDenom string,
 		baseAssetDenom string,
 	) (price sdk.Dec, err error)
+	// GetTotalPoolLiquidity returns the coins in the pool owned by all LPs.
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
@@ -84,5 +84,36 @@ func (t TwapRecord) validate() error {
 	if t.GeometricTwapAccumulator.IsNil() {
 		return fmt.Errorf("twap record geometric accumulator cannot be nil, was (%s)", t.GeometricTwapAccumulator)
 	}
+
+	// a nil squared log return accumulator is allowed for records predating it, and treated as zero.
+	if !t.SquaredLogReturnAccumulator.IsNil() && t.SquaredLogReturnAccumulator.IsNegative() {
+		return fmt.Errorf("twap record squared log return accumulator cannot be negative, was (%s)", t.SquaredLogReturnAccumulator)
+	}
+
+	// nil liquidities and liquidity accumulators are allowed for records predating them, and treated as zero.
+	if !t.P0LastLiquidity.IsNil() && t.P0LastLiquidity.IsNegative() {
+		return fmt.Errorf("twap record p0 last liquidity cannot be negative, was (%s)", t.P0LastLiquidity)
+	}
+
+	if !t.P1LastLiquidity.IsNil() && t.P1LastLiquidity.IsNegative() {
+		return fmt.Errorf("twap record p1 last liquidity cannot be negative, was (%s)", t.P1LastLiquidity)
+	}
+
+	if !t.P0LiquidityAccumulator.IsNil() && t.P0LiquidityAccumulator.IsNegative() {
+		return fmt.Errorf("twap record p0 liquidity accumulator cannot be negative, was (%s)", t.P0LiquidityAccumulator)
+	}
+
+	if !t.P1LiquidityAccumulator.IsNil() && t.P1LiquidityAccumulator.IsNegative() {
+		return fmt.Errorf("twap record p1 liquidity accumulator cannot be negative, was (%s)", t.P1LiquidityAccumulator)
+	}
+
+	// nil volume accumulators are allowed for records predating them, and treated as zero.
+	if !t.Asset0VolumeAccumulator.IsNil() && t.Asset0VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset0 volume accumulator cannot be negative, was (%s)", t.Asset0VolumeAccumulator)
+	}
+
+	if !t.Asset1VolumeAccumulator.IsNil() && t.Asset1VolumeAccumulator.IsNegative() {
+		return fmt.Errorf("twap record asset1 volume accumulator cannot be negative, was (%s)", t.Asset1VolumeAccumulator)
+	}
 	return nil
 }
@@ -33,6 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// pool_record_history_keep_periods overrides record_history_keep_period for
+	// specific pools, to serve TWAPs over longer time ranges for these pools
+	// without keeping more records for all the other pools.
+	PoolRecordHistoryKeepPeriods []PoolRecordHistoryKeepPeriod `protobuf:"bytes,3,rep,name=pool_record_history_keep_periods,json=poolRecordHistoryKeepPeriods,proto3" json:"pool_record_history_keep_periods" yaml:"pool_record_history_keep_periods"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +86,67 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPoolRecordHistoryKeepPeriods() []PoolRecordHistoryKeepPeriod {
+	if m != nil {
+		return m.PoolRecordHistoryKeepPeriods
+	}
+	return nil
+}
+
+// PoolRecordHistoryKeepPeriod is the record history keep period of a pool,
+// overriding the module wide record history keep period if it is longer.
+type PoolRecordHistoryKeepPeriod struct {
+	PoolId                  uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Reset()         { *m = PoolRecordHistoryKeepPeriod{} }
+func (m *PoolRecordHistoryKeepPeriod) String() string { return proto.CompactTextString(m) }
+func (*PoolRecordHistoryKeepPeriod) ProtoMessage()    {}
+func (*PoolRecordHistoryKeepPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecordHistoryKeepPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.Merge(m, src)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecordHistoryKeepPeriod proto.InternalMessageInfo
+
+func (m *PoolRecordHistoryKeepPeriod) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecordHistoryKeepPeriod) GetRecordHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.RecordHistoryKeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
@@ -94,7 +159,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,6 +204,7 @@ func (m *GenesisState) GetParams() Params {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*PoolRecordHistoryKeepPeriod)(nil), "osmosis.twap.v1beta1.PoolRecordHistoryKeepPeriod")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +213,37 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0x5b, 0xa1, 0x34, 0x64, 0x40, 0x5d,
-	0x6a, 0xd3, 0x82, 0x18, 0x2a, 0xa6, 0x0a, 0xc4, 0xd7, 0x52, 0x05, 0x26, 0x96, 0xc8, 0x49, 0x6e,
-	0x53, 0x8b, 0x26, 0xb6, 0x6c, 0xa7, 0x25, 0x0f, 0x80, 0xc4, 0xc8, 0xc8, 0x13, 0xa1, 0x8e, 0x1d,
-	0x99, 0x0a, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0x89, 0x5d, 0x06, 0xd4, 0xff, 0x96, 0xab, 0xdf, 0x39,
-	0x47, 0x27, 0xf7, 0xda, 0x0d, 0xb9, 0x2a, 0xb8, 0x62, 0x8a, 0xe8, 0x2d, 0x15, 0x64, 0x33, 0x49,
-	0x40, 0xd3, 0x09, 0xc9, 0xa1, 0x04, 0xc5, 0x14, 0x16, 0x92, 0x6b, 0xee, 0x75, 0xad, 0x06, 0x37,
-	0x1a, 0x6c, 0x35, 0x83, 0x6e, 0xce, 0x73, 0xde, 0x0a, 0x48, 0xf3, 0x65, 0xb4, 0x83, 0x47, 0x17,
-	0xf3, 0x9a, 0x21, 0x96, 0x90, 0x72, 0x99, 0x59, 0x5d, 0x3f, 0xe7, 0x3c, 0x5f, 0x03, 0x69, 0xa7,
-	0xa4, 0x5a, 0x12, 0x5a, 0xd6, 0x67, 0x94, 0xb6, 0x19, 0xb1, 0xc9, 0x36, 0x83, 0x45, 0xfe, 0xff,
-	0xae, 0xac, 0x92, 0x54, 0x33, 0x5e, 0x1a, 0x1e, 0xfe, 0x40, 0x6e, 0x67, 0x41, 0x25, 0x2d, 0x94,
-	0xf7, 0xd4, 0xbd, 0x27, 0x64, 0x55, 0x42, 0x0c, 0x82, 0xa7, 0xab, 0x98, 0x65, 0x50, 0x6a, 0xb6,
-	0x64, 0x20, 0x7b, 0x28, 0x40, 0xa3, 0x3b, 0x51, 0xb7, 0xa5, 0x2f, 0x1b, 0xf8, 0xe6, 0x1f, 0xf3,
-	0xbe, 0x20, 0x77, 0x60, 0x7a, 0xc6, 0x2b, 0xa6, 0x34, 0x97, 0x75, 0xfc, 0x09, 0x40, 0xc4, 0x02,
-	0x24, 0xe3, 0x59, 0xef, 0x46, 0x80, 0x46, 0x57, 0xd3, 0x3e, 0x36, 0x35, 0xf0, 0xb9, 0x06, 0x7e,
-	0x61, 0x6b, 0xcc, 0xc7, 0xbb, 0xc3, 0xd0, 0xf9, 0x73, 0x18, 0x3e, 0xac, 0x69, 0xb1, 0x9e, 0x85,
-	0xd7, 0x47, 0x85, 0xdf, 0x7f, 0x0d, 0x51, 0x74, 0xdf, 0x08, 0x5e, 0x1b, 0xfe, 0x0e, 0x40, 0x2c,
-	0x0c, 0xfd, 0x8a, 0xdc, 0xbb, 0xaf, 0xcc, 0x11, 0xde, 0x6b, 0xaa, 0xc1, 0x7b, 0xee, 0xde, 0x6e,
-	0x96, 0xa8, 0x7a, 0x28, 0xb8, 0x39, 0xba, 0x9a, 0x06, 0xf8, 0xd2, 0x4d, 0xf0, 0x87, 0x2d, 0x15,
-	0x51, 0x1b, 0x39, 0xbf, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xb9, 0x1d, 0xd1, 0xae, 0xc5, 0xfe,
-	0xc1, 0x83, 0xcb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xf9, 0xdb, 0xdd, 0xd1, 0x47, 0xfb, 0xa3,
-	0x8f, 0x7e, 0x1f, 0x7d, 0xf4, 0xed, 0xe4, 0x3b, 0xfb, 0x93, 0xef, 0xfc, 0x3c, 0xf9, 0xce, 0xc7,
-	0xc7, 0x39, 0xd3, 0xab, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x1b, 0xaf, 0x69, 0xa2, 0xce, 0x03,
-	0xd9, 0x4c, 0x9e, 0x91, 0xcf, 0xe6, 0x25, 0xe8, 0x5a, 0x80, 0x4a, 0x3a, 0xed, 0xc6, 0x9e, 0xfc,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x34, 0x4d, 0x50, 0x69, 0x76, 0x02, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x25, 0x88, 0x2d, 0xe2, 0x60, 0x45, 0x90, 0x86, 0xca, 0x31, 0x3e, 0x40, 0x24,
+	0x54, 0x2f, 0x29, 0x88, 0x43, 0xc5, 0xc9, 0x02, 0x41, 0xe1, 0x12, 0x19, 0x4e, 0x5c, 0xac, 0x75,
+	0xbc, 0x75, 0x56, 0x38, 0x9e, 0xd5, 0xee, 0xa6, 0x25, 0x1f, 0x80, 0xc4, 0x91, 0x23, 0xdf, 0xc0,
+	0x97, 0xf4, 0x58, 0x71, 0xe2, 0x14, 0x50, 0xf2, 0x07, 0xe1, 0x07, 0x90, 0x77, 0x37, 0x08, 0xa1,
+	0x04, 0xae, 0xbd, 0xed, 0xe8, 0xbd, 0x79, 0xf3, 0xfc, 0xc6, 0x83, 0x43, 0x50, 0x63, 0x50, 0x5c,
+	0x11, 0x7d, 0x46, 0x05, 0x39, 0xed, 0x67, 0x4c, 0xd3, 0x3e, 0x29, 0x58, 0xc5, 0x14, 0x57, 0x91,
+	0x90, 0xa0, 0xc1, 0x6b, 0x39, 0x4e, 0x54, 0x73, 0x22, 0xc7, 0xe9, 0xb4, 0x0a, 0x28, 0xc0, 0x10,
+	0x48, 0xfd, 0xb2, 0xdc, 0xce, 0xdd, 0xb5, 0x7a, 0x75, 0x91, 0x4a, 0x36, 0x04, 0x99, 0x3b, 0xde,
+	0x5e, 0x01, 0x50, 0x94, 0x8c, 0x98, 0x2a, 0x9b, 0x9c, 0x10, 0x5a, 0x4d, 0x57, 0xd0, 0xd0, 0x68,
+	0xa4, 0x56, 0xdb, 0x16, 0x0e, 0xf2, 0xff, 0xee, 0xca, 0x27, 0x92, 0x6a, 0x0e, 0x95, 0xc5, 0xc3,
+	0x9f, 0x5b, 0xb8, 0x39, 0xa0, 0x92, 0x8e, 0x95, 0xf7, 0x08, 0xdf, 0x14, 0x72, 0x52, 0xb1, 0x94,
+	0x09, 0x18, 0x8e, 0x52, 0x9e, 0xb3, 0x4a, 0xf3, 0x13, 0xce, 0x64, 0x1b, 0x05, 0xa8, 0x77, 0x2d,
+	0x69, 0x19, 0xf4, 0x59, 0x0d, 0x1e, 0xff, 0xc6, 0xbc, 0x0f, 0x08, 0x77, 0xac, 0xcf, 0x74, 0xc4,
+	0x95, 0x06, 0x39, 0x4d, 0xdf, 0x31, 0x26, 0x52, 0xc1, 0x24, 0x87, 0xbc, 0xbd, 0x15, 0xa0, 0xde,
+	0xee, 0xe1, 0x5e, 0x64, 0x6d, 0x44, 0x2b, 0x1b, 0xd1, 0x53, 0x67, 0x23, 0x3e, 0x38, 0x9f, 0x75,
+	0x1b, 0xcb, 0x59, 0xf7, 0xce, 0x94, 0x8e, 0xcb, 0xa3, 0x70, 0xb3, 0x54, 0xf8, 0xf9, 0x7b, 0x17,
+	0x25, 0xb7, 0x2c, 0xe1, 0x85, 0xc5, 0x5f, 0x31, 0x26, 0x06, 0x06, 0xf5, 0xbe, 0x20, 0x1c, 0x08,
+	0x80, 0x32, 0xdd, 0xac, 0xa0, 0xda, 0xdb, 0xc1, 0x76, 0x6f, 0xf7, 0xb0, 0x1f, 0xad, 0x5b, 0x4f,
+	0x34, 0x00, 0x28, 0x93, 0xf5, 0xea, 0x31, 0x71, 0x2e, 0xef, 0x59, 0x97, 0xff, 0x1b, 0x14, 0x26,
+	0xfb, 0x62, 0xb3, 0x9a, 0x0a, 0xbf, 0x22, 0x7c, 0xfb, 0x1f, 0xe3, 0xbc, 0xfb, 0xf8, 0xaa, 0x19,
+	0xc1, 0x73, 0x93, 0xfd, 0x4e, 0xec, 0x2d, 0x67, 0xdd, 0x1b, 0x7f, 0xcc, 0xe6, 0x79, 0x98, 0x34,
+	0xeb, 0xd7, 0x71, 0x7e, 0x59, 0x36, 0x10, 0x7e, 0x44, 0xf8, 0xfa, 0x73, 0x7b, 0x06, 0xaf, 0x35,
+	0xd5, 0xcc, 0x7b, 0x82, 0xaf, 0xd4, 0x01, 0xab, 0x36, 0x32, 0xb1, 0x07, 0xeb, 0x63, 0x7f, 0x73,
+	0x46, 0x85, 0xcd, 0x21, 0xde, 0xa9, 0x9d, 0x24, 0xb6, 0xc9, 0x3b, 0xc2, 0x4d, 0x61, 0x7e, 0x4c,
+	0xf7, 0x05, 0xfb, 0x1b, 0xb6, 0x66, 0x38, 0xae, 0xd5, 0x75, 0xc4, 0x2f, 0xcf, 0xe7, 0x3e, 0xba,
+	0x98, 0xfb, 0xe8, 0xc7, 0xdc, 0x47, 0x9f, 0x16, 0x7e, 0xe3, 0x62, 0xe1, 0x37, 0xbe, 0x2d, 0xfc,
+	0xc6, 0xdb, 0x07, 0x05, 0xd7, 0xa3, 0x49, 0x16, 0x0d, 0x61, 0x4c, 0x9c, 0xde, 0x41, 0x49, 0x33,
+	0xb5, 0x2a, 0xc8, 0x69, 0xff, 0x31, 0x79, 0x6f, 0x6f, 0x51, 0x4f, 0x05, 0x53, 0x59, 0xd3, 0x24,
+	0xf6, 0xf0, 0xd7, 0x00, 0x8f, 0xd0, 0xb9, 0xc1, 0xf8, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +266,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for iNdEx := len(m.PoolRecordHistoryKeepPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRecordHistoryKeepPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -213,6 +298,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolRecordHistoryKeepPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -283,6 +404,26 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for _, e := range m.PoolRecordHistoryKeepPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -403,6 +544,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecordHistoryKeepPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecordHistoryKeepPeriods = append(m.PoolRecordHistoryKeepPeriods, PoolRecordHistoryKeepPeriod{})
+			if err := m.PoolRecordHistoryKeepPeriods[len(m.PoolRecordHistoryKeepPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecordHistoryKeepPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
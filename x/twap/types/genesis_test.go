@@ -139,6 +139,39 @@ func TestGenesisState_Validate(t *testing.T) {
 
 			expectedErr: true,
 		},
+		"valid pool record history keep periods": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams,
+					PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 96 * time.Hour},
+					PoolRecordHistoryKeepPeriod{PoolId: 2, RecordHistoryKeepPeriod: time.Hour},
+				),
+				[]TwapRecord{
+					baseRecord,
+				}),
+		},
+		"invalid pool record history keep period - error": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams,
+					PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: -1 * time.Hour}, // invalid duration
+				),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"duplicate pool record history keep period pool id - error": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams,
+					PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 96 * time.Hour},
+					PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 72 * time.Hour}, // duplicate pool id
+				),
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -386,3 +419,8 @@ func TestTWAPRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withPoolRecordHistoryKeepPeriods(params Params, poolRecordHistoryKeepPeriods ...PoolRecordHistoryKeepPeriod) Params {
+	params.PoolRecordHistoryKeepPeriods = poolRecordHistoryKeepPeriods
+	return params
+}
@@ -42,6 +42,15 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+
+	// The transient store keys below only live for the duration of a block.
+
+	// format is little endian encoded pool id
+	// made for iterating over the pools changed in the block
+	ChangedPoolPrefix = []byte("changed_pool" + KeySeparator)
+	// format is pool id | denom0 | denom1 | denom
+	// made for getting the volume of a denom swapped against the other denom of the pair in the block
+	blockVolumePrefix = "block_volume" + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -72,6 +81,10 @@ func FormatHistoricalPoolIndexTimeSuffix(poolId uint64, denom1, denom2 string, a
 	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s.", HistoricalTWAPPoolIndexPrefix, poolId, KeySeparator, denom1, KeySeparator, denom2, KeySeparator, timeS))
 }
 
+func FormatBlockVolumeKey(poolId uint64, denom0, denom1, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%d%s%s%s%s%s%s", blockVolumePrefix, poolId, KeySeparator, denom0, KeySeparator, denom1, KeySeparator, denom))
+}
+
 // GetAllMostRecentTwapsForPool returns all of the most recent twap records for a pool id.
 // if the pool id doesn't exist, then this returns a blank list.
 func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecord, error) {
@@ -90,5 +103,31 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = sdk.ZeroDec()
 	}
+	if twap.SquaredLogReturnAccumulator.IsNil() {
+		twap.SquaredLogReturnAccumulator = sdk.ZeroDec()
+	}
+	if twap.P0LastLiquidity.IsNil() {
+		twap.P0LastLiquidity = sdk.ZeroDec()
+	}
+	if twap.P1LastLiquidity.IsNil() {
+		twap.P1LastLiquidity = sdk.ZeroDec()
+	}
+	if twap.P0LiquidityAccumulator.IsNil() {
+		twap.P0LiquidityAccumulator = sdk.ZeroDec()
+	}
+	if twap.P1LiquidityAccumulator.IsNil() {
+		twap.P1LiquidityAccumulator = sdk.ZeroDec()
+	}
+	if twap.Asset0VolumeAccumulator.IsNil() {
+		twap.Asset0VolumeAccumulator = sdk.ZeroDec()
+	}
+	if twap.Asset1VolumeAccumulator.IsNil() {
+		twap.Asset1VolumeAccumulator = sdk.ZeroDec()
+	}
 	return twap, err
 }
+
+// GetAllMostRecentTwaps returns the most recent twap records of all pools.
+func GetAllMostRecentTwaps(store sdk.KVStore) ([]TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(store, []byte(mostRecentTWAPsPrefix), ParseTwapFromBz)
+}
@@ -11,8 +11,9 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
-	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPruneEpochIdentifier         = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod      = []byte("RecordHistoryKeepPeriod")
+	KeyPoolRecordHistoryKeepPeriods = []byte("PoolRecordHistoryKeepPeriods")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -52,14 +53,31 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePoolRecordHistoryKeepPeriods(p.PoolRecordHistoryKeepPeriods); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// PoolRecordHistoryKeepPeriod returns the record history keep period of the given pool.
+// That is the pool's override if it is longer than the module wide keep period,
+// or the module wide keep period otherwise.
+func (p Params) PoolRecordHistoryKeepPeriod(poolId uint64) time.Duration {
+	for _, poolKeepPeriod := range p.PoolRecordHistoryKeepPeriods {
+		if poolKeepPeriod.PoolId == poolId && poolKeepPeriod.RecordHistoryKeepPeriod > p.RecordHistoryKeepPeriod {
+			return poolKeepPeriod.RecordHistoryKeepPeriod
+		}
+	}
+	return p.RecordHistoryKeepPeriod
+}
+
 // Implements params.ParamSet.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyPoolRecordHistoryKeepPeriods, &p.PoolRecordHistoryKeepPeriods, validatePoolRecordHistoryKeepPeriods),
 	}
 }
 
@@ -75,3 +93,24 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validatePoolRecordHistoryKeepPeriods(i interface{}) error {
+	v, ok := i.([]PoolRecordHistoryKeepPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPoolIds := make(map[uint64]struct{}, len(v))
+	for _, poolKeepPeriod := range v {
+		if _, ok := seenPoolIds[poolKeepPeriod.PoolId]; ok {
+			return fmt.Errorf("duplicate record history keep period for pool id %d", poolKeepPeriod.PoolId)
+		}
+		seenPoolIds[poolKeepPeriod.PoolId] = struct{}{}
+
+		if err := validatePeriod(poolKeepPeriod.RecordHistoryKeepPeriod); err != nil {
+			return fmt.Errorf("invalid record history keep period for pool id %d: %w", poolKeepPeriod.PoolId, err)
+		}
+	}
+
+	return nil
+}
@@ -60,6 +60,24 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// Sum of the squared base 2 logarithmic returns of the spot price between
+	// consecutive record updates. It is used to compute the realized volatility
+	// of the pair over a time range. Since log_2(1 / P) = -log_2(P), the returns
+	// of both spot prices square to the same value.
+	SquaredLogReturnAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=squared_log_return_accumulator,json=squaredLogReturnAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"squared_log_return_accumulator"`
+	// We store the last liquidities in the struct, so that we can interpolate
+	// liquidity accumulator values for times between when records are stored.
+	// The liquidity is the value of the pool's asset0 and asset1 reserves,
+	// in terms of asset0 for p0 and in terms of asset1 for p1.
+	P0LastLiquidity        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=p0_last_liquidity,json=p0LastLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p0_last_liquidity"`
+	P1LastLiquidity        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=p1_last_liquidity,json=p1LastLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_last_liquidity"`
+	P0LiquidityAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=p0_liquidity_accumulator,json=p0LiquidityAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p0_liquidity_accumulator"`
+	P1LiquidityAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=p1_liquidity_accumulator,json=p1LiquidityAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p1_liquidity_accumulator"`
+	// Cumulative amounts of asset0 and asset1 swapped between each other in the
+	// pool. They are used to compute the volume weighted average price of the
+	// pair over a time range.
+	Asset0VolumeAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=asset0_volume_accumulator,json=asset0VolumeAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"asset0_volume_accumulator"`
+	Asset1VolumeAccumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=asset1_volume_accumulator,json=asset1VolumeAccumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"asset1_volume_accumulator"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -137,8 +155,123 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 	return time.Time{}
 }
 
+// TwapMetadata describes the records a TWAP query result of a pool is computed
+// from, so that callers can tell a fresh and reliable result from a stale or
+// interpolated one.
+type TwapMetadata struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// last_error_in_range is true if a spot price error occurred within the
+	// requested time range, in which case the result may be faulty.
+	LastErrorInRange bool `protobuf:"varint,2,opt,name=last_error_in_range,json=lastErrorInRange,proto3" json:"last_error_in_range,omitempty" yaml:"last_error_in_range"`
+	// last_error_time is the time of the last spot price error of the pair, as
+	// of the most recent record.
+	LastErrorTime time.Time `protobuf:"bytes,3,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// record_count is the number of records the time range spans, including
+	// the record at or before the start time it is interpolated from.
+	RecordCount      uint64    `protobuf:"varint,4,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty" yaml:"record_count"`
+	OldestRecordTime time.Time `protobuf:"bytes,5,opt,name=oldest_record_time,json=oldestRecordTime,proto3,stdtime" json:"oldest_record_time" yaml:"oldest_record_time"`
+	// time_since_last_update is the time elapsed between the most recent record
+	// and the current block time.
+	TimeSinceLastUpdate time.Duration `protobuf:"bytes,6,opt,name=time_since_last_update,json=timeSinceLastUpdate,proto3,stdduration" json:"time_since_last_update" yaml:"time_since_last_update"`
+	// start_time_interpolated and end_time_interpolated are true if there is no
+	// record at exactly the start and end time respectively, so that the
+	// accumulators at that time are interpolated from the preceding record.
+	StartTimeInterpolated bool `protobuf:"varint,7,opt,name=start_time_interpolated,json=startTimeInterpolated,proto3" json:"start_time_interpolated,omitempty" yaml:"start_time_interpolated"`
+	EndTimeInterpolated   bool `protobuf:"varint,8,opt,name=end_time_interpolated,json=endTimeInterpolated,proto3" json:"end_time_interpolated,omitempty" yaml:"end_time_interpolated"`
+}
+
+func (m *TwapMetadata) Reset()         { *m = TwapMetadata{} }
+func (m *TwapMetadata) String() string { return proto.CompactTextString(m) }
+func (*TwapMetadata) ProtoMessage()    {}
+func (*TwapMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{1}
+}
+func (m *TwapMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapMetadata.Merge(m, src)
+}
+func (m *TwapMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapMetadata proto.InternalMessageInfo
+
+func (m *TwapMetadata) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapMetadata) GetLastErrorInRange() bool {
+	if m != nil {
+		return m.LastErrorInRange
+	}
+	return false
+}
+
+func (m *TwapMetadata) GetLastErrorTime() time.Time {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapMetadata) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *TwapMetadata) GetOldestRecordTime() time.Time {
+	if m != nil {
+		return m.OldestRecordTime
+	}
+	return time.Time{}
+}
+
+func (m *TwapMetadata) GetTimeSinceLastUpdate() time.Duration {
+	if m != nil {
+		return m.TimeSinceLastUpdate
+	}
+	return 0
+}
+
+func (m *TwapMetadata) GetStartTimeInterpolated() bool {
+	if m != nil {
+		return m.StartTimeInterpolated
+	}
+	return false
+}
+
+func (m *TwapMetadata) GetEndTimeInterpolated() bool {
+	if m != nil {
+		return m.EndTimeInterpolated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
+	proto.RegisterType((*TwapMetadata)(nil), "osmosis.twap.v1beta1.TwapMetadata")
 }
 
 func init() {
@@ -146,41 +279,65 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x1a, 0x52, 0x7a, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x82, 0x64, 0x87, 0x0c, 0x55,
-	0x18, 0xea, 0x1f, 0x20, 0x31, 0xb0, 0x25, 0x2a, 0x03, 0x08, 0x21, 0x64, 0x3a, 0xc1, 0x70, 0x3a,
-	0xdb, 0x57, 0xc7, 0xc2, 0xce, 0x9d, 0xee, 0x2e, 0x2d, 0xf9, 0x2f, 0xfa, 0x67, 0x75, 0xec, 0x88,
-	0x18, 0x0c, 0x4a, 0x36, 0xc6, 0x4e, 0x8c, 0xe8, 0x7e, 0x24, 0x34, 0xe1, 0x97, 0x94, 0x29, 0x79,
-	0xef, 0x7d, 0xdf, 0xe7, 0xfb, 0x9e, 0xfd, 0x64, 0x70, 0x48, 0x78, 0x45, 0x78, 0xc1, 0x03, 0x71,
-	0x8e, 0x68, 0x70, 0x16, 0x25, 0x58, 0xa0, 0x48, 0x05, 0x90, 0xe1, 0x94, 0xb0, 0xcc, 0xa7, 0x8c,
-	0x08, 0x62, 0x77, 0x8c, 0xce, 0x97, 0x25, 0xdf, 0xe8, 0xba, 0x9d, 0x9c, 0xe4, 0x44, 0x09, 0x02,
-	0xf9, 0x4f, 0x6b, 0xbb, 0x0f, 0x72, 0x42, 0xf2, 0x12, 0x07, 0x2a, 0x4a, 0xa6, 0xa7, 0x01, 0x9a,
-	0xcc, 0x96, 0xa5, 0x54, 0x71, 0xa0, 0xee, 0xd1, 0x81, 0x29, 0xb9, 0x3a, 0x0a, 0x12, 0xc4, 0xf1,
-	0x6a, 0x90, 0x94, 0x14, 0x13, 0x53, 0xf7, 0x36, 0xa9, 0xa2, 0xa8, 0x30, 0x17, 0xa8, 0xa2, 0x5a,
-	0xd0, 0xff, 0xd1, 0x02, 0xe0, 0xe4, 0x1c, 0xd1, 0x58, 0xcd, 0x6d, 0xdf, 0x07, 0xbb, 0x94, 0x90,
-	0x12, 0x16, 0x99, 0x63, 0xf5, 0xac, 0x41, 0x33, 0x6e, 0xc9, 0xf0, 0x65, 0x66, 0x3f, 0x02, 0x77,
-	0x11, 0xe7, 0x58, 0x84, 0x30, 0xc3, 0x13, 0x52, 0x39, 0xb7, 0x7a, 0xd6, 0x60, 0x2f, 0x6e, 0xeb,
-	0xdc, 0xb1, 0x4c, 0xad, 0x24, 0x91, 0x91, 0xec, 0xdc, 0x90, 0x44, 0x5a, 0x32, 0x04, 0xad, 0x31,
-	0x2e, 0xf2, 0xb1, 0x70, 0x9a, 0x3d, 0x6b, 0xb0, 0x33, 0x7a, 0xfc, 0xbd, 0xf6, 0xf6, 0xf5, 0x23,
-	0x83, 0xba, 0x70, 0x5d, 0x7b, 0x9d, 0x19, 0xaa, 0xca, 0xe7, 0xfd, 0xb5, 0x74, 0x3f, 0x36, 0x8d,
-	0xf6, 0x1b, 0xd0, 0x94, 0x3b, 0x38, 0xb7, 0x7b, 0xd6, 0xa0, 0xfd, 0xa4, 0xeb, 0xeb, 0x05, 0xfd,
-	0xe5, 0x82, 0xfe, 0xc9, 0x72, 0xc1, 0x91, 0x7b, 0x59, 0x7b, 0x8d, 0xeb, 0xda, 0xb3, 0xd7, 0x78,
-	0xb2, 0xb9, 0x7f, 0xf1, 0xd5, 0xb3, 0x62, 0xc5, 0xb1, 0x3f, 0x00, 0x9b, 0x86, 0xb0, 0x44, 0x5c,
-	0x40, 0x4e, 0x89, 0x80, 0x94, 0x15, 0x29, 0x76, 0x5a, 0x72, 0xf6, 0x91, 0x2f, 0x09, 0x5f, 0x6a,
-	0xef, 0x30, 0x2f, 0xc4, 0x78, 0x9a, 0xf8, 0x29, 0xa9, 0xcc, 0xe3, 0x37, 0x3f, 0x47, 0x3c, 0xfb,
-	0x18, 0x88, 0x19, 0xc5, 0xdc, 0x3f, 0xc6, 0x69, 0x7c, 0x40, 0xc3, 0xd7, 0x88, 0x8b, 0x77, 0x94,
-	0x88, 0xb7, 0x12, 0xa3, 0xe0, 0xd1, 0x6f, 0xf0, 0xdd, 0x2d, 0xe1, 0xd1, 0x3a, 0x9c, 0x03, 0x97,
-	0x86, 0x10, 0xb1, 0x42, 0x8c, 0x2b, 0x2c, 0x8a, 0x14, 0xaa, 0x03, 0x44, 0x69, 0x3a, 0xad, 0xa6,
-	0x25, 0x12, 0x84, 0x39, 0x77, 0xb6, 0x32, 0x7a, 0x48, 0xc3, 0xe1, 0x0a, 0x2a, 0x6f, 0x63, 0xf8,
-	0x0b, 0xa9, 0x4c, 0xa3, 0x7f, 0x9a, 0xee, 0x6d, 0x69, 0x1a, 0xfd, 0xdd, 0xb4, 0x04, 0xdd, 0x1c,
-	0x93, 0x0a, 0x0b, 0xf6, 0x27, 0x43, 0xb0, 0x95, 0xa1, 0xb3, 0x22, 0x6e, 0xba, 0x9d, 0x82, 0x03,
-	0xf5, 0xc6, 0x30, 0x63, 0x84, 0xa9, 0x7b, 0x71, 0xda, 0xff, 0x3d, 0xb6, 0xbe, 0x39, 0xb6, 0x7b,
-	0xfa, 0xd8, 0x36, 0x00, 0xfa, 0xe0, 0xf6, 0x65, 0xf6, 0x85, 0x4c, 0xca, 0xbe, 0xd1, 0xab, 0xcb,
-	0xb9, 0x6b, 0x5d, 0xcd, 0x5d, 0xeb, 0xdb, 0xdc, 0xb5, 0x2e, 0x16, 0x6e, 0xe3, 0x6a, 0xe1, 0x36,
-	0x3e, 0x2f, 0xdc, 0xc6, 0xfb, 0xf0, 0xc6, 0x0e, 0xe6, 0x13, 0x72, 0x54, 0xa2, 0x84, 0x2f, 0x83,
-	0xe0, 0x2c, 0x7a, 0x16, 0x7c, 0xd2, 0x5f, 0x1f, 0xb5, 0x51, 0xd2, 0x52, 0x23, 0x3d, 0xfd, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x64, 0xe4, 0x6d, 0x84, 0x9a, 0x04, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x63, 0x92, 0xa6, 0xe9, 0xec, 0xa6, 0x49, 0x9d, 0x34, 0x71, 0x16, 0xb0, 0x17, 0x4b,
+	0x54, 0xa9, 0x50, 0xed, 0x75, 0x91, 0x38, 0xf4, 0x16, 0x13, 0x0e, 0x41, 0x29, 0x42, 0x6e, 0xe0,
+	0x10, 0x0e, 0xd6, 0xac, 0x3d, 0xf5, 0x1a, 0x6c, 0x8f, 0x3b, 0x33, 0x4e, 0xd9, 0xff, 0xa2, 0x47,
+	0xfe, 0xa4, 0x1e, 0x38, 0x94, 0x1b, 0xe2, 0x60, 0x50, 0x72, 0xe3, 0xb8, 0x7f, 0x01, 0x9a, 0x1f,
+	0xde, 0xec, 0xaf, 0x00, 0xda, 0xa8, 0xa7, 0xe4, 0xcd, 0x7b, 0xfe, 0x7c, 0xe7, 0x3d, 0xbf, 0xb7,
+	0xcf, 0xe0, 0x11, 0xa6, 0x39, 0xa6, 0x29, 0x75, 0xd9, 0x6b, 0x58, 0xba, 0x17, 0x5e, 0x1f, 0x31,
+	0xe8, 0x09, 0x23, 0x24, 0x28, 0xc2, 0x24, 0x76, 0x4a, 0x82, 0x19, 0xd6, 0x77, 0x55, 0x9c, 0xc3,
+	0x5d, 0x8e, 0x8a, 0xeb, 0xec, 0x26, 0x38, 0xc1, 0x22, 0xc0, 0xe5, 0xff, 0xc9, 0xd8, 0xce, 0x41,
+	0x82, 0x71, 0x92, 0x21, 0x57, 0x58, 0xfd, 0xea, 0xa5, 0x0b, 0x8b, 0x61, 0xe3, 0x8a, 0x04, 0x27,
+	0x94, 0xcf, 0x48, 0x43, 0xb9, 0x4c, 0x69, 0xb9, 0x7d, 0x48, 0xd1, 0xf8, 0x22, 0x11, 0x4e, 0x0b,
+	0xe5, 0xb7, 0x66, 0xa9, 0x2c, 0xcd, 0x11, 0x65, 0x30, 0x2f, 0x1b, 0xc0, 0x6c, 0x40, 0x5c, 0x11,
+	0xc8, 0x52, 0xac, 0x00, 0xf6, 0x6f, 0x6d, 0x00, 0xce, 0x5e, 0xc3, 0x32, 0x10, 0x79, 0xe9, 0xfb,
+	0xe0, 0x6e, 0x89, 0x71, 0x16, 0xa6, 0xb1, 0xa1, 0x75, 0xb5, 0xc3, 0xb5, 0x60, 0x9d, 0x9b, 0x27,
+	0xb1, 0xfe, 0x09, 0x68, 0x43, 0x4a, 0x11, 0xeb, 0x85, 0x31, 0x2a, 0x70, 0x6e, 0x7c, 0xd0, 0xd5,
+	0x0e, 0xef, 0x05, 0x2d, 0x79, 0x76, 0xcc, 0x8f, 0xc6, 0x21, 0x9e, 0x0a, 0x59, 0x9d, 0x08, 0xf1,
+	0x64, 0xc8, 0x11, 0x58, 0x1f, 0xa0, 0x34, 0x19, 0x30, 0x63, 0xad, 0xab, 0x1d, 0xae, 0xfa, 0x8f,
+	0xff, 0xae, 0xad, 0x4d, 0x59, 0xd2, 0x50, 0x3a, 0x46, 0xb5, 0xb5, 0x3b, 0x84, 0x79, 0xf6, 0xcc,
+	0x9e, 0x3a, 0xb6, 0x03, 0xf5, 0xa0, 0xfe, 0x0d, 0x58, 0xe3, 0x39, 0x1a, 0x77, 0xba, 0xda, 0x61,
+	0xeb, 0x69, 0xc7, 0x91, 0xf9, 0x39, 0x4d, 0x7e, 0xce, 0x59, 0x53, 0x00, 0xdf, 0x7c, 0x5b, 0x5b,
+	0x2b, 0xa3, 0xda, 0xd2, 0xa7, 0x78, 0xfc, 0x61, 0xfb, 0xcd, 0x9f, 0x96, 0x16, 0x08, 0x8e, 0xfe,
+	0x03, 0xd0, 0xcb, 0x5e, 0x98, 0x41, 0xca, 0x42, 0x5a, 0x62, 0x16, 0x96, 0x24, 0x8d, 0x90, 0xb1,
+	0xce, 0xef, 0xee, 0x3b, 0x9c, 0xf0, 0x47, 0x6d, 0x3d, 0x4a, 0x52, 0x36, 0xa8, 0xfa, 0x4e, 0x84,
+	0x73, 0xf5, 0x7a, 0xd4, 0x9f, 0x27, 0x34, 0xfe, 0xc9, 0x65, 0xc3, 0x12, 0x51, 0xe7, 0x18, 0x45,
+	0xc1, 0x56, 0xd9, 0x3b, 0x85, 0x94, 0xbd, 0x28, 0x31, 0xfb, 0x96, 0x63, 0x04, 0xdc, 0x9b, 0x83,
+	0xdf, 0x5d, 0x12, 0xee, 0x4d, 0xc3, 0x29, 0x30, 0xcb, 0x5e, 0x08, 0x49, 0xca, 0x06, 0x39, 0x62,
+	0x69, 0x14, 0x8a, 0x06, 0x85, 0x51, 0x54, 0xe5, 0x55, 0x06, 0x19, 0x26, 0xc6, 0xc6, 0x52, 0x42,
+	0x1f, 0x96, 0xbd, 0xa3, 0x31, 0x94, 0xf7, 0xc6, 0xd1, 0x35, 0x52, 0x88, 0x7a, 0xff, 0x2a, 0x7a,
+	0x6f, 0x49, 0x51, 0xef, 0x66, 0xd1, 0x0c, 0x74, 0x12, 0x84, 0x73, 0xc4, 0xc8, 0x22, 0x41, 0xb0,
+	0x94, 0xa0, 0x31, 0x26, 0xce, 0xaa, 0xbd, 0x04, 0x5b, 0xe2, 0x8d, 0x21, 0x42, 0x30, 0x11, 0xfd,
+	0x62, 0xb4, 0xfe, 0xb3, 0xd9, 0x6c, 0xd5, 0x6c, 0x7b, 0xb2, 0xd9, 0x66, 0x00, 0xb2, 0xe1, 0x36,
+	0xf9, 0xe9, 0x57, 0xfc, 0x90, 0x3f, 0xc7, 0x4b, 0x49, 0x5f, 0x55, 0x90, 0xa0, 0x38, 0xcc, 0x70,
+	0x12, 0x12, 0xc4, 0x2a, 0x52, 0x4c, 0x65, 0xd6, 0x5e, 0xae, 0x94, 0x8a, 0x7a, 0x8a, 0x93, 0x40,
+	0x30, 0x27, 0x93, 0x3b, 0x07, 0x0f, 0x9a, 0x76, 0xcf, 0xd2, 0x57, 0x55, 0x1a, 0xa7, 0x6c, 0x68,
+	0x6c, 0xde, 0xa6, 0xdb, 0x4f, 0x1b, 0x8c, 0x60, 0x7b, 0xb3, 0xec, 0xfb, 0xb7, 0x69, 0xf6, 0x6b,
+	0xf6, 0x00, 0x18, 0xfc, 0xde, 0x8d, 0x3d, 0x55, 0xa6, 0xad, 0xa5, 0x24, 0xf6, 0xca, 0xde, 0x18,
+	0x3f, 0x59, 0x21, 0xae, 0xe4, 0xdd, 0xa0, 0xb4, 0xbd, 0xa4, 0x92, 0xb7, 0x50, 0xe9, 0x47, 0x70,
+	0xa0, 0x7e, 0x53, 0x2f, 0x70, 0x56, 0xe5, 0x68, 0x4a, 0xea, 0xc1, 0x52, 0x52, 0xfb, 0x12, 0xf8,
+	0xbd, 0xe0, 0x2d, 0xd2, 0xf2, 0x16, 0x69, 0xe9, 0xb7, 0xd0, 0xf2, 0xe6, 0xb4, 0xec, 0x5f, 0xef,
+	0x80, 0x36, 0x1f, 0xaa, 0xe7, 0x88, 0xc1, 0x18, 0x32, 0xa8, 0x7f, 0x36, 0xb3, 0x55, 0x7c, 0x7d,
+	0x54, 0x5b, 0xf7, 0xe5, 0xa4, 0x28, 0x87, 0x3d, 0xde, 0x34, 0xcf, 0xc1, 0xce, 0xc4, 0xf4, 0xa4,
+	0x45, 0x48, 0x60, 0x91, 0x20, 0xb1, 0x70, 0x36, 0x7c, 0x73, 0x54, 0x5b, 0x9d, 0xb9, 0x11, 0x6b,
+	0x82, 0xec, 0x60, 0x7b, 0x3c, 0x62, 0x27, 0x45, 0xc0, 0x8f, 0x16, 0x4d, 0xf3, 0xea, 0xfb, 0x98,
+	0xe6, 0x67, 0xa0, 0xad, 0x36, 0x4c, 0x84, 0xab, 0x42, 0x2e, 0xb8, 0x35, 0x7f, 0x7f, 0x54, 0x5b,
+	0x3b, 0x53, 0xfb, 0x47, 0x78, 0xed, 0xa0, 0x25, 0xcd, 0x2f, 0xb9, 0xa5, 0x63, 0xa0, 0xe3, 0x2c,
+	0x46, 0x94, 0x85, 0x13, 0x4b, 0xea, 0x7f, 0x6c, 0xb8, 0x4f, 0xd5, 0x35, 0x0f, 0xa4, 0xc2, 0x3c,
+	0x43, 0xde, 0x74, 0x5b, 0x3a, 0xe4, 0x8a, 0x17, 0x97, 0x1d, 0x82, 0x3d, 0xee, 0x0e, 0x69, 0x5a,
+	0x44, 0x48, 0x4e, 0x6c, 0x55, 0xc6, 0x90, 0xc9, 0xc5, 0xd7, 0x7a, 0x7a, 0x30, 0x27, 0x7a, 0xac,
+	0x3e, 0x1b, 0xfc, 0xc7, 0x4a, 0xf3, 0x63, 0xa9, 0xb9, 0x18, 0x63, 0xff, 0xc2, 0x75, 0x77, 0xb8,
+	0xf3, 0x05, 0xf7, 0xf1, 0x59, 0xfe, 0x4e, 0x78, 0xf4, 0x73, 0xb0, 0x4f, 0x19, 0x24, 0x4c, 0xdc,
+	0x2f, 0x4c, 0x0b, 0x86, 0x48, 0x89, 0x33, 0xc8, 0x50, 0x2c, 0xf6, 0xe2, 0x86, 0x6f, 0x8f, 0x6a,
+	0xcb, 0x94, 0xf0, 0x1b, 0x02, 0xed, 0xe0, 0xa1, 0xf0, 0xf0, 0x54, 0x4e, 0x26, 0xce, 0xf5, 0x33,
+	0xf0, 0x10, 0x15, 0xf1, 0x02, 0xf2, 0x86, 0x20, 0x77, 0x47, 0xb5, 0xf5, 0x91, 0x24, 0x2f, 0x0c,
+	0xb3, 0x83, 0x1d, 0x54, 0xc4, 0xb3, 0x54, 0xff, 0xeb, 0xb7, 0x97, 0xa6, 0xf6, 0xee, 0xd2, 0xd4,
+	0xfe, 0xba, 0x34, 0xb5, 0x37, 0x57, 0xe6, 0xca, 0xbb, 0x2b, 0x73, 0xe5, 0xf7, 0x2b, 0x73, 0xe5,
+	0xbc, 0x37, 0x31, 0x29, 0xea, 0x53, 0xf0, 0x49, 0x06, 0xfb, 0xb4, 0x31, 0xdc, 0x0b, 0xef, 0x0b,
+	0xf7, 0x67, 0xf9, 0x15, 0x29, 0xe6, 0xa6, 0xbf, 0x2e, 0x0a, 0xfa, 0xf9, 0x3f, 0x03, 0x00, 0xfb,
+	0x40, 0xdc, 0x41, 0x62, 0x0a, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +360,82 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Asset1VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset1VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.Asset0VolumeAccumulator.Size()
+		i -= size
+		if _, err := m.Asset0VolumeAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.P1LiquidityAccumulator.Size()
+		i -= size
+		if _, err := m.P1LiquidityAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.P0LiquidityAccumulator.Size()
+		i -= size
+		if _, err := m.P0LiquidityAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.P1LastLiquidity.Size()
+		i -= size
+		if _, err := m.P1LastLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.P0LastLiquidity.Size()
+		i -= size
+		if _, err := m.P0LastLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.SquaredLogReturnAccumulator.Size()
+		i -= size
+		if _, err := m.SquaredLogReturnAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -296,6 +529,93 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TwapMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTimeInterpolated {
+		i--
+		if m.EndTimeInterpolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartTimeInterpolated {
+		i--
+		if m.StartTimeInterpolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeSinceLastUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeSinceLastUpdate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.OldestRecordTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestRecordTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.RecordCount != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.LastErrorInRange {
+		i--
+		if m.LastErrorInRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTwapRecord(v)
 	base := offset
@@ -341,6 +661,50 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.SquaredLogReturnAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P0LastLiquidity.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1LastLiquidity.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P0LiquidityAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1LiquidityAccumulator.Size()
+	n += 2 + l + sovTwapRecord(uint64(l))
+	l = m.Asset0VolumeAccumulator.Size()
+	n += 2 + l + sovTwapRecord(uint64(l))
+	l = m.Asset1VolumeAccumulator.Size()
+	n += 2 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *TwapMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	if m.LastErrorInRange {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastErrorTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.RecordCount != 0 {
+		n += 1 + sovTwapRecord(uint64(m.RecordCount))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.OldestRecordTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeSinceLastUpdate)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.StartTimeInterpolated {
+		n += 2
+	}
+	if m.EndTimeInterpolated {
+		n += 2
+	}
 	return n
 }
 
@@ -717,6 +1081,491 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquaredLogReturnAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquaredLogReturnAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0LastLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0LastLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1LastLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1LastLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0LiquidityAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0LiquidityAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1LiquidityAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1LiquidityAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset0VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1VolumeAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1VolumeAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorInRange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastErrorInRange = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestRecordTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.OldestRecordTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSinceLastUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeSinceLastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeInterpolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartTimeInterpolated = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimeInterpolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndTimeInterpolated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
@@ -10,6 +10,14 @@ import (
 
 var MaxSpotPrice = sdk.NewDec(2).Power(128).Sub(sdk.OneDec())
 
+// MaxLiquidity bounds the liquidity stored in TWAP records, so that the liquidity accumulators
+// can not overflow when valuing reserves at an erroneous spot price.
+var MaxLiquidity = MaxSpotPrice
+
+// MaxBatchTwapItems bounds the number of items of a batch twap query,
+// so that a single query can not take an unbounded time to serve.
+const MaxBatchTwapItems = 500
+
 // GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
 // (X, Y), X < Y.
 // The pair (X,Y) should only appear once in the list. Denoms are lexicographically sorted.
@@ -14,7 +14,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
+		GetCmdDenomConversionRate(),
 		GetCmdBaseDenom(),
+		GetCmdBaseFee(),
+		GetCmdMempoolFilters(),
+		GetCmdRecommendedGasPrices(),
+		GetCmdFeeSponsors(),
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
 	)
 
 	return cmd
@@ -42,6 +49,17 @@ func GetCmdDenomPoolID() *cobra.Command {
 	)
 }
 
+func GetCmdDenomConversionRate() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryDenomConversionRateRequest](
+		"conversion-rate",
+		"Query the rate at which fees paid in a whitelisted fee token are converted to the base denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} conversion-rate [denom]
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdBaseDenom() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.QueryBaseDenomRequest](
 		"base-denom",
@@ -52,3 +70,47 @@ func GetCmdBaseDenom() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+func GetCmdBaseFee() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryBaseFeeRequest](
+		"base-fee",
+		"Query the consensus base fee, the minimum gas price in base denom",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} base-fee
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdMempoolFilters() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryMempoolFiltersRequest](
+		"mempool-filters",
+		"Query the mempool filters, with the number of delivered txs each matched",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} mempool-filters
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdRecommendedGasPrices() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryRecommendedGasPricesRequest](
+		"recommended-gas-prices",
+		"Query the recommended low, medium and high gas prices in the base denom and every fee token",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} recommended-gas-prices
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdFeeSponsors() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryFeeSponsorsRequest](
+		"fee-sponsors",
+		"Query the contracts registered as fee sponsors",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fee-sponsors
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
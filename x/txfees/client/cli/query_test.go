@@ -70,6 +70,42 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryDenomSpotPriceRequest{Denom: "uosmo"},
 			&types.QueryDenomSpotPriceResponse{},
 		},
+		{
+			"Query conversion rate by denom",
+			"/osmosis.txfees.v1beta1.Query/DenomConversionRate",
+			&types.QueryDenomConversionRateRequest{Denom: "uosmo"},
+			&types.QueryDenomConversionRateResponse{},
+		},
+		{
+			"Query base fee",
+			"/osmosis.txfees.v1beta1.Query/BaseFee",
+			&types.QueryBaseFeeRequest{},
+			&types.QueryBaseFeeResponse{},
+		},
+		{
+			"Query mempool filters",
+			"/osmosis.txfees.v1beta1.Query/MempoolFilters",
+			&types.QueryMempoolFiltersRequest{},
+			&types.QueryMempoolFiltersResponse{},
+		},
+		{
+			"Query recommended gas prices",
+			"/osmosis.txfees.v1beta1.Query/RecommendedGasPrices",
+			&types.QueryRecommendedGasPricesRequest{},
+			&types.QueryRecommendedGasPricesResponse{},
+		},
+		{
+			"Query fee sponsors",
+			"/osmosis.txfees.v1beta1.Query/FeeSponsors",
+			&types.QueryFeeSponsorsRequest{},
+			&types.QueryFeeSponsorsResponse{},
+		},
+		{
+			"Query params",
+			"/osmosis.txfees.v1beta1.Query/Params",
+			&types.QueryParamsRequest{},
+			&types.QueryParamsResponse{},
+		},
 		{
 			"Query fee tokens",
 			"/osmosis.txfees.v1beta1.Query/FeeTokens",
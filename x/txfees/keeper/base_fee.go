@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// GetBaseFee returns the current consensus base fee, in base denom per gas.
+// Transactions must pay at least this gas price to be executed.
+// If it has not been set yet, the consensus min fee is returned.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.BaseFeeKey) {
+		return types.ConsensusMinFee
+	}
+	return osmoutils.MustGetDec(store, types.BaseFeeKey)
+}
+
+// SetBaseFee sets the current consensus base fee, in base denom per gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.BaseFeeKey, baseFee)
+}
+
+// EndBlock updates the base fee according to the gas used by the block, records the gas used by the block,
+// and liquidates the next chunk of the fee tokens whose liquidation is pending.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	blockGasUsed := uint64(0)
+	if ctx.BlockGasMeter() != nil {
+		blockGasUsed = ctx.BlockGasMeter().GasConsumed()
+	}
+	k.UpdateBaseFee(ctx, blockGasUsed)
+	k.recordBlockGas(ctx, blockGasUsed)
+	k.liquidatePendingFeeTokens(ctx)
+}
+
+// UpdateBaseFee moves the base fee towards the gas price at which blocks use the target block gas,
+// like EIP-1559 does:
+// newBaseFee = baseFee * (1 + maxBaseFeeChangeRate * (blockGasUsed - targetBlockGas) / targetBlockGas)
+// The relative change is bounded by the max base fee change rate, as blocks can use more than twice the target gas,
+// and the new base fee is bounded by the min and max base fee. A base fee below types.BaseFeeGrowthFloor increases
+// from the floor, so that it recovers after congestion even when it decayed to a zero min base fee.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) {
+	k.SetBaseFee(ctx, calcNextBaseFee(k.GetParams(ctx), k.GetBaseFee(ctx), blockGasUsed))
+}
+
+// calcNextBaseFee returns the base fee following a block using blockGasUsed gas at the given base fee.
+func calcNextBaseFee(params types.Params, baseFee sdk.Dec, blockGasUsed uint64) sdk.Dec {
+	targetBlockGas := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	gasDelta := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(targetBlockGas)
+	changeRate := params.MaxBaseFeeChangeRate.Mul(gasDelta).Quo(targetBlockGas)
+	changeRate = sdk.MinDec(changeRate, params.MaxBaseFeeChangeRate)
+	if changeRate.IsPositive() && baseFee.LT(types.BaseFeeGrowthFloor) {
+		baseFee = types.BaseFeeGrowthFloor
+	}
+
+	newBaseFee := baseFee.Add(baseFee.Mul(changeRate))
+	return params.ClampBaseFee(newBaseFee)
+}
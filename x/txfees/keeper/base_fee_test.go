@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+func baseFeeTestParams(targetBlockGas uint64) types.Params {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.MustNewDecFromStr("0.0025")
+	params.MaxBaseFee = sdk.NewDec(5)
+	params.TargetBlockGas = targetBlockGas
+	params.MaxBaseFeeChangeRate = sdk.NewDecWithPrec(1, 1)
+	return params
+}
+
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	targetBlockGas := uint64(1_000_000)
+	params := baseFeeTestParams(targetBlockGas)
+
+	tests := map[string]struct {
+		baseFee      sdk.Dec
+		blockGasUsed uint64
+		expBaseFee   sdk.Dec
+	}{
+		"target gas used, base fee unchanged": {
+			baseFee:      sdk.NewDec(1),
+			blockGasUsed: targetBlockGas,
+			expBaseFee:   sdk.NewDec(1),
+		},
+		"twice the target gas used, base fee increases by the max change rate": {
+			baseFee:      sdk.NewDec(1),
+			blockGasUsed: 2 * targetBlockGas,
+			expBaseFee:   sdk.MustNewDecFromStr("1.1"),
+		},
+		"more than twice the target gas used, base fee increase is bounded": {
+			baseFee:      sdk.NewDec(1),
+			blockGasUsed: 10 * targetBlockGas,
+			expBaseFee:   sdk.MustNewDecFromStr("1.1"),
+		},
+		"half the target gas used": {
+			baseFee:      sdk.NewDec(1),
+			blockGasUsed: targetBlockGas / 2,
+			expBaseFee:   sdk.MustNewDecFromStr("0.95"),
+		},
+		"no gas used, base fee decreases by the max change rate": {
+			baseFee:      sdk.NewDec(1),
+			blockGasUsed: 0,
+			expBaseFee:   sdk.MustNewDecFromStr("0.9"),
+		},
+		"bounded by the max base fee": {
+			baseFee:      sdk.MustNewDecFromStr("4.9"),
+			blockGasUsed: 2 * targetBlockGas,
+			expBaseFee:   sdk.NewDec(5),
+		},
+		"bounded by the min base fee": {
+			baseFee:      sdk.MustNewDecFromStr("0.0026"),
+			blockGasUsed: 0,
+			expBaseFee:   sdk.MustNewDecFromStr("0.0025"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+			s.App.TxFeesKeeper.SetBaseFee(s.Ctx, tc.baseFee)
+
+			s.App.TxFeesKeeper.UpdateBaseFee(s.Ctx, tc.blockGasUsed)
+
+			s.Require().Equal(tc.expBaseFee, s.App.TxFeesKeeper.GetBaseFee(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockUpdatesBaseFee() {
+	s.SetupTest(false)
+	s.App.TxFeesKeeper.SetParams(s.Ctx, baseFeeTestParams(1_000_000))
+	s.App.TxFeesKeeper.SetBaseFee(s.Ctx, sdk.NewDec(1))
+
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(2_000_000, "block gas meter")
+	s.App.TxFeesKeeper.EndBlock(s.Ctx.WithBlockGasMeter(blockGasMeter))
+
+	s.Require().Equal(sdk.MustNewDecFromStr("1.1"), s.App.TxFeesKeeper.GetBaseFee(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestMigrateBaseFee() {
+	s.SetupTest(false)
+	s.App.TxFeesKeeper.SetBaseFee(s.Ctx, sdk.NewDec(1))
+
+	err := s.App.TxFeesKeeper.MigrateBaseFee(s.Ctx)
+	s.Require().NoError(err)
+
+	s.Require().Equal(types.DefaultParams(), s.App.TxFeesKeeper.GetParams(s.Ctx))
+	s.Require().Equal(types.DefaultParams().MinBaseFee, s.App.TxFeesKeeper.GetBaseFee(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestZeroMinBaseFee() {
+	s.SetupTest(false)
+	params := baseFeeTestParams(1_000_000)
+	params.MinBaseFee = sdk.ZeroDec()
+	s.Require().NoError(params.Validate())
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+	s.App.TxFeesKeeper.SetBaseFee(s.Ctx, sdk.ZeroDec())
+
+	// a zero base fee stays at zero while blocks use less than the target gas
+	s.App.TxFeesKeeper.UpdateBaseFee(s.Ctx, 0)
+	s.Require().Equal(sdk.ZeroDec(), s.App.TxFeesKeeper.GetBaseFee(s.Ctx))
+
+	// and recovers from the growth floor after congestion
+	s.App.TxFeesKeeper.UpdateBaseFee(s.Ctx, 2_000_000)
+	s.Require().Equal(types.BaseFeeGrowthFloor.Mul(sdk.MustNewDecFromStr("1.1")), s.App.TxFeesKeeper.GetBaseFee(s.Ctx))
+
+	for i := 0; i < 100; i++ {
+		s.App.TxFeesKeeper.UpdateBaseFee(s.Ctx, 2_000_000)
+	}
+	s.Require().True(s.App.TxFeesKeeper.GetBaseFee(s.Ctx).GT(types.ConsensusMinFee))
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// RegisterFeeSponsor registers a contract as a fee sponsor, replacing its previous registration if any.
+func (k Keeper) RegisterFeeSponsor(ctx sdk.Context, feeSponsor types.FeeSponsor) error {
+	if err := feeSponsor.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidFeeSponsor, err.Error())
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(feeSponsor.ContractAddress)
+	if err != nil {
+		return err
+	}
+	if k.wasmKeeper == nil || !k.wasmKeeper.HasContractInfo(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidFeeSponsor, "%s is not a contract", feeSponsor.ContractAddress)
+	}
+	return k.setFeeSponsor(ctx, feeSponsor)
+}
+
+// UnregisterFeeSponsor unregisters a fee sponsor.
+func (k Keeper) UnregisterFeeSponsor(ctx sdk.Context, contractAddress string) error {
+	store := k.getFeeSponsorsStore(ctx)
+	if !store.Has([]byte(contractAddress)) {
+		return errorsmod.Wrapf(types.ErrFeeSponsorNotFound, "%s", contractAddress)
+	}
+	store.Delete([]byte(contractAddress))
+	return nil
+}
+
+// GetFeeSponsor returns the fee sponsor registered for the contract address, and whether there is one.
+func (k Keeper) GetFeeSponsor(ctx sdk.Context, contractAddress string) (types.FeeSponsor, bool) {
+	bz := k.getFeeSponsorsStore(ctx).Get([]byte(contractAddress))
+	if bz == nil {
+		return types.FeeSponsor{}, false
+	}
+
+	feeSponsor := types.FeeSponsor{}
+	if err := proto.Unmarshal(bz, &feeSponsor); err != nil {
+		panic(err)
+	}
+	return feeSponsor, true
+}
+
+// GetFeeSponsors returns all the registered fee sponsors.
+func (k Keeper) GetFeeSponsors(ctx sdk.Context) []types.FeeSponsor {
+	iterator := k.getFeeSponsorsStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	feeSponsors := []types.FeeSponsor{}
+	for ; iterator.Valid(); iterator.Next() {
+		feeSponsor := types.FeeSponsor{}
+		if err := proto.Unmarshal(iterator.Value(), &feeSponsor); err != nil {
+			panic(err)
+		}
+		feeSponsors = append(feeSponsors, feeSponsor)
+	}
+	return feeSponsors
+}
+
+func (k Keeper) setFeeSponsor(ctx sdk.Context, feeSponsor types.FeeSponsor) error {
+	bz, err := proto.Marshal(&feeSponsor)
+	if err != nil {
+		return err
+	}
+	k.getFeeSponsorsStore(ctx).Set([]byte(feeSponsor.ContractAddress), bz)
+	return nil
+}
+
+func (k Keeper) getFeeSponsorsStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSponsorsPrefix)
+}
+
+// ApproveFeeSponsorship returns nil if the fee sponsor approves paying the fee of the tx with the given msgs.
+// Each msg must be sponsored by the fee sponsor, and the sponsor contract is then called with
+// an ApproveFeeSponsorshipSudoMsg, approving the sponsorship by returning no error.
+// The contract can use at most FeeSponsorApprovalGasLimit gas, which is charged to the tx,
+// and its state changes are only kept if it approves.
+func (k Keeper) ApproveFeeSponsorship(ctx sdk.Context, feeSponsor types.FeeSponsor, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	if k.contractKeeper == nil {
+		return errorsmod.Wrap(types.ErrFeeSponsorshipNotApproved, "fee sponsorship is not enabled")
+	}
+
+	msgInfos := make([]types.SponsoredMsgInfo, 0, len(msgs))
+	for _, msg := range msgs {
+		msgInfo := types.NewSponsoredMsgInfo(msg)
+		if !feeSponsor.Sponsors(msgInfo) {
+			return errorsmod.Wrapf(types.ErrFeeSponsorshipNotApproved, "%s does not sponsor msg %s", feeSponsor.ContractAddress, msgInfo.TypeURL)
+		}
+		msgInfos = append(msgInfos, msgInfo)
+	}
+
+	wasmFee := make([]wasmvmtypes.Coin, 0, len(fee))
+	for _, coin := range fee {
+		wasmFee = append(wasmFee, wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()})
+	}
+	msgBz, err := json.Marshal(types.ApproveFeeSponsorshipSudoMsg{
+		ApproveFeeSponsorship: types.ApproveFeeSponsorshipMsg{
+			FeePayer: feePayer.String(),
+			Fee:      wasmFee,
+			Msgs:     msgInfos,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(feeSponsor.ContractAddress)
+	if err != nil {
+		return err
+	}
+	return k.callFeeSponsor(ctx, contractAddr, msgBz)
+}
+
+// callFeeSponsor calls the fee sponsor contract with the sudo msg, within the fee sponsor approval gas limit,
+// keeping its state changes if it returns no error.
+func (k Keeper) callFeeSponsor(ctx sdk.Context, contractAddr sdk.AccAddress, msgBz []byte) (err error) {
+	gasMeter := sdk.NewGasMeter(types.FeeSponsorApprovalGasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(types.ErrFeeSponsorshipNotApproved, "%s ran out of gas approving", contractAddr)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "fee sponsorship approval")
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.contractKeeper.Sudo(cacheCtx.WithGasMeter(gasMeter), contractAddr, msgBz); err != nil {
+		return errorsmod.Wrapf(types.ErrFeeSponsorshipNotApproved, "%s: %s", contractAddr, err)
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
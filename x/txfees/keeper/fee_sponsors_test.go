@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// mockFeeSponsorContracts stands in for the wasm keepers, with contracts approving or rejecting
+// all the fee sponsorships they are asked for.
+type mockFeeSponsorContracts struct {
+	contracts map[string]bool
+	approve   bool
+	gasUsed   uint64
+	sudoMsgs  []string
+}
+
+func (m *mockFeeSponsorContracts) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "mock sudo")
+	m.sudoMsgs = append(m.sudoMsgs, string(msg))
+	if !m.approve {
+		return nil, errors.New("not approved")
+	}
+	return nil, nil
+}
+
+func (m *mockFeeSponsorContracts) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+func (s *KeeperTestSuite) setupFeeSponsorKeeper(contracts ...sdk.AccAddress) (keeper.Keeper, *mockFeeSponsorContracts) {
+	mockContracts := &mockFeeSponsorContracts{contracts: map[string]bool{}, approve: true}
+	for _, contract := range contracts {
+		mockContracts.contracts[contract.String()] = true
+	}
+	k := *s.App.TxFeesKeeper
+	k.SetContractKeeper(mockContracts)
+	k.SetWasmKeeper(mockContracts)
+	return k, mockContracts
+}
+
+func (s *KeeperTestSuite) TestRegisterFeeSponsor() {
+	s.SetupTest(false)
+	sponsor, targetContract, notContract := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	k, _ := s.setupFeeSponsorKeeper(sponsor)
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	msg := types.NewMsgRegisterFeeSponsor(sponsor.String(), []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, []string{targetContract.String()})
+	s.Require().NoError(msg.ValidateBasic())
+	s.Require().Error(types.NewMsgRegisterFeeSponsor(sponsor.String(), nil, nil).ValidateBasic())
+	s.Require().Error(types.NewMsgRegisterFeeSponsor(sponsor.String(), nil, []string{"invalid"}).ValidateBasic())
+
+	// only contracts can register as fee sponsors.
+	_, err := msgServer.RegisterFeeSponsor(goCtx, types.NewMsgRegisterFeeSponsor(notContract.String(), msg.MsgTypeUrls, nil))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeSponsor)
+
+	_, err = msgServer.RegisterFeeSponsor(goCtx, msg)
+	s.Require().NoError(err)
+
+	res, err := keeper.NewQuerier(k).FeeSponsors(goCtx, &types.QueryFeeSponsorsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.FeeSponsor{msg.FeeSponsor()}, res.FeeSponsors)
+
+	_, err = msgServer.UnregisterFeeSponsor(goCtx, types.NewMsgUnregisterFeeSponsor(sponsor.String()))
+	s.Require().NoError(err)
+	_, found := k.GetFeeSponsor(s.Ctx, sponsor.String())
+	s.Require().False(found)
+
+	_, err = msgServer.UnregisterFeeSponsor(goCtx, types.NewMsgUnregisterFeeSponsor(sponsor.String()))
+	s.Require().ErrorIs(err, types.ErrFeeSponsorNotFound)
+}
+
+func (s *KeeperTestSuite) TestFeeSponsorDecorator() {
+	baseDenom := sdk.DefaultBondDenom
+	fee := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))
+
+	tests := map[string]struct {
+		sponsoredMsg bool
+		approve      bool
+		gasUsed      uint64
+		expSudoCall  bool
+		expectPass   bool
+	}{
+		"sponsored msg, approved": {
+			sponsoredMsg: true,
+			approve:      true,
+			expSudoCall:  true,
+			expectPass:   true,
+		},
+		"msg not sponsored": {
+			sponsoredMsg: false,
+			approve:      true,
+			expSudoCall:  false,
+			expectPass:   false,
+		},
+		"sponsored msg, not approved": {
+			sponsoredMsg: true,
+			approve:      false,
+			expSudoCall:  true,
+			expectPass:   false,
+		},
+		"sponsored msg, approval runs out of gas": {
+			sponsoredMsg: true,
+			approve:      true,
+			gasUsed:      types.FeeSponsorApprovalGasLimit + 1,
+			expSudoCall:  false,
+			expectPass:   false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest(false)
+			sponsor, feePayer, targetContract := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+			k, mockContracts := s.setupFeeSponsorKeeper(sponsor)
+			mockContracts.approve = tc.approve
+			mockContracts.gasUsed = tc.gasUsed
+
+			s.Require().NoError(k.RegisterFeeSponsor(s.Ctx, types.FeeSponsor{
+				ContractAddress: sponsor.String(),
+				TargetContracts: []string{targetContract.String()},
+			}))
+			sponsorBalance := s.App.BankKeeper.GetBalance(s.Ctx, sponsor, baseDenom)
+			feePayerBalance := s.App.BankKeeper.GetBalance(s.Ctx, feePayer, baseDenom)
+			s.Require().NoError(simapp.FundAccount(s.App.BankKeeper, s.Ctx, sponsor, fee))
+
+			var msg sdk.Msg = testdata.NewTestMsg(feePayer)
+			if tc.sponsoredMsg {
+				msg = &wasmtypes.MsgExecuteContract{Sender: feePayer.String(), Contract: targetContract.String(), Msg: []byte("{}")}
+			}
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(msg))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeeGranter(sponsor)
+			txBuilder.SetGasLimit(1_000_000)
+
+			// the fee sponsor is only called by the FeeSponsorDecorator, placed after the signature verification.
+			dfd := keeper.NewDeductFeeDecorator(k, *s.App.AccountKeeper, *s.App.BankKeeper, nil)
+			cacheCtx, _ := s.Ctx.CacheContext()
+			_, err := sdk.ChainAnteDecorators(dfd)(cacheCtx, txBuilder.GetTx(), false)
+			s.Require().NoError(err)
+			s.Require().Empty(mockContracts.sudoMsgs)
+
+			fsd := keeper.NewFeeSponsorDecorator(k)
+			_, err = sdk.ChainAnteDecorators(dfd, fsd)(s.Ctx, txBuilder.GetTx(), false)
+			if tc.expSudoCall {
+				s.Require().Len(mockContracts.sudoMsgs, 1)
+				s.Require().Contains(mockContracts.sudoMsgs[0], `"fee_payer":"`+feePayer.String()+`"`)
+				s.Require().Contains(mockContracts.sudoMsgs[0], `"contract":"`+targetContract.String()+`"`)
+			} else {
+				s.Require().Empty(mockContracts.sudoMsgs)
+			}
+			if !tc.expectPass {
+				s.Require().ErrorIs(err, types.ErrFeeSponsorshipNotApproved)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the fee is paid by the sponsor, and not by the fee payer.
+			s.Require().Equal(sponsorBalance, s.App.BankKeeper.GetBalance(s.Ctx, sponsor, baseDenom))
+			s.Require().Equal(feePayerBalance, s.App.BankKeeper.GetBalance(s.Ctx, feePayer, baseDenom))
+		})
+	}
+}
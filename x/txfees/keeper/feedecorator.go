@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper/txfee_filters"
 	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -72,9 +71,16 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
+	matchedFilters := mfd.TxFeesKeeper.GetMatchingMempoolFilters(ctx, tx)
+	// Count the txs each mempool filter matches in block execution.
+	// Counting in simulations too makes their gas estimates account for it.
+	if (!ctx.IsCheckTx() && !ctx.IsReCheckTx()) || simulate {
+		mfd.TxFeesKeeper.IncrementMempoolFilterCounters(ctx, matchedFilters)
+	}
+
 	// Determine if these fees are sufficient for the tx to pass.
 	// Once ABCI++ Process Proposal lands, we can have block validity conditions enforce this.
-	minBaseGasPrice := mfd.getMinBaseGasPrice(ctx, baseDenom, simulate, feeTx)
+	minBaseGasPrice := mfd.getMinBaseGasPrice(ctx, baseDenom, simulate, feeTx, matchedFilters)
 
 	// If minBaseGasPrice is zero, then we don't need to check the fee. Continue
 	if minBaseGasPrice.IsZero() {
@@ -95,13 +101,16 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, simulate bool, feeTx sdk.FeeTx) sdk.Dec {
-	// In block execution (DeliverTx), its set to the governance decided upon consensus min fee.
-	minBaseGasPrice := types.ConsensusMinFee
+func (mfd MempoolFeeDecorator) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, simulate bool, feeTx sdk.FeeTx, matchedFilters []types.MempoolFilter) sdk.Dec {
+	// In block execution (DeliverTx), its set to the consensus base fee, which moves with block gas usage
+	// within governance decided upon bounds.
+	minBaseGasPrice := mfd.TxFeesKeeper.GetBaseFee(ctx)
 	// If we are in CheckTx, a separate function is ran locally to ensure sufficient fees for entering our mempool.
 	// So we ensure that the provided fees meet a minimum threshold for the validator
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
-		minBaseGasPrice = sdk.MaxDec(minBaseGasPrice, mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx))
+		minBaseGasPrice = sdk.MaxDec(minBaseGasPrice, mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx, matchedFilters))
+		// The mempool filters the tx matches then raise it by their governance set multiplier.
+		minBaseGasPrice = minBaseGasPrice.Mul(getMempoolFiltersMultiplier(matchedFilters))
 	}
 	// If we are in genesis or are simulating a tx, then we actually override all of the above, to set it to 0.
 	if ctx.IsGenesis() || simulate {
@@ -135,13 +144,13 @@ func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasReq
 	return nil
 }
 
-func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) sdk.Dec {
+func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx, matchedFilters []types.MempoolFilter) sdk.Dec {
 	cfgMinGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
 	// the check below prevents tx gas from getting over HighGasTxThreshold which is default to 1_000_000
 	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
 		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
 	}
-	if txfee_filters.IsArbTxLoose(tx) {
+	if matchesMempoolFilter(matchedFilters, types.ArbitrageMempoolFilterName) {
 		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
 	}
 	return cfgMinGasPrice
@@ -149,6 +158,8 @@ func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDeno
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
 // If the first signer does not have the funds to pay for the fees, we return an InsufficientFunds error.
+// If the tx's fee granter is a registered fee sponsor contract, fees are deducted from the contract,
+// which must then approve the tx in the FeeSponsorDecorator.
 // We call next AnteHandler if fees successfully deducted.
 //
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
@@ -193,10 +204,13 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	deductFeesFrom := feePayer
 
 	// If a fee granter was set, deduct fee from the fee granter's account.
+	// A fee granter registered as a fee sponsor approves the tx in the FeeSponsorDecorator, once its
+	// signatures are verified, otherwise the feegrant module must allow it.
 	if feeGranter != nil {
-		if dfd.feegrantKeeper == nil {
+		_, isFeeSponsor := dfd.txFeesKeeper.GetFeeSponsor(ctx, feeGranter.String())
+		if !isFeeSponsor && dfd.feegrantKeeper == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fee grants is not enabled")
-		} else if !feeGranter.Equals(feePayer) {
+		} else if !isFeeSponsor && !feeGranter.Equals(feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
 			if err != nil {
 				return ctx, errorsmod.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
@@ -227,6 +241,52 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
+// FeeSponsorDecorator has the fee sponsor set as the fee granter of the tx, if any, approve paying its fees.
+// It must be placed after the signature verification decorators, so that the sponsor contract is only
+// called for txs signed by their fee payer. The fees are deducted from the sponsor by the DeductFeeDecorator
+// beforehand, which is reverted along with the tx if the sponsor does not approve it.
+//
+// CONTRACT: Tx must implement FeeTx interface to use FeeSponsorDecorator
+type FeeSponsorDecorator struct {
+	txFeesKeeper Keeper
+}
+
+func NewFeeSponsorDecorator(tk Keeper) FeeSponsorDecorator {
+	return FeeSponsorDecorator{
+		txFeesKeeper: tk,
+	}
+}
+
+func (fsd FeeSponsorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeGranter := feeTx.FeeGranter()
+	if feeGranter == nil {
+		return next(ctx, tx, simulate)
+	}
+	feeSponsor, isFeeSponsor := fsd.txFeesKeeper.GetFeeSponsor(ctx, feeGranter.String())
+	if !isFeeSponsor {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := feeTx.FeePayer()
+	err = fsd.txFeesKeeper.ApproveFeeSponsorship(ctx, feeSponsor, feePayer, feeTx.GetFee(), tx.GetMsgs())
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "%s not sponsored by %s", feePayer, feeGranter)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.TypeEvtFeeSponsorship,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeySponsor, feeGranter.String()),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+	))
+
+	return next(ctx, tx, simulate)
+}
+
 // DeductFees deducts fees from the given account and transfers them to the set module account.
 func DeductFees(txFeesKeeper types.TxFeesKeeper, bankKeeper types.BankKeeper, ctx sdk.Context, acc authtypes.AccountI, fees sdk.Coins) error {
 	// Checks the validity of the fee tokens (sorted, have positive amount, valid and unique denomination)
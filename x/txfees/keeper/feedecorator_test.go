@@ -35,7 +35,8 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 		minGasPrices sdk.DecCoins // if blank, set to 0
 		gasRequested uint64       // if blank, set to base gas
 		isCheckTx    bool
-		isSimulate   bool // if blank, is false
+		isSimulate   bool    // if blank, is false
+		baseFee      sdk.Dec // if blank, left at the default min base fee
 		expectPass   bool
 	}
 
@@ -96,6 +97,20 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 				isCheckTx:    isCheckTx == 1,
 				expectPass:   isCheckTx != 1,
 			},
+			{
+				name:       fmt.Sprintf("LT raised base fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, consensusMinFeeAmt)),
+				isCheckTx:  isCheckTx == 1,
+				baseFee:    sdk.MustNewDecFromStr("0.01"),
+				expectPass: false,
+			},
+			{
+				name:       fmt.Sprintf("raised base fee - %s", txType[isCheckTx]),
+				txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+				isCheckTx:  isCheckTx == 1,
+				baseFee:    sdk.MustNewDecFromStr("0.01"),
+				expectPass: true,
+			},
 			{
 				name:       "invalid fee denom",
 				txFee:      sdk.NewCoins(sdk.NewInt64Coin("moooooo", 1000)),
@@ -167,6 +182,9 @@ func (s *KeeperTestSuite) TestFeeDecorator() {
 			if tc.gasRequested == 0 {
 				tc.gasRequested = baseGas
 			}
+			if !tc.baseFee.IsNil() {
+				s.App.TxFeesKeeper.SetBaseFee(s.Ctx, tc.baseFee)
+			}
 			s.Ctx = s.Ctx.WithIsCheckTx(tc.isCheckTx).WithMinGasPrices(tc.minGasPrices)
 
 			// TODO: Cleanup this code.
@@ -26,12 +26,46 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.CalcFeeSpotPrice(ctx, feeToken.Denom)
+	conversionRate, _, err := k.CalcFeeConversionRate(ctx, feeToken.Denom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).RoundInt()), nil
+	return sdk.NewCoin(baseDenom, conversionRate.MulInt(inputFee.Amount).RoundInt()), nil
+}
+
+// CalcFeeConversionRate returns the rate at which fees paid in inputDenom are converted into the base denomination,
+// and whether this rate is a TWAP.
+// The rate is the geometric TWAP of the fee token's pool over the conversion TWAP window ending at the current block,
+// so that it can't be moved within a block to pay fees cheaply.
+// If the window is zero, or the pool has no TWAP history over the whole window, e.g. right after its creation,
+// the rate falls back to the spot price.
+func (k Keeper) CalcFeeConversionRate(ctx sdk.Context, inputDenom string) (rate sdk.Dec, isTwap bool, err error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	feeToken, err := k.GetFeeToken(ctx, inputDenom)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	window := k.GetParams(ctx).ConversionTwapWindow
+	if window > 0 {
+		startTime := ctx.BlockTime().Add(-window)
+		twap, err := k.twapKeeper.GetGeometricTwapToNow(ctx, feeToken.PoolID, feeToken.Denom, baseDenom, startTime)
+		if err == nil {
+			return twap, true, nil
+		}
+		k.Logger(ctx).Debug("falling back to spot price for fee token conversion", "denom", inputDenom, "twap", twap, "error", err)
+	}
+
+	spotPrice, err := k.CalcFeeSpotPrice(ctx, inputDenom)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+	return spotPrice, false, nil
 }
 
 // CalcFeeSpotPrice converts the provided tx fees into their equivalent value in the base denomination.
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper"
 	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -225,3 +228,77 @@ func (s *KeeperTestSuite) TestFeeTokenConversions() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCalcFeeConversionRate() {
+	s.SetupTest(false)
+
+	uionPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000),
+		sdk.NewInt64Coin("uion", 2000000),
+	)
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId))
+
+	window := s.App.TxFeesKeeper.GetParams(s.Ctx).ConversionTwapWindow
+
+	// the pool has no TWAP history over the whole window yet, so the spot price is used.
+	rate, isTwap, err := s.App.TxFeesKeeper.CalcFeeConversionRate(s.Ctx, "uion")
+	s.Require().NoError(err)
+	s.Require().False(isTwap)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+
+	// move the spot price within the block, after the pool's TWAP history covers the window.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(window + time.Second))
+	_, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], uionPoolId, sdk.NewInt64Coin("uion", 2000000), sdk.DefaultBondDenom, sdk.OneInt())
+	s.Require().NoError(err)
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+
+	spotPrice, err := s.App.TxFeesKeeper.CalcFeeSpotPrice(s.Ctx, "uion")
+	s.Require().NoError(err)
+	s.Require().True(spotPrice.LT(sdk.NewDecWithPrec(25, 2)))
+
+	// the TWAP is not moved by the swap.
+	rate, isTwap, err = s.App.TxFeesKeeper.CalcFeeConversionRate(s.Ctx, "uion")
+	s.Require().NoError(err)
+	s.Require().True(isTwap)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 1), rate)
+
+	converted, err := s.App.TxFeesKeeper.ConvertToBaseToken(s.Ctx, sdk.NewInt64Coin("uion", 10))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), converted)
+
+	querier := keeper.NewQuerier(*s.App.TxFeesKeeper)
+	res, err := querier.DenomConversionRate(sdk.WrapSDKContext(s.Ctx), &types.QueryDenomConversionRateRequest{Denom: "uion"})
+	s.Require().NoError(err)
+	s.Require().Equal(uionPoolId, res.PoolID)
+	s.Require().Equal(rate, res.ConversionRate)
+	s.Require().True(res.IsTwap)
+
+	// a zero window disables TWAP conversion.
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.ConversionTwapWindow = 0
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	rate, isTwap, err = s.App.TxFeesKeeper.CalcFeeConversionRate(s.Ctx, "uion")
+	s.Require().NoError(err)
+	s.Require().False(isTwap)
+	s.Require().Equal(spotPrice, rate)
+}
+
+func (s *KeeperTestSuite) TestCalcFeeConversionRateOfOne() {
+	s.SetupTest(false)
+
+	uatomPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000),
+		sdk.NewInt64Coin("uatom", 1000000),
+	)
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("uatom", uatomPoolId))
+
+	// the geometric TWAP of a constant price of one is one.
+	window := s.App.TxFeesKeeper.GetParams(s.Ctx).ConversionTwapWindow
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(window + time.Second))
+
+	rate, isTwap, err := s.App.TxFeesKeeper.CalcFeeConversionRate(s.Ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().True(isTwap)
+	s.Require().Equal(sdk.OneDec(), rate)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// GetRecentBlockGas returns the gas used by each of the last RecentBlockGasWindow blocks, from the oldest.
+func (k Keeper) GetRecentBlockGas(ctx sdk.Context) []uint64 {
+	iterator := k.getRecentBlockGasStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	recentBlockGas := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		recentBlockGas = append(recentBlockGas, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	return recentBlockGas
+}
+
+// recordBlockGas records the gas used by the current block,
+// and prunes the gas used by the block falling out of the recent block window.
+func (k Keeper) recordBlockGas(ctx sdk.Context, blockGasUsed uint64) {
+	store := k.getRecentBlockGasStore(ctx)
+	height := uint64(ctx.BlockHeight())
+	store.Set(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(blockGasUsed))
+	if height >= types.RecentBlockGasWindow {
+		store.Delete(sdk.Uint64ToBigEndian(height - types.RecentBlockGasWindow))
+	}
+}
+
+func (k Keeper) getRecentBlockGasStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RecentBlockGasPrefix)
+}
+
+// GetRecommendedGasPrices returns the recommended gas prices in the base denom, followed by those in every fee token.
+// Base denom gas prices are:
+// - low: the base fee, which the next block requires.
+// - medium: the base fee following a block using the average gas of recent blocks, if higher.
+// - high: the base fee following a block using the most gas of recent blocks, if higher.
+// They are converted to fee token gas prices at the rate fees paid in the fee token are converted at.
+// Fee tokens whose conversion rate can't be calculated, e.g. as their pool was drained, are left out.
+func (k Keeper) GetRecommendedGasPrices(ctx sdk.Context) ([]types.RecommendedGasPrice, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+	low, medium, high := baseFee, baseFee, baseFee
+
+	recentBlockGas := k.GetRecentBlockGas(ctx)
+	if len(recentBlockGas) > 0 {
+		totalGas, maxGas := sdk.ZeroInt(), uint64(0)
+		for _, blockGas := range recentBlockGas {
+			totalGas = totalGas.Add(sdk.NewIntFromUint64(blockGas))
+			if blockGas > maxGas {
+				maxGas = blockGas
+			}
+		}
+		avgGas := totalGas.QuoRaw(int64(len(recentBlockGas))).Uint64()
+		medium = sdk.MaxDec(baseFee, calcNextBaseFee(params, baseFee, avgGas))
+		high = sdk.MaxDec(baseFee, calcNextBaseFee(params, baseFee, maxGas))
+	}
+
+	gasPrices := []types.RecommendedGasPrice{{Denom: baseDenom, Low: low, Medium: medium, High: high}}
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		conversionRate, _, err := k.CalcFeeConversionRate(ctx, feeToken.Denom)
+		if err != nil || !conversionRate.IsPositive() {
+			k.Logger(ctx).Debug("no recommended gas prices for fee token", "denom", feeToken.Denom, "rate", conversionRate, "error", err)
+			continue
+		}
+		// round up, so that paying the recommended gas price in the fee token pays at least that in the base denom.
+		gasPrices = append(gasPrices, types.RecommendedGasPrice{
+			Denom:  feeToken.Denom,
+			Low:    low.QuoRoundUp(conversionRate),
+			Medium: medium.QuoRoundUp(conversionRate),
+			High:   high.QuoRoundUp(conversionRate),
+		})
+	}
+	return gasPrices, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+func (s *KeeperTestSuite) TestRecentBlockGasWindow() {
+	s.SetupTest(false)
+
+	for height := int64(1); height <= types.RecentBlockGasWindow+5; height++ {
+		blockGasMeter := sdk.NewInfiniteGasMeter()
+		blockGasMeter.ConsumeGas(uint64(height), "block gas meter")
+		s.App.TxFeesKeeper.EndBlock(s.Ctx.WithBlockHeight(height).WithBlockGasMeter(blockGasMeter))
+	}
+
+	recentBlockGas := s.App.TxFeesKeeper.GetRecentBlockGas(s.Ctx)
+	s.Require().Len(recentBlockGas, types.RecentBlockGasWindow)
+	s.Require().Equal(uint64(6), recentBlockGas[0])
+	s.Require().Equal(uint64(types.RecentBlockGasWindow+5), recentBlockGas[len(recentBlockGas)-1])
+}
+
+func (s *KeeperTestSuite) TestGetRecommendedGasPrices() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.App.TxFeesKeeper.SetParams(s.Ctx, baseFeeTestParams(1_000_000))
+
+	uionPoolId := s.PrepareBalancerPoolWithCoins(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin("uion", 2000000),
+	)
+	s.Require().NoError(s.ExecuteUpgradeFeeTokenProposal("uion", uionPoolId))
+
+	// without recent blocks, all gas prices are the base fee.
+	s.App.TxFeesKeeper.SetBaseFee(s.Ctx, sdk.NewDec(1))
+	gasPrices, err := s.App.TxFeesKeeper.GetRecommendedGasPrices(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.RecommendedGasPrice{
+		{Denom: baseDenom, Low: sdk.NewDec(1), Medium: sdk.NewDec(1), High: sdk.NewDec(1)},
+		{Denom: "uion", Low: sdk.NewDec(2), Medium: sdk.NewDec(2), High: sdk.NewDec(2)},
+	}, gasPrices)
+
+	// recent blocks use the target block gas on average, and at most 1.5 times of it.
+	for i, blockGas := range []uint64{500_000, 1_000_000, 1_500_000} {
+		blockGasMeter := sdk.NewInfiniteGasMeter()
+		blockGasMeter.ConsumeGas(blockGas, "block gas meter")
+		s.App.TxFeesKeeper.EndBlock(s.Ctx.WithBlockHeight(int64(i + 1)).WithBlockGasMeter(blockGasMeter))
+	}
+	s.App.TxFeesKeeper.SetBaseFee(s.Ctx, sdk.NewDec(1))
+
+	expGasPrices := []types.RecommendedGasPrice{
+		{Denom: baseDenom, Low: sdk.NewDec(1), Medium: sdk.NewDec(1), High: sdk.MustNewDecFromStr("1.05")},
+		{Denom: "uion", Low: sdk.NewDec(2), Medium: sdk.NewDec(2), High: sdk.MustNewDecFromStr("2.1")},
+	}
+	gasPrices, err = s.App.TxFeesKeeper.GetRecommendedGasPrices(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expGasPrices, gasPrices)
+
+	res, err := keeper.NewQuerier(*s.App.TxFeesKeeper).RecommendedGasPrices(sdk.WrapSDKContext(s.Ctx), &types.QueryRecommendedGasPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(expGasPrices, res.GasPrices)
+}
@@ -17,6 +17,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err != nil {
 		panic(err)
 	}
+	k.SetParams(ctx, genState.Params)
+	k.SetBaseFee(ctx, genState.BaseFee)
+	for _, feeSponsor := range genState.FeeSponsors {
+		if err := k.setFeeSponsor(ctx, feeSponsor); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the txfees module's exported genesis.
@@ -24,5 +31,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.BaseFee = k.GetBaseFee(ctx)
+	genesis.FeeSponsors = k.GetFeeSponsors(ctx)
 	return genesis
 }
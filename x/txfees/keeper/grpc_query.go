@@ -54,6 +54,29 @@ func (q Querier) DenomSpotPrice(ctx context.Context, req *types.QueryDenomSpotPr
 	return &types.QueryDenomSpotPriceResponse{PoolID: feeToken.PoolID, SpotPrice: spotPrice}, nil
 }
 
+func (q Querier) DenomConversionRate(ctx context.Context, req *types.QueryDenomConversionRateRequest) (*types.QueryDenomConversionRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	conversionRate, isTwap, err := q.CalcFeeConversionRate(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	feeToken, err := q.GetFeeToken(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomConversionRateResponse{PoolID: feeToken.PoolID, ConversionRate: conversionRate, IsTwap: isTwap}, nil
+}
+
 func (q Querier) DenomPoolId(ctx context.Context, req *types.QueryDenomPoolIdRequest) (*types.QueryDenomPoolIdResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -82,3 +105,47 @@ func (q Querier) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest)
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (q Querier) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryBaseFeeResponse{BaseFee: q.Keeper.GetBaseFee(sdkCtx)}, nil
+}
+
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+func (q Querier) MempoolFilters(ctx context.Context, _ *types.QueryMempoolFiltersRequest) (*types.QueryMempoolFiltersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	filters := q.Keeper.GetParams(sdkCtx).MempoolFilters
+	stats := make([]types.MempoolFilterStats, 0, len(filters))
+	for _, filter := range filters {
+		stats = append(stats, types.MempoolFilterStats{
+			Filter:         filter,
+			MatchedTxCount: q.Keeper.GetMempoolFilterCounter(sdkCtx, filter.Name),
+		})
+	}
+
+	return &types.QueryMempoolFiltersResponse{Filters: stats}, nil
+}
+
+func (q Querier) RecommendedGasPrices(ctx context.Context, _ *types.QueryRecommendedGasPricesRequest) (*types.QueryRecommendedGasPricesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gasPrices, err := q.Keeper.GetRecommendedGasPrices(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecommendedGasPricesResponse{GasPrices: gasPrices}, nil
+}
+
+func (q Querier) FeeSponsors(ctx context.Context, _ *types.QueryFeeSponsorsRequest) (*types.QueryFeeSponsorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryFeeSponsorsResponse{FeeSponsors: q.Keeper.GetFeeSponsors(sdkCtx)}, nil
+}
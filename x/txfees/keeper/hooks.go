@@ -3,8 +3,6 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/osmosis-labs/osmosis/osmoutils"
-	txfeestypes "github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -12,40 +10,23 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	return nil
 }
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account.
+// Fee token balances too large to swap at once are swapped in chunks over the next blocks.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
-	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
+	params := k.GetParams(ctx)
 	feeTokens := k.GetFeeTokens(ctx)
 
 	for _, feetoken := range feeTokens {
 		if feetoken.Denom == baseDenom {
 			continue
 		}
-		coinBalance := k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, feetoken.Denom)
-		if coinBalance.Amount.IsZero() {
-			continue
+		if done := k.liquidateFeeToken(ctx, params, feetoken, baseDenom); !done {
+			k.setLiquidationPending(ctx, feetoken.Denom)
 		}
-
-		// Do the swap of this fee token denom to base denom.
-		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.poolManager.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
-		})
 	}
 
-	// Get all of the txfee payout denom in the module account
-	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, baseDenom))
-
-	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, txfeestypes.NonNativeFeeCollectorName, txfeestypes.FeeCollectorName, baseDenomCoins)
-		return err
-	})
+	k.sendBaseDenomToFeeCollector(ctx, baseDenom)
 
 	return nil
 }
@@ -6,13 +6,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 )
 
-var defaultPooledAssetAmount = int64(500)
+var defaultPooledAssetAmount = int64(5000)
 
 func (s *KeeperTestSuite) preparePool(denom string) (poolID uint64, pool poolmanagertypes.PoolI) {
 	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
@@ -100,8 +101,10 @@ func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 			s.Equal(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee), tc.coins)
 
 			// End of epoch, so all the non-osmo fee amount should be swapped to osmo and transfer to fee module account
+			// the pools need TWAP history over the liquidation TWAP window to bound the swaps.
 			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
-			futureCtx := s.Ctx.WithBlockTime(time.Now().Add(time.Minute))
+			liquidationTwapWindow := s.App.TxFeesKeeper.GetParams(s.Ctx).LiquidationTwapWindow
+			futureCtx := s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(liquidationTwapWindow + time.Minute))
 			err := s.App.TxFeesKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
 			s.NoError(err)
 
@@ -116,3 +119,167 @@ func (s *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 		})
 	}
 }
+
+// fundNonNativeFeeCollector sends the given coins to the non native fee collector, as if they were paid as fees.
+func (s *KeeperTestSuite) fundNonNativeFeeCollector(coins sdk.Coins) {
+	_, _, addr0 := testdata.KeyTestPubAddr()
+	err := simapp.FundAccount(s.App.BankKeeper, s.Ctx, addr0, coins)
+	s.Require().NoError(err)
+	err = s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, addr0, types.NonNativeFeeCollectorName, coins)
+	s.Require().NoError(err)
+}
+
+// afterLiquidationTwapWindow moves the block time past the liquidation TWAP window of the pools created so far.
+func (s *KeeperTestSuite) afterLiquidationTwapWindow() {
+	liquidationTwapWindow := s.App.TxFeesKeeper.GetParams(s.Ctx).LiquidationTwapWindow
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(liquidationTwapWindow + time.Minute))
+}
+
+func (s *KeeperTestSuite) TestAfterEpochEndSlippageBound() {
+	s.SetupTest(false)
+	uionPoolId, _ := s.preparePool("uion")
+	s.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("uion", 10)))
+	s.afterLiquidationTwapWindow()
+
+	// sandwich the liquidation: the spot price of uion moves away from its TWAP.
+	_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], uionPoolId, sdk.NewInt64Coin("uion", 2000), sdk.DefaultBondDenom, sdk.OneInt())
+	s.Require().NoError(err)
+
+	err = s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "day", 1)
+	s.Require().NoError(err)
+
+	// the swap is not executed, the balance is left for the next epoch.
+	moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uion", 10)), s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+	s.Require().Empty(s.App.TxFeesKeeper.GetLiquidationPendingDenoms(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestAfterEpochEndChunkedLiquidation() {
+	s.SetupTest(false)
+	s.preparePool("uion")
+
+	// bound the slippage loosely, as the liquidation chunks successively move the spot price.
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MaxLiquidationSlippage = sdk.NewDecWithPrec(2, 1)
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	// the balance is 6% of the pool liquidity, liquidated in chunks of at most 2% of it.
+	s.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("uion", 300)))
+	s.afterLiquidationTwapWindow()
+
+	moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	moduleAddrFee := s.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err := s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "day", 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(200), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrNonNativeFee, "uion").Amount)
+	s.Require().Equal([]string{"uion"}, s.App.TxFeesKeeper.GetLiquidationPendingDenoms(s.Ctx))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtFeeTokenLiquidation, 1)
+	feeCollectorBalance := s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, sdk.DefaultBondDenom).Amount
+	s.Require().True(feeCollectorBalance.IsPositive())
+
+	// the liquidation does not continue in the same block.
+	s.App.TxFeesKeeper.EndBlock(s.Ctx)
+	s.Require().Equal(sdk.NewInt(200), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrNonNativeFee, "uion").Amount)
+
+	// the liquidation continues in the next blocks, until the whole balance is liquidated.
+	for i := 0; i < 3 && len(s.App.TxFeesKeeper.GetLiquidationPendingDenoms(s.Ctx)) > 0; i++ {
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		s.App.TxFeesKeeper.EndBlock(s.Ctx)
+	}
+	s.Require().Empty(s.App.TxFeesKeeper.GetLiquidationPendingDenoms(s.Ctx))
+	s.Require().Empty(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddrNonNativeFee))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddrFee, sdk.DefaultBondDenom).Amount.GT(feeCollectorBalance))
+}
+
+// TestChunkedLiquidationUpdatesTwap tests that the liquidation chunks swapped in the txfees end blocker are recorded
+// by the twap end blocker of the same block.
+func (s *KeeperTestSuite) TestChunkedLiquidationUpdatesTwap() {
+	s.SetupTest(false)
+	uionPoolId, _ := s.preparePool("uion")
+
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MaxLiquidationSlippage = sdk.NewDecWithPrec(2, 1)
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	s.fundNonNativeFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("uion", 300)))
+	s.afterLiquidationTwapWindow()
+	err := s.App.TxFeesKeeper.AfterEpochEnd(s.Ctx, "day", 1)
+	s.Require().NoError(err)
+	s.App.EndBlocker(s.Ctx, abci.RequestEndBlock{Height: s.Ctx.BlockHeight()})
+
+	// the next block liquidates a chunk in the end blockers of the app.
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	priceBeforeChunk, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, uionPoolId, sdk.DefaultBondDenom, "uion")
+	s.Require().NoError(err)
+	s.App.EndBlocker(s.Ctx, abci.RequestEndBlock{Height: s.Ctx.BlockHeight()})
+	priceAfterChunk, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, uionPoolId, sdk.DefaultBondDenom, "uion")
+	s.Require().NoError(err)
+	s.Require().True(priceAfterChunk.LT(priceBeforeChunk))
+
+	// the TWAP since the chunk is the spot price after the chunk, as it was recorded in the same block.
+	chunkTime := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(chunkTime.Add(time.Minute))
+	twap, err := s.App.TwapKeeper.GetArithmeticTwapToNow(s.Ctx, uionPoolId, "uion", sdk.DefaultBondDenom, chunkTime)
+	s.Require().NoError(err)
+	s.Require().Equal(priceAfterChunk, twap)
+}
+
+func (s *KeeperTestSuite) TestAfterEpochEndLiquidationRoute() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.preparePool("uion")
+
+	uionFooPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("uion", 5000), sdk.NewInt64Coin("foo", 5000))
+	fooPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 5000), sdk.NewInt64Coin(baseDenom, 5000))
+
+	tests := map[string]struct {
+		routes        []poolmanagertypes.SwapAmountInRoute
+		expLiquidated bool
+	}{
+		"route through foo": {
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: uionFooPoolId, TokenOutDenom: "foo"},
+				{PoolId: fooPoolId, TokenOutDenom: baseDenom},
+			},
+			expLiquidated: true,
+		},
+		"route not ending in the base denom": {
+			routes: []poolmanagertypes.SwapAmountInRoute{
+				{PoolId: uionFooPoolId, TokenOutDenom: "foo"},
+			},
+			expLiquidated: false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			ctx, _ := s.Ctx.CacheContext()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(s.App.TxFeesKeeper.GetParams(ctx).LiquidationTwapWindow + time.Minute))
+
+			params := s.App.TxFeesKeeper.GetParams(ctx)
+			params.LiquidationRoutes = []types.LiquidationRoute{{Denom: "uion", Routes: tc.routes}}
+			s.App.TxFeesKeeper.SetParams(ctx, params)
+
+			_, _, addr0 := testdata.KeyTestPubAddr()
+			fee := sdk.NewCoins(sdk.NewInt64Coin("uion", 100))
+			s.Require().NoError(simapp.FundAccount(s.App.BankKeeper, ctx, addr0, fee))
+			s.Require().NoError(s.App.BankKeeper.SendCoinsFromAccountToModule(ctx, addr0, types.NonNativeFeeCollectorName, fee))
+
+			err := s.App.TxFeesKeeper.AfterEpochEnd(ctx, "day", 1)
+			s.Require().NoError(err)
+
+			moduleAddrNonNativeFee := s.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+			uionFooLiquidity, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(ctx, uionFooPoolId)
+			s.Require().NoError(err)
+			if tc.expLiquidated {
+				s.Require().Empty(s.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+				s.Require().Equal(sdk.NewInt(5100), uionFooLiquidity.AmountOf("uion"))
+			} else {
+				s.Require().Equal(fee, s.App.BankKeeper.GetAllBalances(ctx, moduleAddrNonNativeFee))
+				s.Require().Equal(sdk.NewInt(5000), uionFooLiquidity.AmountOf("uion"))
+			}
+		})
+	}
+}
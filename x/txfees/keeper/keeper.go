@@ -7,17 +7,22 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
 )
 
 type Keeper struct {
-	storeKey sdk.StoreKey
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
 	poolManager         types.PoolManager
 	spotPriceCalculator types.SpotPriceCalculator
+	twapKeeper          types.TwapKeeper
+	contractKeeper      types.ContractKeeper
+	wasmKeeper          types.WasmKeeper
 }
 
 var _ types.TxFeesKeeper = (*Keeper)(nil)
@@ -26,18 +31,38 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	poolManager types.PoolManager,
 	spotPriceCalculator types.SpotPriceCalculator,
+	twapKeeper types.TwapKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		storeKey:            storeKey,
+		paramSpace:          paramSpace,
 		poolManager:         poolManager,
 		spotPriceCalculator: spotPriceCalculator,
+		twapKeeper:          twapKeeper,
 	}
 }
 
+// SetContractKeeper sets the contract keeper calling fee sponsor contracts.
+// It is set after keeper construction, as the wasm keeper is constructed after the txfees keeper.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// SetWasmKeeper sets the wasm keeper checking that fee sponsors are contracts.
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -46,3 +71,14 @@ func (k Keeper) GetFeeTokensStore(ctx sdk.Context) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.FeeTokensStorePrefix)
 }
+
+// GetParams returns the total set of txfees parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of txfees parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
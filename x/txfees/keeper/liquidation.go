@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// liquidateFeeToken swaps a chunk of the non native fee collector's balance of the given fee token to the base denom,
+// through its liquidation route, and returns true if no balance is left to liquidate in the next blocks.
+//
+// The swap output is bounded below by the TWAP value of the chunk, less the max liquidation slippage,
+// so that the swap can't be sandwiched for more than that.
+// The chunk is the whole balance, unless it exceeds the max liquidation pool fraction of the liquidity
+// of the token in of any pool of the route, in which case the rest is left to liquidate in the next blocks.
+// If the swap fails, e.g. because the price moved away from its TWAP, the balance is left for the next epoch.
+func (k Keeper) liquidateFeeToken(ctx sdk.Context, params types.Params, feeToken types.FeeToken, baseDenom string) (done bool) {
+	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	balance := k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, feeToken.Denom)
+	if balance.Amount.IsZero() {
+		return true
+	}
+
+	remaining := sdk.ZeroInt()
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		routes, err := k.getLiquidationRoute(params, feeToken, baseDenom)
+		if err != nil {
+			return err
+		}
+
+		expectedRate, amountIn, err := k.getLiquidationQuote(cacheCtx, params, balance, routes)
+		if err != nil {
+			return err
+		}
+
+		tokenIn := sdk.NewCoin(feeToken.Denom, amountIn)
+		minAmountOut := expectedRate.MulInt(amountIn).Mul(sdk.OneDec().Sub(params.MaxLiquidationSlippage)).TruncateInt()
+		amountOut, err := k.poolManager.RouteExactAmountIn(cacheCtx, nonNativeFeeAddr, routes, tokenIn, minAmountOut)
+		if err != nil {
+			return err
+		}
+
+		remaining = balance.Amount.Sub(amountIn)
+		cacheCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtFeeTokenLiquidation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenOut, sdk.NewCoin(baseDenom, amountOut).String()),
+			sdk.NewAttribute(types.AttributeKeyMinAmountOut, minAmountOut.String()),
+			sdk.NewAttribute(types.AttributeKeyExpectedRate, expectedRate.String()),
+			sdk.NewAttribute(types.AttributeKeyRealisedRate, sdk.NewDecFromInt(amountOut).QuoInt(amountIn).String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingBalance, remaining.String()),
+		))
+		return nil
+	})
+	if err != nil {
+		return true
+	}
+	return remaining.IsZero()
+}
+
+// getLiquidationRoute returns the route the given fee token is liquidated through,
+// which is its liquidation route param if set, and its fee token pool otherwise.
+func (k Keeper) getLiquidationRoute(params types.Params, feeToken types.FeeToken, baseDenom string) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	routes, ok := params.GetLiquidationRoute(feeToken.Denom)
+	if !ok {
+		return []poolmanagertypes.SwapAmountInRoute{{PoolId: feeToken.PoolID, TokenOutDenom: baseDenom}}, nil
+	}
+	if finalDenom := routes[len(routes)-1].TokenOutDenom; finalDenom != baseDenom {
+		return nil, fmt.Errorf("liquidation route of %s ends in %s, not the base denom %s", feeToken.Denom, finalDenom, baseDenom)
+	}
+	return routes, nil
+}
+
+// getLiquidationQuote returns the expected rate of a liquidation swap through the given routes, the product of the
+// arithmetic TWAPs of its hops over the liquidation TWAP window, and the chunk of the balance to swap.
+func (k Keeper) getLiquidationQuote(ctx sdk.Context, params types.Params, balance sdk.Coin, routes []poolmanagertypes.SwapAmountInRoute) (expectedRate sdk.Dec, amountIn sdk.Int, err error) {
+	startTime := ctx.BlockTime().Add(-params.LiquidationTwapWindow)
+	expectedRate = sdk.OneDec()
+	amountIn = balance.Amount
+	tokenInDenom := balance.Denom
+
+	for _, route := range routes {
+		if params.MaxLiquidationPoolFraction.IsPositive() {
+			liquidity, err := k.poolManager.GetTotalPoolLiquidity(ctx, route.PoolId)
+			if err != nil {
+				return sdk.Dec{}, sdk.Int{}, err
+			}
+			// the max amount in of the hop, valued in the fee token at the expected rate of the previous hops.
+			hopMaxAmountIn := params.MaxLiquidationPoolFraction.MulInt(liquidity.AmountOf(tokenInDenom)).Quo(expectedRate).TruncateInt()
+			amountIn = sdk.MinInt(amountIn, hopMaxAmountIn)
+		}
+
+		twap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom, startTime)
+		if err != nil {
+			return sdk.Dec{}, sdk.Int{}, err
+		}
+		if !twap.IsPositive() {
+			return sdk.Dec{}, sdk.Int{}, fmt.Errorf("non-positive twap %s of %s in %s in pool %d", twap, tokenInDenom, route.TokenOutDenom, route.PoolId)
+		}
+		expectedRate = expectedRate.Mul(twap)
+		tokenInDenom = route.TokenOutDenom
+	}
+
+	if !amountIn.IsPositive() {
+		return sdk.Dec{}, sdk.Int{}, fmt.Errorf("no liquidity to liquidate %s through", balance.Denom)
+	}
+	return expectedRate, amountIn, nil
+}
+
+// liquidatePendingFeeTokens liquidates a chunk of each fee token whose liquidation is pending,
+// and was not liquidated in this block yet, then sends the base denom collected to the fee collector.
+func (k Keeper) liquidatePendingFeeTokens(ctx sdk.Context) {
+	pendingDenoms := k.GetLiquidationPendingDenoms(ctx)
+	if len(pendingDenoms) == 0 {
+		return
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return
+	}
+	params := k.GetParams(ctx)
+
+	for _, denom := range pendingDenoms {
+		if k.getLiquidationPendingHeight(ctx, denom) == ctx.BlockHeight() {
+			continue
+		}
+		feeToken, err := k.GetFeeToken(ctx, denom)
+		if err != nil {
+			// the fee token was removed, its balance is swapped if it is added back.
+			k.deleteLiquidationPending(ctx, denom)
+			continue
+		}
+		if done := k.liquidateFeeToken(ctx, params, feeToken, baseDenom); done {
+			k.deleteLiquidationPending(ctx, denom)
+		} else {
+			k.setLiquidationPending(ctx, denom)
+		}
+	}
+
+	k.sendBaseDenomToFeeCollector(ctx, baseDenom)
+}
+
+// sendBaseDenomToFeeCollector sends the base denom balance of the non native fee collector to the fee collector.
+func (k Keeper) sendBaseDenomToFeeCollector(ctx sdk.Context, baseDenom string) {
+	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+	baseDenomCoins := sdk.NewCoins(k.bankKeeper.GetBalance(ctx, nonNativeFeeAddr, baseDenom))
+	if baseDenomCoins.IsZero() {
+		return
+	}
+
+	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.NonNativeFeeCollectorName, types.FeeCollectorName, baseDenomCoins)
+	})
+}
+
+func (k Keeper) getLiquidationPendingStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidationPendingPrefix)
+}
+
+// setLiquidationPending marks the liquidation of the given fee token denom as pending,
+// after a chunk of it was liquidated in the current block.
+func (k Keeper) setLiquidationPending(ctx sdk.Context, denom string) {
+	k.getLiquidationPendingStore(ctx).Set([]byte(denom), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// getLiquidationPendingHeight returns the height the given pending fee token denom was last liquidated at.
+func (k Keeper) getLiquidationPendingHeight(ctx sdk.Context, denom string) int64 {
+	bz := k.getLiquidationPendingStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (k Keeper) deleteLiquidationPending(ctx sdk.Context, denom string) {
+	k.getLiquidationPendingStore(ctx).Delete([]byte(denom))
+}
+
+// GetLiquidationPendingDenoms returns the denoms of the fee tokens whose liquidation is pending.
+func (k Keeper) GetLiquidationPendingDenoms(ctx sdk.Context) []string {
+	iterator := k.getLiquidationPendingStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
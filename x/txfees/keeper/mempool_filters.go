@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper/txfee_filters"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// GetMatchingMempoolFilters returns the mempool filters the tx matches.
+func (k Keeper) GetMatchingMempoolFilters(ctx sdk.Context, tx sdk.Tx) []types.MempoolFilter {
+	var filters []types.MempoolFilter
+	k.paramSpace.Get(ctx, types.KeyMempoolFilters, &filters)
+
+	matchedFilters := []types.MempoolFilter{}
+	for _, filter := range filters {
+		if txfee_filters.NewTxFilter(filter)(tx) {
+			matchedFilters = append(matchedFilters, filter)
+		}
+	}
+	return matchedFilters
+}
+
+// GetMempoolFilterCounter returns the number of delivered txs the mempool filter of the given name matched.
+func (k Keeper) GetMempoolFilterCounter(ctx sdk.Context, name string) uint64 {
+	bz := k.getMempoolFilterCountersStore(ctx).Get([]byte(name))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementMempoolFilterCounters increments the counters of the given mempool filters.
+func (k Keeper) IncrementMempoolFilterCounters(ctx sdk.Context, filters []types.MempoolFilter) {
+	store := k.getMempoolFilterCountersStore(ctx)
+	for _, filter := range filters {
+		count := k.GetMempoolFilterCounter(ctx, filter.Name)
+		store.Set([]byte(filter.Name), sdk.Uint64ToBigEndian(count+1))
+	}
+}
+
+func (k Keeper) getMempoolFilterCountersStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.MempoolFilterCountersPrefix)
+}
+
+// getMempoolFiltersMultiplier returns the largest min gas price multiplier of the given mempool filters,
+// or one if there are none.
+func getMempoolFiltersMultiplier(filters []types.MempoolFilter) sdk.Dec {
+	multiplier := sdk.OneDec()
+	for _, filter := range filters {
+		multiplier = sdk.MaxDec(multiplier, filter.MinGasPriceMultiplier)
+	}
+	return multiplier
+}
+
+// matchesMempoolFilter returns true if the mempool filter of the given name is among the given filters.
+func matchesMempoolFilter(filters []types.MempoolFilter, name string) bool {
+	for _, filter := range filters {
+		if filter.Name == name {
+			return true
+		}
+	}
+	return false
+}
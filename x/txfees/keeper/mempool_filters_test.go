@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+var (
+	arbitrageFilter = types.MempoolFilter{Name: types.ArbitrageMempoolFilterName, MinGasPriceMultiplier: sdk.OneDec()}
+	bankSendFilter  = types.MempoolFilter{
+		Name:                  "bank-send",
+		MinGasPriceMultiplier: sdk.NewDec(2),
+		MsgTypeUrls:           []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	}
+	longRouteFilter = types.MempoolFilter{
+		Name:                  "long-route",
+		MinGasPriceMultiplier: sdk.NewDec(3),
+		SwapRouteShape:        &types.SwapRouteShape{MinHops: 3},
+	}
+	pool5Filter = types.MempoolFilter{
+		Name:                  "pool-5",
+		MinGasPriceMultiplier: sdk.NewDec(4),
+		SwapRouteShape:        &types.SwapRouteShape{PoolIds: []uint64{5}},
+	}
+)
+
+func (s *KeeperTestSuite) TestGetMatchingMempoolFilters() {
+	s.SetupTest(false)
+	addr := s.TestAccs[0].String()
+
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MempoolFilters = []types.MempoolFilter{arbitrageFilter, bankSendFilter, longRouteFilter, pool5Filter}
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	tests := map[string]struct {
+		msgs       []sdk.Msg
+		expFilters []types.MempoolFilter
+	}{
+		"no msg matches": {
+			msgs:       []sdk.Msg{testdata.NewTestMsg(s.TestAccs[0])},
+			expFilters: []types.MempoolFilter{},
+		},
+		"msg type url": {
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)))},
+			expFilters: []types.MempoolFilter{bankSendFilter},
+		},
+		"cyclic gamm swap, short route": {
+			msgs: []sdk.Msg{&gammtypes.MsgSwapExactAmountIn{
+				Sender:            addr,
+				Routes:            []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uion"}, {PoolId: 2, TokenOutDenom: "uosmo"}},
+				TokenIn:           sdk.NewInt64Coin("uosmo", 10),
+				TokenOutMinAmount: sdk.OneInt(),
+			}},
+			expFilters: []types.MempoolFilter{arbitrageFilter},
+		},
+		"poolmanager swap, long route": {
+			msgs: []sdk.Msg{&poolmanagertypes.MsgSwapExactAmountIn{
+				Sender: addr,
+				Routes: []poolmanagertypes.SwapAmountInRoute{
+					{PoolId: 1, TokenOutDenom: "uion"}, {PoolId: 2, TokenOutDenom: "atom"}, {PoolId: 3, TokenOutDenom: "uosmo"},
+				},
+				TokenIn:           sdk.NewInt64Coin("foo", 10),
+				TokenOutMinAmount: sdk.OneInt(),
+			}},
+			expFilters: []types.MempoolFilter{longRouteFilter},
+		},
+		"poolmanager swap exact amount out, through pool 5": {
+			msgs: []sdk.Msg{&poolmanagertypes.MsgSwapExactAmountOut{
+				Sender:           addr,
+				Routes:           []poolmanagertypes.SwapAmountOutRoute{{PoolId: 5, TokenInDenom: "uion"}},
+				TokenInMaxAmount: sdk.NewInt(10),
+				TokenOut:         sdk.NewInt64Coin("uosmo", 10),
+			}},
+			expFilters: []types.MempoolFilter{pool5Filter},
+		},
+		"split route swap, one route through pool 5": {
+			msgs: []sdk.Msg{&poolmanagertypes.MsgSplitRouteSwapExactAmountIn{
+				Sender: addr,
+				Routes: []poolmanagertypes.SwapAmountInSplitRoute{
+					{Pools: []poolmanagertypes.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}}, TokenInAmount: sdk.NewInt(5)},
+					{Pools: []poolmanagertypes.SwapAmountInRoute{{PoolId: 5, TokenOutDenom: "uosmo"}}, TokenInAmount: sdk.NewInt(5)},
+				},
+				TokenInDenom:      "uion",
+				TokenOutMinAmount: sdk.OneInt(),
+			}},
+			expFilters: []types.MempoolFilter{pool5Filter},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+
+			matchedFilters := s.App.TxFeesKeeper.GetMatchingMempoolFilters(s.Ctx, txBuilder.GetTx())
+			s.Require().Equal(tc.expFilters, matchedFilters)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMempoolFilterMultiplierAndCounter() {
+	s.SetupTest(false)
+	baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+	testMsgFilter := types.MempoolFilter{
+		Name:                  "test-msg",
+		MinGasPriceMultiplier: sdk.NewDec(3),
+		MsgTypeUrls:           []string{sdk.MsgTypeURL(&testdata.TestMsg{})},
+	}
+	params := s.App.TxFeesKeeper.GetParams(s.Ctx)
+	params.MempoolFilters = []types.MempoolFilter{arbitrageFilter, testMsgFilter}
+	s.App.TxFeesKeeper.SetParams(s.Ctx, params)
+
+	// the consensus min fee of a 10000 gas tx is 25, raised to 75 in the mempool by the filter.
+	tests := []struct {
+		name       string
+		fee        int64
+		isCheckTx  bool
+		expectPass bool
+	}{
+		{name: "check tx, fee below the raised min fee", fee: 74, isCheckTx: true, expectPass: false},
+		{name: "check tx, fee at the raised min fee", fee: 75, isCheckTx: true, expectPass: true},
+		{name: "deliver tx, fee at the consensus min fee", fee: 25, isCheckTx: false, expectPass: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.Ctx.WithIsCheckTx(tc.isCheckTx)
+			fee := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, tc.fee))
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			priv0, _, addr0 := testdata.KeyTestPubAddr()
+			s.App.AccountKeeper.SetAccount(ctx, s.App.AccountKeeper.NewAccountWithAddress(ctx, addr0))
+			signerData := authsigning.SignerData{ChainID: ctx.ChainID()}
+			sigV2, err := clienttx.SignWithPrivKey(1, signerData, txBuilder, priv0, s.clientCtx.TxConfig, 0)
+			s.Require().NoError(err)
+			s.Require().NoError(simapp.FundAccount(s.App.BankKeeper, ctx, addr0, fee))
+			tx := s.BuildTx(txBuilder, []sdk.Msg{testdata.NewTestMsg(addr0)}, sigV2, "", fee, 10000)
+
+			counter := s.App.TxFeesKeeper.GetMempoolFilterCounter(ctx, testMsgFilter.Name)
+
+			mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, types.NewDefaultMempoolFeeOptions())
+			dfd := keeper.NewDeductFeeDecorator(*s.App.TxFeesKeeper, *s.App.AccountKeeper, *s.App.BankKeeper, nil)
+			_, err = sdk.ChainAnteDecorators(mfd, dfd)(ctx, tx, false)
+			if !tc.expectPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// only txs in block execution are counted.
+			expCounter := counter
+			if !tc.isCheckTx {
+				expCounter++
+			}
+			s.Require().Equal(expCounter, s.App.TxFeesKeeper.GetMempoolFilterCounter(ctx, testMsgFilter.Name))
+			s.Require().Equal(uint64(0), s.App.TxFeesKeeper.GetMempoolFilterCounter(ctx, arbitrageFilter.Name))
+		})
+	}
+
+	res, err := keeper.NewQuerier(*s.App.TxFeesKeeper).MempoolFilters(sdk.WrapSDKContext(s.Ctx), &types.QueryMempoolFiltersRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.MempoolFilterStats{
+		{Filter: arbitrageFilter, MatchedTxCount: 0},
+		{Filter: testMsgFilter, MatchedTxCount: 1},
+	}, res.Filters)
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// MigrateBaseFee sets the txfees params, introduced along with the consensus base fee,
+// to their default values, and the base fee to the default min base fee.
+func (k Keeper) MigrateBaseFee(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	k.SetParams(ctx, params)
+	k.SetBaseFee(ctx, params.MinBaseFee)
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) RegisterFeeSponsor(goCtx context.Context, msg *types.MsgRegisterFeeSponsor) (*types.MsgRegisterFeeSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.Keeper.RegisterFeeSponsor(ctx, msg.FeeSponsor()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRegisterFeeSponsor,
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Sender),
+		),
+	})
+
+	return &types.MsgRegisterFeeSponsorResponse{}, nil
+}
+
+func (server msgServer) UnregisterFeeSponsor(goCtx context.Context, msg *types.MsgUnregisterFeeSponsor) (*types.MsgUnregisterFeeSponsorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.Keeper.UnregisterFeeSponsor(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUnregisterFeeSponsor,
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnregisterFeeSponsorResponse{}, nil
+}
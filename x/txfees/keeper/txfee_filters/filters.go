@@ -0,0 +1,133 @@
+package txfee_filters
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v16/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v16/x/txfees/types"
+)
+
+// TxFilter returns true if the tx matches the filter.
+type TxFilter func(tx sdk.Tx) bool
+
+// builtinFilters are the filters built into the node, by name.
+// Their names must be listed in types.BuiltinMempoolFilterNames.
+var builtinFilters = map[string]TxFilter{
+	types.ArbitrageMempoolFilterName: IsArbTxLoose,
+}
+
+// NewTxFilter returns the TxFilter matching the txs the given mempool filter matches.
+// A mempool filter named after a built-in filter matches the txs the built-in filter matches.
+// Any other mempool filter matches the txs with a msg of one of its msg type urls,
+// or with a swap route of its swap route shape.
+func NewTxFilter(filter types.MempoolFilter) TxFilter {
+	if builtinFilter, ok := builtinFilters[filter.Name]; ok {
+		return builtinFilter
+	}
+
+	return func(tx sdk.Tx) bool {
+		for _, msg := range tx.GetMsgs() {
+			if matchesMsgTypeURL(msg, filter.MsgTypeUrls) {
+				return true
+			}
+			if filter.SwapRouteShape != nil && matchesSwapRouteShape(msg, *filter.SwapRouteShape) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func matchesMsgTypeURL(msg sdk.Msg, typeURLs []string) bool {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	for _, typeURL := range typeURLs {
+		if msgTypeURL == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesSwapRouteShape returns true if any swap route of the msg has the given shape.
+func matchesSwapRouteShape(msg sdk.Msg, shape types.SwapRouteShape) bool {
+	for _, route := range getSwapRoutes(msg) {
+		if route.hasShape(shape) {
+			return true
+		}
+	}
+	return false
+}
+
+// swapRoute is the path of a swap, as the pools it goes through,
+// and the denoms before and after each of them.
+type swapRoute struct {
+	poolIds []uint64
+	denoms  []string
+}
+
+func (r swapRoute) hasShape(shape types.SwapRouteShape) bool {
+	if uint64(len(r.poolIds)) < shape.MinHops {
+		return false
+	}
+	if shape.Cyclic && r.denoms[0] != r.denoms[len(r.denoms)-1] {
+		return false
+	}
+	if len(shape.PoolIds) == 0 {
+		return true
+	}
+	for _, poolId := range r.poolIds {
+		for _, shapePoolId := range shape.PoolIds {
+			if poolId == shapePoolId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getSwapRoutes returns the swap routes of the msg, if it is a gamm or poolmanager swap msg.
+func getSwapRoutes(msg sdk.Msg) []swapRoute {
+	switch msg := msg.(type) {
+	case *gammtypes.MsgSwapExactAmountIn:
+		return []swapRoute{newSwapAmountInRoute(msg.TokenIn.Denom, msg.Routes)}
+	case *gammtypes.MsgSwapExactAmountOut:
+		return []swapRoute{newSwapAmountOutRoute(msg.Routes, msg.TokenOut.Denom)}
+	case *poolmanagertypes.MsgSwapExactAmountIn:
+		return []swapRoute{newSwapAmountInRoute(msg.TokenIn.Denom, msg.Routes)}
+	case *poolmanagertypes.MsgSwapExactAmountOut:
+		return []swapRoute{newSwapAmountOutRoute(msg.Routes, msg.TokenOut.Denom)}
+	case *poolmanagertypes.MsgSplitRouteSwapExactAmountIn:
+		routes := make([]swapRoute, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			routes = append(routes, newSwapAmountInRoute(msg.TokenInDenom, route.Pools))
+		}
+		return routes
+	case *poolmanagertypes.MsgSplitRouteSwapExactAmountOut:
+		routes := make([]swapRoute, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			routes = append(routes, newSwapAmountOutRoute(route.Pools, msg.TokenOutDenom))
+		}
+		return routes
+	}
+	return nil
+}
+
+func newSwapAmountInRoute(tokenInDenom string, routes []poolmanagertypes.SwapAmountInRoute) swapRoute {
+	route := swapRoute{poolIds: make([]uint64, 0, len(routes)), denoms: []string{tokenInDenom}}
+	for _, hop := range routes {
+		route.poolIds = append(route.poolIds, hop.PoolId)
+		route.denoms = append(route.denoms, hop.TokenOutDenom)
+	}
+	return route
+}
+
+func newSwapAmountOutRoute(routes []poolmanagertypes.SwapAmountOutRoute, tokenOutDenom string) swapRoute {
+	route := swapRoute{poolIds: make([]uint64, 0, len(routes)), denoms: make([]string, 0, len(routes)+1)}
+	for _, hop := range routes {
+		route.poolIds = append(route.poolIds, hop.PoolId)
+		route.denoms = append(route.denoms, hop.TokenInDenom)
+	}
+	route.denoms = append(route.denoms, tokenOutDenom)
+	return route
+}
@@ -6,6 +6,7 @@ only specify their tx fee parameters for a single "base" asset.
 - Adds a whitelist of tokens that can be used as fees on the chain.
 - Any token not on this list cannot be provided as a tx fee.
 - Adds a new SDK message for creating governance proposals for adding new TxFee denoms.
+- Lets CosmWasm contracts register as fee sponsors, paying the fees of the txs they approve.
 */
 package txfees
 
@@ -136,7 +137,12 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	if err := cfg.RegisterMigration(types.ModuleName, 1, am.keeper.MigrateBaseFee); err != nil {
+		panic(fmt.Sprintf("failed to register %s migration from version 1 to 2: %s", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the txfees module's invariants.
@@ -168,9 +174,10 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlock(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
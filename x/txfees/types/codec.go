@@ -3,21 +3,37 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&UpdateFeeTokenProposal{}, "osmosis/UpdateFeeTokenProposal", nil)
+	cdc.RegisterConcrete(&MsgRegisterFeeSponsor{}, "osmosis/txfees/register-fee-sponsor", nil)
+	cdc.RegisterConcrete(&MsgUnregisterFeeSponsor{}, "osmosis/txfees/unregister-fee-sponsor", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterFeeSponsor{},
+		&MsgUnregisterFeeSponsor{},
+	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&UpdateFeeTokenProposal{},
 	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
 )
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
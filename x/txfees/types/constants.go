@@ -5,3 +5,31 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 // ConsensusMinFee is a governance set parameter from prop 354 (https://www.mintscan.io/osmosis/proposals/354)
 // Its intended to be .0025 uosmo / gas
 var ConsensusMinFee sdk.Dec = sdk.NewDecWithPrec(25, 4)
+
+// BaseFeeGrowthFloor is the base fee that a lower base fee, e.g. zero with a zero min base fee, increases from
+// after a block using more than the target gas. As the base fee changes relatively to its current value,
+// a zero base fee would otherwise never move away from zero.
+var BaseFeeGrowthFloor sdk.Dec = sdk.NewDecWithPrec(1, 4)
+
+// RecentBlockGasWindow is the number of recent blocks whose gas used is kept to recommend gas prices.
+const RecentBlockGasWindow = 20
+
+// FeeSponsorApprovalGasLimit is the most gas a fee sponsor contract can use to approve the sponsorship of a tx.
+// As the fees of txs it doesn't approve are not paid, this bounds the work done for them.
+const FeeSponsorApprovalGasLimit = 250_000
+
+// ArbitrageMempoolFilterName is the name of the mempool filter built into the node matching likely arbitrage txs.
+const ArbitrageMempoolFilterName = "arbitrage"
+
+// BuiltinMempoolFilterNames are the names of the mempool filters built into the node, implemented in txfee_filters.
+var BuiltinMempoolFilterNames = []string{ArbitrageMempoolFilterName}
+
+// IsBuiltinMempoolFilter returns true if the mempool filter of the given name is built into the node.
+func IsBuiltinMempoolFilter(name string) bool {
+	for _, builtinName := range BuiltinMempoolFilterNames {
+		if name == builtinName {
+			return true
+		}
+	}
+	return false
+}
@@ -11,4 +11,8 @@ var (
 	ErrNoBaseDenom     = errorsmod.Register(ModuleName, 1, "no base denom was set")
 	ErrTooManyFeeCoins = errorsmod.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
 	ErrInvalidFeeToken = errorsmod.Register(ModuleName, 3, "invalid fee token")
+
+	ErrInvalidFeeSponsor         = errorsmod.Register(ModuleName, 4, "invalid fee sponsor")
+	ErrFeeSponsorNotFound        = errorsmod.Register(ModuleName, 5, "fee sponsor not found")
+	ErrFeeSponsorshipNotApproved = errorsmod.Register(ModuleName, 6, "fee sponsorship not approved")
 )
@@ -0,0 +1,18 @@
+package types
+
+const (
+	TypeEvtFeeTokenLiquidation  = "fee_token_liquidation"
+	TypeEvtFeeSponsorship       = "fee_sponsorship"
+	TypeMsgRegisterFeeSponsor   = "register_fee_sponsor"
+	TypeMsgUnregisterFeeSponsor = "unregister_fee_sponsor"
+
+	AttributeValueCategory       = ModuleName
+	AttributeKeyTokenIn          = "token_in"
+	AttributeKeyTokenOut         = "token_out"
+	AttributeKeyMinAmountOut     = "min_amount_out"
+	AttributeKeyExpectedRate     = "expected_rate"
+	AttributeKeyRealisedRate     = "realised_rate"
+	AttributeKeyRemainingBalance = "remaining_balance"
+	AttributeKeySponsor          = "sponsor"
+	AttributeKeyFeePayer         = "fee_payer"
+)
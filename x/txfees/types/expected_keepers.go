@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -13,6 +15,13 @@ type SpotPriceCalculator interface {
 	CalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (sdk.Dec, error)
 }
 
+// TwapKeeper defines the contract needed for TWAP related APIs.
+// The x/twap keeper is expected to satisfy this interface.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+	GetGeometricTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
 // PoolManager defines the contract needed for swap related APIs.
 type PoolManager interface {
 	RouteExactAmountIn(
@@ -30,6 +39,8 @@ type PoolManager interface {
 		tokenOutDenom string,
 		tokenOutMinAmount sdk.Int,
 	) (sdk.Int, error)
+
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
 
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
@@ -44,6 +55,17 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// ContractKeeper defines the contract needed to call fee sponsor contracts.
+// The wasm permissioned keeper is expected to satisfy this interface.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// WasmKeeper defines the contract needed to check that fee sponsors are contracts.
+type WasmKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
+
 // BankKeeper defines the contract needed for supply related APIs (noalias)
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApproveFeeSponsorshipSudoMsg is the sudo msg a fee sponsor contract is called with to approve paying the fee of a tx.
+// The contract approves it by returning no error.
+type ApproveFeeSponsorshipSudoMsg struct {
+	ApproveFeeSponsorship ApproveFeeSponsorshipMsg `json:"approve_fee_sponsorship"`
+}
+
+type ApproveFeeSponsorshipMsg struct {
+	FeePayer string             `json:"fee_payer"`
+	Fee      []wasmvmtypes.Coin `json:"fee"`
+	Msgs     []SponsoredMsgInfo `json:"msgs"`
+}
+
+// SponsoredMsgInfo describes a msg of a tx to sponsor, with the contract it executes, if any.
+type SponsoredMsgInfo struct {
+	TypeURL  string `json:"type_url"`
+	Contract string `json:"contract,omitempty"`
+}
+
+// NewSponsoredMsgInfo returns the description of the msg sent to fee sponsors.
+func NewSponsoredMsgInfo(msg sdk.Msg) SponsoredMsgInfo {
+	info := SponsoredMsgInfo{TypeURL: sdk.MsgTypeURL(msg)}
+	if executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		info.Contract = executeMsg.Contract
+	}
+	return info
+}
+
+// Sponsors returns true if the fee sponsor may sponsor a tx with the msg,
+// i.e. if the msg is of one of its msg type urls, or executes one of its target contracts.
+func (s FeeSponsor) Sponsors(msg SponsoredMsgInfo) bool {
+	for _, typeURL := range s.MsgTypeUrls {
+		if msg.TypeURL == typeURL {
+			return true
+		}
+	}
+	if msg.Contract == "" {
+		return false
+	}
+	for _, contract := range s.TargetContracts {
+		if msg.Contract == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the fee sponsor has an invalid address, or sponsors no msgs.
+func (s FeeSponsor) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+		return fmt.Errorf("invalid fee sponsor address %s: %w", s.ContractAddress, err)
+	}
+	if len(s.MsgTypeUrls) == 0 && len(s.TargetContracts) == 0 {
+		return fmt.Errorf("fee sponsor %s must have msg type urls or target contracts", s.ContractAddress)
+	}
+	for _, typeURL := range s.MsgTypeUrls {
+		if typeURL == "" {
+			return fmt.Errorf("empty msg type url in fee sponsor %s", s.ContractAddress)
+		}
+	}
+	for _, contract := range s.TargetContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid target contract %s of fee sponsor %s: %w", contract, s.ContractAddress, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/txfees/v1beta1/fee_sponsor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeSponsor is a CosmWasm contract paying the fees of the txs naming it as
+// their fee granter, if it approves them.
+// A tx can only be sponsored if each of its msgs is of one of the sponsor's
+// msg_type_urls, or executes one of its target_contracts.
+type FeeSponsor struct {
+	ContractAddress string   `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	MsgTypeUrls     []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	TargetContracts []string `protobuf:"bytes,3,rep,name=target_contracts,json=targetContracts,proto3" json:"target_contracts,omitempty" yaml:"target_contracts"`
+}
+
+func (m *FeeSponsor) Reset()         { *m = FeeSponsor{} }
+func (m *FeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsor) ProtoMessage()    {}
+func (*FeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c0dbfbce0067e59, []int{0}
+}
+func (m *FeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsor.Merge(m, src)
+}
+func (m *FeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsor proto.InternalMessageInfo
+
+func (m *FeeSponsor) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FeeSponsor) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *FeeSponsor) GetTargetContracts() []string {
+	if m != nil {
+		return m.TargetContracts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeSponsor)(nil), "osmosis.txfees.v1beta1.FeeSponsor")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/fee_sponsor.proto", fileDescriptor_2c0dbfbce0067e59)
+}
+
+var fileDescriptor_2c0dbfbce0067e59 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x2f, 0xce, 0xcd,
+	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
+	0x49, 0x34, 0xd4, 0x4f, 0x4b, 0x4d, 0x8d, 0x2f, 0x2e, 0xc8, 0xcf, 0x2b, 0xce, 0x2f, 0xd2, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0xaa, 0xd4, 0x83, 0xa8, 0xd4, 0x83, 0xaa, 0x94, 0x12,
+	0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0x95, 0x6e, 0x31, 0x72, 0x71,
+	0xb9, 0xa5, 0xa6, 0x06, 0x43, 0x8c, 0x10, 0x72, 0xe3, 0x12, 0x48, 0xce, 0xcf, 0x2b, 0x29, 0x4a,
+	0x4c, 0x2e, 0x89, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0,
+	0x74, 0x92, 0xfe, 0x74, 0x4f, 0x5e, 0xbc, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x5d, 0x85, 0x52,
+	0x10, 0x3f, 0x4c, 0xc8, 0x11, 0x22, 0x22, 0x64, 0xc3, 0xc5, 0x9b, 0x5b, 0x9c, 0x1e, 0x5f, 0x52,
+	0x59, 0x90, 0x1a, 0x5f, 0x5a, 0x94, 0x53, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0xe9, 0x24, 0xf1,
+	0xe9, 0x9e, 0xbc, 0x08, 0xc4, 0x10, 0x14, 0x69, 0xa5, 0x20, 0xee, 0xdc, 0xe2, 0xf4, 0x90, 0xca,
+	0x82, 0xd4, 0xd0, 0xa2, 0x9c, 0x62, 0x90, 0x2b, 0x4a, 0x12, 0x8b, 0xd2, 0x53, 0x4b, 0xe2, 0x61,
+	0xe6, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0xa3, 0xba, 0x02, 0x5d, 0x85, 0x52, 0x10, 0x3f, 0x44, 0xc8,
+	0x19, 0x26, 0xe2, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46,
+	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0xf0, 0xd2, 0xcd, 0x49,
+	0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x0c, 0xcd, 0xf4, 0x2b, 0x60, 0x81, 0x0d, 0x72, 0x67, 0x71,
+	0x12, 0x1b, 0x38, 0xc4, 0x8c, 0x01, 0x03, 0x00, 0x52, 0x9d, 0x84, 0x64, 0x8b, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetContracts) > 0 {
+		for iNdEx := len(m.TargetContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetContracts[iNdEx])
+			copy(dAtA[i:], m.TargetContracts[iNdEx])
+			i = encodeVarintFeeSponsor(dAtA, i, uint64(len(m.TargetContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintFeeSponsor(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeeSponsor(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeSponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeSponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeSponsor(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovFeeSponsor(uint64(l))
+		}
+	}
+	if len(m.TargetContracts) > 0 {
+		for _, s := range m.TargetContracts {
+			l = len(s)
+			n += 1 + l + sovFeeSponsor(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeSponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeSponsor(x uint64) (n int) {
+	return sovFeeSponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetContracts = append(m.TargetContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeSponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeSponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeSponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeSponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeSponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeSponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeSponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeSponsor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,12 +1,20 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
+		Params:    DefaultParams(),
+		BaseFee:   DefaultParams().MinBaseFee,
+
+		FeeSponsors: []FeeSponsor{},
 	}
 }
 
@@ -26,5 +34,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBaseFee(gs.BaseFee); err != nil {
+		return err
+	}
+	if !gs.Params.ClampBaseFee(gs.BaseFee).Equal(gs.BaseFee) {
+		return fmt.Errorf("base fee %s must be within the min base fee %s and the max base fee %s",
+			gs.BaseFee, gs.Params.MinBaseFee, gs.Params.MaxBaseFee)
+	}
+
+	seenFeeSponsors := make(map[string]struct{}, len(gs.FeeSponsors))
+	for _, feeSponsor := range gs.FeeSponsors {
+		if err := feeSponsor.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenFeeSponsors[feeSponsor.ContractAddress]; ok {
+			return fmt.Errorf("duplicate fee sponsor %s", feeSponsor.ContractAddress)
+		}
+		seenFeeSponsors[feeSponsor.ContractAddress] = struct{}{}
+	}
+
 	return nil
 }
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types1 "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,17 +29,315 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params holds parameters for the txfees module
+type Params struct {
+	// min_base_fee and max_base_fee bound the consensus base fee, in base denom
+	// per gas.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee" yaml:"max_base_fee"`
+	// target_block_gas is the gas used per block the base fee is adjusted
+	// towards. The base fee increases after blocks using more gas, and decreases
+	// after blocks using less gas.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// max_base_fee_change_rate is the maximum relative change of the base fee
+	// from a block to the next, reached when a block uses twice the target gas
+	// or none.
+	MaxBaseFeeChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_base_fee_change_rate,json=maxBaseFeeChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee_change_rate" yaml:"max_base_fee_change_rate"`
+	// conversion_twap_window is the length of the window of the geometric TWAP
+	// used to convert fee tokens to the base denom, ending at the current block.
+	// The spot price is used instead when the fee token's pool has no TWAP
+	// history over the whole window, or if the window is zero.
+	ConversionTwapWindow time.Duration `protobuf:"bytes,5,opt,name=conversion_twap_window,json=conversionTwapWindow,proto3,stdduration" json:"conversion_twap_window" yaml:"conversion_twap_window"`
+	// liquidation_twap_window is the length of the window of the arithmetic TWAPs
+	// the minimum outputs of fee token liquidation swaps are derived from.
+	LiquidationTwapWindow time.Duration `protobuf:"bytes,6,opt,name=liquidation_twap_window,json=liquidationTwapWindow,proto3,stdduration" json:"liquidation_twap_window" yaml:"liquidation_twap_window"`
+	// max_liquidation_slippage is the maximum relative shortfall of the output of
+	// a fee token liquidation swap from its TWAP value.
+	MaxLiquidationSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_liquidation_slippage,json=maxLiquidationSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_liquidation_slippage" yaml:"max_liquidation_slippage"`
+	// max_liquidation_pool_fraction is the maximum fraction of the liquidity of
+	// the token in of any pool of its route a fee token liquidation swap can put
+	// in. Larger balances are liquidated in chunks over several blocks. Zero
+	// disables chunking.
+	MaxLiquidationPoolFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_liquidation_pool_fraction,json=maxLiquidationPoolFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_liquidation_pool_fraction" yaml:"max_liquidation_pool_fraction"`
+	// liquidation_routes are the routes fee tokens are liquidated through, for
+	// fee tokens better liquidated through several pools than their fee token
+	// pool.
+	LiquidationRoutes []LiquidationRoute `protobuf:"bytes,9,rep,name=liquidation_routes,json=liquidationRoutes,proto3" json:"liquidation_routes" yaml:"liquidation_routes"`
+	// mempool_filters are the filters txs entering the mempool are matched
+	// against, each raising the min gas price of the txs it matches.
+	MempoolFilters []MempoolFilter `protobuf:"bytes,10,rep,name=mempool_filters,json=mempoolFilters,proto3" json:"mempool_filters" yaml:"mempool_filters"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetConversionTwapWindow() time.Duration {
+	if m != nil {
+		return m.ConversionTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidationTwapWindow() time.Duration {
+	if m != nil {
+		return m.LiquidationTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidationRoutes() []LiquidationRoute {
+	if m != nil {
+		return m.LiquidationRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetMempoolFilters() []MempoolFilter {
+	if m != nil {
+		return m.MempoolFilters
+	}
+	return nil
+}
+
+// LiquidationRoute is the route a fee token is swapped to the base denom
+// through at epoch end, instead of its fee token pool.
+type LiquidationRoute struct {
+	Denom  string                     `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Routes []types1.SwapAmountInRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *LiquidationRoute) Reset()         { *m = LiquidationRoute{} }
+func (m *LiquidationRoute) String() string { return proto.CompactTextString(m) }
+func (*LiquidationRoute) ProtoMessage()    {}
+func (*LiquidationRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{1}
+}
+func (m *LiquidationRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationRoute.Merge(m, src)
+}
+func (m *LiquidationRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationRoute proto.InternalMessageInfo
+
+func (m *LiquidationRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LiquidationRoute) GetRoutes() []types1.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// MempoolFilter is a named filter of txs entering the mempool, whose min gas
+// price is multiplied by min_gas_price_multiplier if they match it.
+// A filter named after a filter built into the node, e.g. "arbitrage", matches
+// the txs the built-in filter matches, and has no msg_type_urls or
+// swap_route_shape. Any other filter matches the txs with a msg of one of its
+// msg_type_urls, or with a swap route of its swap_route_shape.
+type MempoolFilter struct {
+	Name                  string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	MinGasPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_gas_price_multiplier,json=minGasPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price_multiplier" yaml:"min_gas_price_multiplier"`
+	MsgTypeUrls           []string                               `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty" yaml:"msg_type_urls"`
+	SwapRouteShape        *SwapRouteShape                        `protobuf:"bytes,4,opt,name=swap_route_shape,json=swapRouteShape,proto3" json:"swap_route_shape,omitempty" yaml:"swap_route_shape"`
+}
+
+func (m *MempoolFilter) Reset()         { *m = MempoolFilter{} }
+func (m *MempoolFilter) String() string { return proto.CompactTextString(m) }
+func (*MempoolFilter) ProtoMessage()    {}
+func (*MempoolFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{2}
+}
+func (m *MempoolFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolFilter.Merge(m, src)
+}
+func (m *MempoolFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolFilter proto.InternalMessageInfo
+
+func (m *MempoolFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MempoolFilter) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MempoolFilter) GetSwapRouteShape() *SwapRouteShape {
+	if m != nil {
+		return m.SwapRouteShape
+	}
+	return nil
+}
+
+// SwapRouteShape is the shape of the routes of swap msgs a mempool filter
+// matches. All of its set conditions must hold for a route to match.
+type SwapRouteShape struct {
+	// min_hops is the minimum number of pools of a matching route.
+	MinHops uint64 `protobuf:"varint,1,opt,name=min_hops,json=minHops,proto3" json:"min_hops,omitempty" yaml:"min_hops"`
+	// cyclic requires a matching route to end in the denom it starts in.
+	Cyclic bool `protobuf:"varint,2,opt,name=cyclic,proto3" json:"cyclic,omitempty" yaml:"cyclic"`
+	// pool_ids, if not empty, requires a matching route to go through one of
+	// these pools.
+	PoolIds []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *SwapRouteShape) Reset()         { *m = SwapRouteShape{} }
+func (m *SwapRouteShape) String() string { return proto.CompactTextString(m) }
+func (*SwapRouteShape) ProtoMessage()    {}
+func (*SwapRouteShape) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{3}
+}
+func (m *SwapRouteShape) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRouteShape) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRouteShape.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRouteShape) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRouteShape.Merge(m, src)
+}
+func (m *SwapRouteShape) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRouteShape) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRouteShape.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRouteShape proto.InternalMessageInfo
+
+func (m *SwapRouteShape) GetMinHops() uint64 {
+	if m != nil {
+		return m.MinHops
+	}
+	return 0
+}
+
+func (m *SwapRouteShape) GetCyclic() bool {
+	if m != nil {
+		return m.Cyclic
+	}
+	return false
+}
+
+func (m *SwapRouteShape) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
 // GenesisState defines the txfees module's genesis state.
 type GenesisState struct {
 	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Params    Params     `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// base_fee is the current consensus base fee, in base denom per gas.
+	BaseFee     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	FeeSponsors []FeeSponsor                           `protobuf:"bytes,5,rep,name=fee_sponsors,json=feeSponsors,proto3" json:"fee_sponsors" yaml:"fee_sponsors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4423c18e3d020b37, []int{0}
+	return fileDescriptor_4423c18e3d020b37, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +380,25 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFeeSponsors() []FeeSponsor {
+	if m != nil {
+		return m.FeeSponsors
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
+	proto.RegisterType((*LiquidationRoute)(nil), "osmosis.txfees.v1beta1.LiquidationRoute")
+	proto.RegisterType((*MempoolFilter)(nil), "osmosis.txfees.v1beta1.MempoolFilter")
+	proto.RegisterType((*SwapRouteShape)(nil), "osmosis.txfees.v1beta1.SwapRouteShape")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
 
@@ -85,25 +407,76 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0, 0x4c,
-	0xbf, 0x02, 0xe6, 0xa5, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x47, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xc5, 0x01, 0x1e, 0x7d, 0x45, 0x01, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xfd, 0x23, 0x5b, 0x2b, 0xff, 0x85, 0xb1, 0x1d, 0xc6, 0x69, 0x44, 0x77, 0x9b, 0x18,
+	0x4a, 0xd1, 0x50, 0xb0, 0x0b, 0xf4, 0x50, 0xe4, 0x12, 0xd6, 0xb1, 0x1b, 0x20, 0x01, 0xdc, 0xb5,
+	0xdb, 0x02, 0x45, 0x0b, 0x62, 0x45, 0xad, 0x68, 0xc2, 0x5c, 0x2e, 0xcb, 0xa5, 0x2c, 0x39, 0x40,
+	0x1f, 0xa0, 0xed, 0xa5, 0xc7, 0x06, 0x7d, 0x97, 0x9e, 0x73, 0xcc, 0xb1, 0xe8, 0x41, 0x2d, 0xec,
+	0x7b, 0x0f, 0x7a, 0x82, 0x62, 0x7f, 0x28, 0x51, 0x8a, 0xd5, 0xc2, 0xf0, 0xc9, 0xe6, 0xcc, 0x37,
+	0xdf, 0x37, 0x3b, 0x3b, 0x9a, 0x59, 0xf0, 0x80, 0x71, 0xca, 0x78, 0xc8, 0xeb, 0x59, 0xb7, 0x45,
+	0x08, 0xaf, 0x9f, 0xed, 0x34, 0x48, 0x86, 0x77, 0xea, 0x01, 0x89, 0x09, 0x0f, 0xb9, 0x93, 0xa4,
+	0x2c, 0x63, 0xe6, 0x86, 0x46, 0x39, 0x0a, 0xe5, 0x68, 0xd4, 0xe6, 0x5a, 0xc0, 0x02, 0x26, 0x21,
+	0x75, 0xf1, 0x9f, 0x42, 0x6f, 0x56, 0x03, 0xc6, 0x82, 0x88, 0xd4, 0xe5, 0x57, 0xa3, 0xdd, 0xaa,
+	0x37, 0xdb, 0x29, 0xce, 0x42, 0x16, 0x6b, 0xff, 0x47, 0xb9, 0x66, 0xc2, 0x58, 0x44, 0x71, 0x8c,
+	0x03, 0x92, 0x0e, 0x84, 0x79, 0x07, 0x27, 0x5e, 0xca, 0xda, 0x19, 0xd1, 0xe8, 0x87, 0x13, 0x32,
+	0x6c, 0x11, 0x92, 0xb1, 0x53, 0x92, 0x93, 0xd6, 0x26, 0xc3, 0x3c, 0x9e, 0xb0, 0x98, 0xb3, 0x54,
+	0x21, 0xe1, 0xef, 0x65, 0x50, 0x3a, 0xc4, 0x29, 0xa6, 0xdc, 0x0c, 0xc0, 0x22, 0x0d, 0x63, 0xaf,
+	0x81, 0x39, 0xf1, 0x5a, 0x84, 0x58, 0xc6, 0x96, 0x51, 0x2b, 0xbb, 0xcf, 0xde, 0xf4, 0xec, 0xa9,
+	0x3f, 0x7b, 0xf6, 0x76, 0x10, 0x66, 0x27, 0xed, 0x86, 0xe3, 0x33, 0x5a, 0xf7, 0x25, 0xbd, 0xfe,
+	0xf3, 0x98, 0x37, 0x4f, 0xeb, 0xd9, 0x79, 0x42, 0xb8, 0xb3, 0x47, 0xfc, 0x7e, 0xcf, 0xbe, 0x7d,
+	0x8e, 0x69, 0xf4, 0x29, 0x2c, 0x72, 0x41, 0x04, 0x68, 0x18, 0xbb, 0x98, 0x93, 0x7d, 0x42, 0xa4,
+	0x10, 0xee, 0x0e, 0x85, 0xa6, 0x6f, 0x28, 0x84, 0xbb, 0x23, 0x42, 0xb8, 0x9b, 0x0b, 0x3d, 0x03,
+	0xab, 0x19, 0x4e, 0x03, 0x92, 0x79, 0x8d, 0x88, 0xf9, 0xa7, 0x5e, 0x80, 0xb9, 0x35, 0xb3, 0x65,
+	0xd4, 0x66, 0xdd, 0x7b, 0xfd, 0x9e, 0x7d, 0x47, 0x85, 0x8f, 0x23, 0x20, 0x5a, 0x56, 0x26, 0x57,
+	0x58, 0x0e, 0x30, 0x37, 0x7f, 0x34, 0x80, 0x55, 0x14, 0xf1, 0xfc, 0x13, 0x1c, 0x07, 0xc4, 0x4b,
+	0x71, 0x46, 0xac, 0x59, 0x99, 0xfc, 0x17, 0xd7, 0x4e, 0xde, 0x7e, 0x37, 0xf9, 0x22, 0x2f, 0x44,
+	0x6b, 0xc3, 0x83, 0x7c, 0x26, 0xed, 0x08, 0x67, 0xc4, 0x7c, 0x05, 0x36, 0x7c, 0x16, 0x9f, 0x91,
+	0x94, 0x87, 0x2c, 0xf6, 0x32, 0xd1, 0x1f, 0x9d, 0x30, 0x6e, 0xb2, 0x8e, 0x35, 0xb7, 0x65, 0xd4,
+	0x2a, 0xbb, 0x77, 0x1d, 0xd5, 0x6f, 0x4e, 0xde, 0x6f, 0xce, 0x9e, 0xee, 0x37, 0xf7, 0x91, 0xc8,
+	0xb1, 0xdf, 0xb3, 0xef, 0x2b, 0xe5, 0xab, 0x69, 0xe0, 0xaf, 0x7f, 0xd9, 0x06, 0x5a, 0x1b, 0x3a,
+	0x8f, 0x3b, 0x38, 0xf9, 0x5a, 0xba, 0xcc, 0x1f, 0xc0, 0x9d, 0x28, 0xfc, 0xbe, 0x1d, 0x36, 0x71,
+	0x36, 0x16, 0x65, 0x95, 0xfe, 0x4f, 0xfc, 0x43, 0x2d, 0x5e, 0x55, 0xe2, 0x13, 0x78, 0x94, 0xfa,
+	0x7a, 0xc1, 0x5b, 0x90, 0xff, 0x59, 0x5f, 0x43, 0x31, 0x96, 0x47, 0x61, 0x92, 0xe0, 0x80, 0x58,
+	0xf3, 0x37, 0xbf, 0x86, 0xab, 0x78, 0x21, 0xda, 0xa0, 0xb8, 0xfb, 0x62, 0xe8, 0x39, 0xd2, 0x0e,
+	0xf3, 0xb5, 0x01, 0xee, 0x8f, 0x47, 0x89, 0x9f, 0xb0, 0xd7, 0x4a, 0xb1, 0x2f, 0xbe, 0xac, 0x05,
+	0x99, 0xd2, 0x57, 0xd7, 0x4e, 0xe9, 0xc1, 0xd5, 0x29, 0x8d, 0x90, 0x43, 0xb4, 0x39, 0x9a, 0xd7,
+	0x21, 0x63, 0xd1, 0xbe, 0x76, 0x9a, 0xaf, 0x80, 0x59, 0x8c, 0x94, 0x03, 0x84, 0x5b, 0xe5, 0xad,
+	0x99, 0x5a, 0x65, 0xb7, 0xe6, 0x5c, 0x3d, 0xbe, 0x9c, 0x02, 0x19, 0x12, 0x01, 0xee, 0xfb, 0xfa,
+	0xca, 0xee, 0xbe, 0x7b, 0x65, 0x8a, 0x11, 0xa2, 0x5b, 0xd1, 0x58, 0x10, 0x37, 0x63, 0xb0, 0x42,
+	0x09, 0x55, 0xc9, 0x86, 0x51, 0x46, 0x52, 0x6e, 0x01, 0x29, 0xfc, 0x70, 0x92, 0xf0, 0x4b, 0x05,
+	0xdf, 0x97, 0x68, 0xb7, 0xaa, 0x55, 0x37, 0x74, 0x15, 0x46, 0xb9, 0x20, 0x5a, 0xa6, 0x45, 0x38,
+	0x87, 0xaf, 0x0d, 0xb0, 0x3a, 0x9e, 0xba, 0xb9, 0x0d, 0xe6, 0x9a, 0x24, 0x66, 0x54, 0xcf, 0xb0,
+	0xd5, 0x7e, 0xcf, 0x5e, 0x54, 0x7c, 0xd2, 0x0c, 0x91, 0x72, 0x9b, 0xdf, 0x81, 0x92, 0x2e, 0xce,
+	0xb4, 0xcc, 0xd1, 0x19, 0xe4, 0x58, 0x98, 0xc6, 0x83, 0x44, 0x8f, 0x3a, 0x38, 0x79, 0x4a, 0x59,
+	0x3b, 0xce, 0x9e, 0xeb, 0x12, 0xad, 0xeb, 0x64, 0x97, 0x14, 0x79, 0x5e, 0x16, 0x4d, 0x0a, 0xff,
+	0x99, 0x06, 0x4b, 0x23, 0xa7, 0x33, 0x3f, 0x00, 0xb3, 0x31, 0xa6, 0xf9, 0x6c, 0x5d, 0xe9, 0xf7,
+	0xec, 0x8a, 0x0a, 0x15, 0x56, 0x88, 0xa4, 0xd3, 0xfc, 0x49, 0x34, 0x7a, 0x18, 0x8b, 0x61, 0xe4,
+	0x25, 0x69, 0xe8, 0x13, 0x8f, 0xb6, 0xa3, 0x2c, 0x4c, 0xa2, 0x90, 0xa4, 0xd6, 0xf4, 0x0d, 0x1b,
+	0x7d, 0x02, 0x2f, 0x44, 0xeb, 0x34, 0x8c, 0x0f, 0x30, 0x3f, 0x14, 0x8e, 0x97, 0x03, 0xbb, 0xf9,
+	0x04, 0x2c, 0x51, 0x1e, 0x78, 0x82, 0xca, 0x6b, 0xa7, 0x91, 0x18, 0xa0, 0x33, 0xb5, 0xb2, 0x6b,
+	0xf5, 0x7b, 0xf6, 0x9a, 0xa6, 0x2c, 0xba, 0x21, 0xaa, 0x50, 0x1e, 0x1c, 0x9f, 0x27, 0xe4, 0xcb,
+	0x34, 0xe2, 0x26, 0x05, 0xab, 0xc3, 0x1d, 0xe6, 0xf1, 0x13, 0x9c, 0xa8, 0x89, 0x59, 0xd9, 0xdd,
+	0x9e, 0xd4, 0x0e, 0xa2, 0xca, 0xb2, 0xba, 0x47, 0x02, 0x5d, 0x9c, 0xd4, 0xe3, 0x4c, 0x10, 0x2d,
+	0xf3, 0x11, 0x30, 0xfc, 0xcd, 0x00, 0xcb, 0xa3, 0xf1, 0xa6, 0x03, 0x16, 0xc4, 0x99, 0x4f, 0x58,
+	0xc2, 0x65, 0xd5, 0x67, 0xdd, 0xdb, 0xfd, 0x9e, 0xbd, 0x32, 0xac, 0x86, 0xf0, 0x40, 0x34, 0x4f,
+	0xc3, 0xf8, 0x73, 0x96, 0x70, 0xf3, 0x11, 0x28, 0xf9, 0xe7, 0x7e, 0x14, 0xfa, 0xb2, 0xd2, 0x0b,
+	0xee, 0xad, 0xe1, 0xf5, 0x2a, 0x3b, 0x44, 0x1a, 0x20, 0xa8, 0x65, 0x6f, 0x86, 0x4d, 0x55, 0x95,
+	0x11, 0xea, 0xdc, 0x03, 0xd1, 0xbc, 0xf8, 0xf7, 0x79, 0x93, 0xc3, 0xfe, 0x34, 0x58, 0x3c, 0x50,
+	0x4f, 0x89, 0xa3, 0x4c, 0x0c, 0xf3, 0xf7, 0x40, 0x59, 0xcc, 0xfe, 0x42, 0xab, 0xa2, 0xa1, 0xc1,
+	0xdc, 0x03, 0xe5, 0x7c, 0xad, 0xe7, 0xfd, 0xb9, 0x35, 0xa9, 0x68, 0xfb, 0x84, 0x1c, 0x0b, 0xa0,
+	0x3b, 0x2b, 0x1a, 0x03, 0x0d, 0x03, 0xcd, 0x27, 0xa0, 0x94, 0xc8, 0xfd, 0x2e, 0x37, 0x5f, 0x65,
+	0xb7, 0x3a, 0x89, 0x42, 0xbd, 0x02, 0x34, 0x81, 0x8e, 0x31, 0xbf, 0x05, 0x0b, 0x83, 0x35, 0xad,
+	0x36, 0xdd, 0xd3, 0x6b, 0x77, 0x9e, 0x2e, 0xc8, 0x70, 0x45, 0xcf, 0x37, 0xf4, 0x7e, 0x6e, 0x80,
+	0xc5, 0xc2, 0x8b, 0x84, 0x5b, 0x73, 0xf2, 0x90, 0xf0, 0x3f, 0x0e, 0x79, 0xa4, 0xa0, 0xee, 0x3d,
+	0xfd, 0xc3, 0xd3, 0x4f, 0x80, 0x22, 0x0b, 0x44, 0x95, 0xd6, 0x00, 0xc8, 0xdd, 0x17, 0x6f, 0x2e,
+	0xaa, 0xc6, 0xdb, 0x8b, 0xaa, 0xf1, 0xf7, 0x45, 0xd5, 0xf8, 0xe5, 0xb2, 0x3a, 0xf5, 0xf6, 0xb2,
+	0x3a, 0xf5, 0xc7, 0x65, 0x75, 0xea, 0x9b, 0xdd, 0xc2, 0x09, 0xb4, 0xe2, 0xe3, 0x08, 0x37, 0x78,
+	0xfe, 0x51, 0x3f, 0xdb, 0xf9, 0xa4, 0xde, 0xcd, 0x9f, 0x50, 0xf2, 0x44, 0x8d, 0x92, 0xdc, 0x6c,
+	0x1f, 0xff, 0x3b, 0x00, 0x87, 0xd7, 0x7c, 0xa9, 0x2a, 0x0a, 0x00, 0x00,
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -113,20 +486,20 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Feetokens) > 0 {
-		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MempoolFilters) > 0 {
+		for iNdEx := len(m.MempoolFilters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Feetokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MempoolFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -134,60 +507,1329 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Basedenom) > 0 {
-		i -= len(m.Basedenom)
-		copy(dAtA[i:], m.Basedenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Basedenom)))
+	if len(m.LiquidationRoutes) > 0 {
+		for iNdEx := len(m.LiquidationRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.MaxLiquidationPoolFraction.Size()
+		i -= size
+		if _, err := m.MaxLiquidationPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxLiquidationSlippage.Size()
+		i -= size
+		if _, err := m.MaxLiquidationSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LiquidationTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ConversionTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConversionTwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxBaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LiquidationRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LiquidationRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Basedenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Feetokens) > 0 {
-		for _, e := range m.Feetokens {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MempoolFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MempoolFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *MempoolFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapRouteShape != nil {
+		{
+			size, err := m.SwapRouteShape.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MinGasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.MinGasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRouteShape) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRouteShape) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRouteShape) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA5 := make([]byte, len(m.PoolIds)*10)
+		var j4 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Cyclic {
+		i--
+		if m.Cyclic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinHops))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSponsors) > 0 {
+		for iNdEx := len(m.FeeSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Feetokens) > 0 {
+		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feetokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Basedenom) > 0 {
+		i -= len(m.Basedenom)
+		copy(dAtA[i:], m.Basedenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Basedenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxBaseFeeChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConversionTwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationTwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxLiquidationSlippage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxLiquidationPoolFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LiquidationRoutes) > 0 {
+		for _, e := range m.LiquidationRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MempoolFilters) > 0 {
+		for _, e := range m.MempoolFilters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidationRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MempoolFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MinGasPriceMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SwapRouteShape != nil {
+		l = m.SwapRouteShape.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapRouteShape) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MinHops))
+	}
+	if m.Cyclic {
+		n += 2
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Basedenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Feetokens) > 0 {
+		for _, e := range m.Feetokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeSponsors) > 0 {
+		for _, e := range m.FeeSponsors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ConversionTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LiquidationTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLiquidationSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLiquidationPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationRoutes = append(m.LiquidationRoutes, LiquidationRoute{})
+			if err := m.LiquidationRoutes[len(m.LiquidationRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MempoolFilters = append(m.MempoolFilters, MempoolFilter{})
+			if err := m.MempoolFilters[len(m.MempoolFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types1.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRouteShape", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapRouteShape == nil {
+				m.SwapRouteShape = &SwapRouteShape{}
+			}
+			if err := m.SwapRouteShape.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRouteShape) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRouteShape: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRouteShape: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHops", wireType)
+			}
+			m.MinHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cyclic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cyclic = bool(v != 0)
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -278,6 +1920,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsors = append(m.FeeSponsors, FeeSponsor{})
+			if err := m.FeeSponsors[len(m.FeeSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,4 +23,18 @@ const (
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
+	BaseFeeKey           = []byte("base_fee")
+
+	// LiquidationPendingPrefix prefixes the denoms of the fee tokens whose liquidation
+	// is spread over several blocks and continues in the next block.
+	LiquidationPendingPrefix = []byte("liquidation_pending")
+
+	// MempoolFilterCountersPrefix prefixes the number of delivered txs matched by each mempool filter, by name.
+	MempoolFilterCountersPrefix = []byte("mempool_filter_counters")
+
+	// RecentBlockGasPrefix prefixes the gas used by each of the recent blocks, by height.
+	RecentBlockGasPrefix = []byte("recent_block_gas")
+
+	// FeeSponsorsPrefix prefixes the fee sponsors, by contract address.
+	FeeSponsorsPrefix = []byte("fee_sponsors")
 )
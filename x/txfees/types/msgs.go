@@ -0,0 +1,72 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterFeeSponsor{}
+	_ sdk.Msg = &MsgUnregisterFeeSponsor{}
+)
+
+// NewMsgRegisterFeeSponsor creates a message registering the sender contract as a fee sponsor.
+func NewMsgRegisterFeeSponsor(sender string, msgTypeUrls, targetContracts []string) *MsgRegisterFeeSponsor {
+	return &MsgRegisterFeeSponsor{
+		Sender:          sender,
+		MsgTypeUrls:     msgTypeUrls,
+		TargetContracts: targetContracts,
+	}
+}
+
+func (m MsgRegisterFeeSponsor) Route() string { return RouterKey }
+func (m MsgRegisterFeeSponsor) Type() string  { return TypeMsgRegisterFeeSponsor }
+func (m MsgRegisterFeeSponsor) ValidateBasic() error {
+	if err := m.FeeSponsor().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeSponsor, err.Error())
+	}
+	return nil
+}
+
+func (m MsgRegisterFeeSponsor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterFeeSponsor) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// FeeSponsor returns the fee sponsor the msg registers.
+func (m MsgRegisterFeeSponsor) FeeSponsor() FeeSponsor {
+	return FeeSponsor{
+		ContractAddress: m.Sender,
+		MsgTypeUrls:     m.MsgTypeUrls,
+		TargetContracts: m.TargetContracts,
+	}
+}
+
+// NewMsgUnregisterFeeSponsor creates a message unregistering the sender contract as a fee sponsor.
+func NewMsgUnregisterFeeSponsor(sender string) *MsgUnregisterFeeSponsor {
+	return &MsgUnregisterFeeSponsor{Sender: sender}
+}
+
+func (m MsgUnregisterFeeSponsor) Route() string { return RouterKey }
+func (m MsgUnregisterFeeSponsor) Type() string  { return TypeMsgUnregisterFeeSponsor }
+func (m MsgUnregisterFeeSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgUnregisterFeeSponsor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUnregisterFeeSponsor) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
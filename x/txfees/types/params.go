@@ -0,0 +1,305 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v16/x/poolmanager/types"
+)
+
+// Parameter store keys.
+var (
+	KeyMinBaseFee                 = []byte("MinBaseFee")
+	KeyMaxBaseFee                 = []byte("MaxBaseFee")
+	KeyTargetBlockGas             = []byte("TargetBlockGas")
+	KeyMaxBaseFeeChangeRate       = []byte("MaxBaseFeeChangeRate")
+	KeyConversionTwapWindow       = []byte("ConversionTwapWindow")
+	KeyLiquidationTwapWindow      = []byte("LiquidationTwapWindow")
+	KeyMaxLiquidationSlippage     = []byte("MaxLiquidationSlippage")
+	KeyMaxLiquidationPoolFraction = []byte("MaxLiquidationPoolFraction")
+	KeyLiquidationRoutes          = []byte("LiquidationRoutes")
+	KeyMempoolFilters             = []byte("MempoolFilters")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+var (
+	defaultMaxBaseFee                 = sdk.NewDec(5)
+	defaultTargetBlockGas             = uint64(75_000_000)
+	defaultMaxBaseFeeChangeRate       = sdk.NewDecWithPrec(1, 1)
+	defaultConversionTwapWindow       = 5 * time.Minute
+	defaultLiquidationTwapWindow      = time.Hour
+	defaultMaxLiquidationSlippage     = sdk.NewDecWithPrec(5, 2)
+	defaultMaxLiquidationPoolFraction = sdk.NewDecWithPrec(2, 2)
+)
+
+// ParamKeyTable for txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(minBaseFee, maxBaseFee sdk.Dec, targetBlockGas uint64, maxBaseFeeChangeRate sdk.Dec, conversionTwapWindow time.Duration,
+	liquidationTwapWindow time.Duration, maxLiquidationSlippage, maxLiquidationPoolFraction sdk.Dec, liquidationRoutes []LiquidationRoute,
+	mempoolFilters []MempoolFilter,
+) Params {
+	return Params{
+		MinBaseFee:                 minBaseFee,
+		MaxBaseFee:                 maxBaseFee,
+		TargetBlockGas:             targetBlockGas,
+		MaxBaseFeeChangeRate:       maxBaseFeeChangeRate,
+		ConversionTwapWindow:       conversionTwapWindow,
+		LiquidationTwapWindow:      liquidationTwapWindow,
+		MaxLiquidationSlippage:     maxLiquidationSlippage,
+		MaxLiquidationPoolFraction: maxLiquidationPoolFraction,
+		LiquidationRoutes:          liquidationRoutes,
+		MempoolFilters:             mempoolFilters,
+	}
+}
+
+// DefaultParams returns the default txfees module parameters.
+// The minimum base fee is the consensus min fee, which the base fee is thus never below by default.
+func DefaultParams() Params {
+	return Params{
+		MinBaseFee:           ConsensusMinFee.Clone(),
+		MaxBaseFee:           defaultMaxBaseFee.Clone(),
+		TargetBlockGas:       defaultTargetBlockGas,
+		MaxBaseFeeChangeRate: defaultMaxBaseFeeChangeRate.Clone(),
+		ConversionTwapWindow: defaultConversionTwapWindow,
+
+		LiquidationTwapWindow:      defaultLiquidationTwapWindow,
+		MaxLiquidationSlippage:     defaultMaxLiquidationSlippage.Clone(),
+		MaxLiquidationPoolFraction: defaultMaxLiquidationPoolFraction.Clone(),
+
+		MempoolFilters: []MempoolFilter{
+			{Name: ArbitrageMempoolFilterName, MinGasPriceMultiplier: sdk.OneDec()},
+		},
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+	if p.MinBaseFee.GT(p.MaxBaseFee) {
+		return fmt.Errorf("min base fee %s must not be greater than max base fee %s", p.MinBaseFee, p.MaxBaseFee)
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateMaxBaseFeeChangeRate(p.MaxBaseFeeChangeRate); err != nil {
+		return err
+	}
+	if err := validateConversionTwapWindow(p.ConversionTwapWindow); err != nil {
+		return err
+	}
+	if err := validateLiquidationTwapWindow(p.LiquidationTwapWindow); err != nil {
+		return err
+	}
+	if err := validateMaxLiquidationSlippage(p.MaxLiquidationSlippage); err != nil {
+		return err
+	}
+	if err := validateMaxLiquidationPoolFraction(p.MaxLiquidationPoolFraction); err != nil {
+		return err
+	}
+	if err := validateLiquidationRoutes(p.LiquidationRoutes); err != nil {
+		return err
+	}
+	if err := validateMempoolFilters(p.MempoolFilters); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetLiquidationRoute returns the liquidation route of the given fee token denom, if any.
+func (p Params) GetLiquidationRoute(denom string) ([]poolmanagertypes.SwapAmountInRoute, bool) {
+	for _, liquidationRoute := range p.LiquidationRoutes {
+		if liquidationRoute.Denom == denom {
+			return liquidationRoute.Routes, true
+		}
+	}
+	return nil, false
+}
+
+// ClampBaseFee returns the base fee bounded by the min and max base fee.
+func (p Params) ClampBaseFee(baseFee sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.MaxDec(baseFee, p.MinBaseFee), p.MaxBaseFee)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateBaseFee),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyMaxBaseFeeChangeRate, &p.MaxBaseFeeChangeRate, validateMaxBaseFeeChangeRate),
+		paramtypes.NewParamSetPair(KeyConversionTwapWindow, &p.ConversionTwapWindow, validateConversionTwapWindow),
+		paramtypes.NewParamSetPair(KeyLiquidationTwapWindow, &p.LiquidationTwapWindow, validateLiquidationTwapWindow),
+		paramtypes.NewParamSetPair(KeyMaxLiquidationSlippage, &p.MaxLiquidationSlippage, validateMaxLiquidationSlippage),
+		paramtypes.NewParamSetPair(KeyMaxLiquidationPoolFraction, &p.MaxLiquidationPoolFraction, validateMaxLiquidationPoolFraction),
+		paramtypes.NewParamSetPair(KeyLiquidationRoutes, &p.LiquidationRoutes, validateLiquidationRoutes),
+		paramtypes.NewParamSetPair(KeyMempoolFilters, &p.MempoolFilters, validateMempoolFilters),
+	}
+}
+
+func validateBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative, got %s", v)
+	}
+	return nil
+}
+
+// validateMinBaseFee requires the min base fee to be non-negative. With a zero min base fee, the base fee
+// decays to zero while blocks use less than the target gas, and increases from BaseFeeGrowthFloor otherwise.
+func validateMinBaseFee(i interface{}) error {
+	return validateBaseFee(i)
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return errors.New("target block gas must be positive")
+	}
+	return nil
+}
+
+func validateMaxBaseFeeChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max base fee change rate must be in [0, 1), got %s", v)
+	}
+	return nil
+}
+
+// validateConversionTwapWindow requires the conversion TWAP window to be non-negative.
+// A zero window disables TWAP based fee conversion in favor of the spot price.
+func validateConversionTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("conversion twap window must be non-negative, got %s", v)
+	}
+	return nil
+}
+
+// validateLiquidationTwapWindow requires the liquidation TWAP window to be positive,
+// as liquidation swaps are never bounded by the spot price.
+func validateLiquidationTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("liquidation twap window must be positive, got %s", v)
+	}
+	return nil
+}
+
+func validateMaxLiquidationSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max liquidation slippage must be in [0, 1), got %s", v)
+	}
+	return nil
+}
+
+func validateMaxLiquidationPoolFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max liquidation pool fraction must be in [0, 1], got %s", v)
+	}
+	return nil
+}
+
+// validateLiquidationRoutes requires at most one non-empty route per fee token denom.
+// Whether a route ends in the base denom is checked when it is used, as the base denom is not a param.
+func validateLiquidationRoutes(i interface{}) error {
+	v, ok := i.([]LiquidationRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]struct{}, len(v))
+	for _, liquidationRoute := range v {
+		if err := sdk.ValidateDenom(liquidationRoute.Denom); err != nil {
+			return err
+		}
+		if _, ok := seenDenoms[liquidationRoute.Denom]; ok {
+			return fmt.Errorf("duplicate liquidation route for denom %s", liquidationRoute.Denom)
+		}
+		seenDenoms[liquidationRoute.Denom] = struct{}{}
+
+		if len(liquidationRoute.Routes) == 0 {
+			return fmt.Errorf("empty liquidation route for denom %s", liquidationRoute.Denom)
+		}
+		for _, route := range liquidationRoute.Routes {
+			if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateMempoolFilters requires the mempool filters to have unique names and multipliers of at least one.
+// A filter named after a built-in filter must not have matching conditions, and any other filter must have some.
+func validateMempoolFilters(i interface{}) error {
+	v, ok := i.([]MempoolFilter)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenNames := make(map[string]struct{}, len(v))
+	for _, filter := range v {
+		if filter.Name == "" {
+			return errors.New("mempool filter name must not be empty")
+		}
+		if _, ok := seenNames[filter.Name]; ok {
+			return fmt.Errorf("duplicate mempool filter %s", filter.Name)
+		}
+		seenNames[filter.Name] = struct{}{}
+
+		if filter.MinGasPriceMultiplier.IsNil() || filter.MinGasPriceMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("min gas price multiplier of mempool filter %s must be at least 1, got %s", filter.Name, filter.MinGasPriceMultiplier)
+		}
+
+		hasConditions := len(filter.MsgTypeUrls) > 0 || filter.SwapRouteShape != nil
+		if IsBuiltinMempoolFilter(filter.Name) && hasConditions {
+			return fmt.Errorf("built-in mempool filter %s must not have matching conditions", filter.Name)
+		}
+		if !IsBuiltinMempoolFilter(filter.Name) && !hasConditions {
+			return fmt.Errorf("mempool filter %s must have msg type urls or a swap route shape", filter.Name)
+		}
+		for _, typeURL := range filter.MsgTypeUrls {
+			if typeURL == "" {
+				return fmt.Errorf("empty msg type url in mempool filter %s", filter.Name)
+			}
+		}
+	}
+	return nil
+}
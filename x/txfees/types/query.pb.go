@@ -204,6 +204,111 @@ func (m *QueryDenomSpotPriceResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// QueryDenomConversionRateRequest defines grpc request structure for querying
+// the conversion rate of the specified tx fee denom to the base denom
+type QueryDenomConversionRateRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomConversionRateRequest) Reset()         { *m = QueryDenomConversionRateRequest{} }
+func (m *QueryDenomConversionRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomConversionRateRequest) ProtoMessage()    {}
+func (*QueryDenomConversionRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{4}
+}
+func (m *QueryDenomConversionRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomConversionRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomConversionRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomConversionRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomConversionRateRequest.Merge(m, src)
+}
+func (m *QueryDenomConversionRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomConversionRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomConversionRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomConversionRateRequest proto.InternalMessageInfo
+
+func (m *QueryDenomConversionRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomConversionRateResponse defines grpc response structure for
+// querying the conversion rate of the specified tx fee denom to the base denom
+type QueryDenomConversionRateResponse struct {
+	PoolID uint64 `protobuf:"varint,1,opt,name=poolID,proto3" json:"poolID,omitempty" yaml:"pool_id"`
+	// conversion_rate is the amount of base denom a unit of the fee token is
+	// worth when paying fees.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate" yaml:"conversion_rate"`
+	// is_twap is true if the conversion rate is the geometric TWAP over the
+	// conversion TWAP window, and false if it is the spot price.
+	IsTwap bool `protobuf:"varint,3,opt,name=is_twap,json=isTwap,proto3" json:"is_twap,omitempty" yaml:"is_twap"`
+}
+
+func (m *QueryDenomConversionRateResponse) Reset()         { *m = QueryDenomConversionRateResponse{} }
+func (m *QueryDenomConversionRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomConversionRateResponse) ProtoMessage()    {}
+func (*QueryDenomConversionRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{5}
+}
+func (m *QueryDenomConversionRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomConversionRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomConversionRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomConversionRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomConversionRateResponse.Merge(m, src)
+}
+func (m *QueryDenomConversionRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomConversionRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomConversionRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomConversionRateResponse proto.InternalMessageInfo
+
+func (m *QueryDenomConversionRateResponse) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *QueryDenomConversionRateResponse) GetIsTwap() bool {
+	if m != nil {
+		return m.IsTwap
+	}
+	return false
+}
+
 type QueryDenomPoolIdRequest struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
@@ -212,7 +317,7 @@ func (m *QueryDenomPoolIdRequest) Reset()         { *m = QueryDenomPoolIdRequest
 func (m *QueryDenomPoolIdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomPoolIdRequest) ProtoMessage()    {}
 func (*QueryDenomPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{4}
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{6}
 }
 func (m *QueryDenomPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +361,7 @@ func (m *QueryDenomPoolIdResponse) Reset()         { *m = QueryDenomPoolIdRespon
 func (m *QueryDenomPoolIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomPoolIdResponse) ProtoMessage()    {}
 func (*QueryDenomPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{5}
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{7}
 }
 func (m *QueryDenomPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +404,7 @@ func (m *QueryBaseDenomRequest) Reset()         { *m = QueryBaseDenomRequest{} }
 func (m *QueryBaseDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseDenomRequest) ProtoMessage()    {}
 func (*QueryBaseDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{6}
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{8}
 }
 func (m *QueryBaseDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +441,7 @@ func (m *QueryBaseDenomResponse) Reset()         { *m = QueryBaseDenomResponse{}
 func (m *QueryBaseDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseDenomResponse) ProtoMessage()    {}
 func (*QueryBaseDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6cbc1b48c44dfdd6, []int{7}
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{9}
 }
 func (m *QueryBaseDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,619 +477,2997 @@ func (m *QueryBaseDenomResponse) GetBaseDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
-	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
-	proto.RegisterType((*QueryDenomSpotPriceRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceRequest")
-	proto.RegisterType((*QueryDenomSpotPriceResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceResponse")
-	proto.RegisterType((*QueryDenomPoolIdRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdRequest")
-	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
-	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
-	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+type QueryBaseFeeRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("osmosis/txfees/v1beta1/query.proto", fileDescriptor_6cbc1b48c44dfdd6)
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
 }
-
-var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x12, 0x4f,
-	0x18, 0xc6, 0x99, 0xfe, 0xff, 0x34, 0x61, 0x6a, 0x1a, 0x9d, 0xd8, 0x16, 0x57, 0xb3, 0x90, 0x89,
-	0x36, 0x4d, 0x0d, 0x3b, 0x02, 0xea, 0xc1, 0x9b, 0x48, 0x9a, 0x98, 0x18, 0x53, 0x57, 0x4f, 0xbd,
-	0x6c, 0x76, 0xe1, 0x05, 0x37, 0x05, 0x66, 0xcb, 0x0c, 0x4d, 0x89, 0xf1, 0xe2, 0x27, 0x30, 0xd1,
-	0xf8, 0x15, 0x3c, 0xe9, 0xe7, 0xe8, 0xb1, 0x89, 0x17, 0xe3, 0x81, 0x18, 0xf0, 0x13, 0xf0, 0x09,
-	0xcc, 0xce, 0xce, 0xb2, 0xb4, 0x05, 0x29, 0x27, 0xd8, 0x79, 0x9f, 0x79, 0xde, 0xe7, 0xdd, 0xf9,
-	0xcd, 0x62, 0xca, 0x45, 0x9b, 0x0b, 0x5f, 0x30, 0x79, 0xd2, 0x00, 0x10, 0xec, 0xb8, 0xe8, 0x81,
-	0x74, 0x8b, 0xec, 0xa8, 0x07, 0xdd, 0xbe, 0x15, 0x74, 0xb9, 0xe4, 0x64, 0x53, 0x6b, 0xac, 0x48,
-	0x63, 0x69, 0x8d, 0x71, 0xb3, 0xc9, 0x9b, 0x5c, 0x49, 0x58, 0xf8, 0x2f, 0x52, 0x1b, 0x77, 0x9a,
-	0x9c, 0x37, 0x5b, 0xc0, 0xdc, 0xc0, 0x67, 0x6e, 0xa7, 0xc3, 0xa5, 0x2b, 0x7d, 0xde, 0x11, 0xba,
-	0x6a, 0xea, 0xaa, 0x7a, 0xf2, 0x7a, 0x0d, 0x56, 0xef, 0x75, 0x95, 0x40, 0xd7, 0xef, 0xcd, 0xc9,
-	0xd3, 0x00, 0x90, 0xfc, 0x10, 0xb4, 0x8c, 0x6e, 0xe1, 0x8d, 0x57, 0x61, 0xc2, 0x3d, 0x80, 0x37,
-	0xe1, 0xb2, 0xb0, 0xe1, 0xa8, 0x07, 0x42, 0x52, 0x89, 0x37, 0x2f, 0x16, 0x44, 0xc0, 0x3b, 0x02,
-	0xc8, 0x01, 0xc6, 0x0d, 0x00, 0x47, 0xb9, 0x88, 0x2c, 0xca, 0xff, 0xb7, 0xb3, 0x56, 0xca, 0x5b,
-	0xb3, 0x47, 0xb3, 0xe2, 0xed, 0x95, 0x5b, 0xa7, 0x83, 0x5c, 0x6a, 0x3c, 0xc8, 0xdd, 0xe8, 0xbb,
-	0xed, 0xd6, 0x13, 0x9a, 0x38, 0x50, 0x3b, 0xd3, 0x88, 0x7b, 0xd0, 0x2a, 0x36, 0x54, 0xd7, 0x2a,
-	0x74, 0x78, 0xfb, 0x75, 0xc0, 0xe5, 0x7e, 0xd7, 0xaf, 0x81, 0xce, 0x44, 0xb6, 0x71, 0xba, 0x1e,
-	0x16, 0xb2, 0x28, 0x8f, 0x76, 0x32, 0x95, 0xeb, 0xe3, 0x41, 0xee, 0x5a, 0x64, 0xa7, 0x96, 0xa9,
-	0x1d, 0x95, 0xe9, 0x37, 0x84, 0x6f, 0xcf, 0xb4, 0xd1, 0x13, 0xec, 0xe2, 0xd5, 0x80, 0xf3, 0xd6,
-	0xf3, 0xaa, 0x32, 0xfa, 0xbf, 0x42, 0xc6, 0x83, 0xdc, 0x7a, 0x64, 0x14, 0xae, 0x3b, 0x7e, 0x9d,
-	0xda, 0x5a, 0x41, 0x3c, 0x8c, 0x45, 0xc0, 0xa5, 0x13, 0x84, 0x0e, 0xd9, 0x15, 0xd5, 0xf8, 0x59,
-	0x38, 0xcb, 0xaf, 0x41, 0x6e, 0xbb, 0xe9, 0xcb, 0xb7, 0x3d, 0xcf, 0xaa, 0xf1, 0x36, 0xab, 0xa9,
-	0x17, 0xa0, 0x7f, 0x0a, 0xa2, 0x7e, 0xc8, 0x64, 0x3f, 0x00, 0x61, 0x55, 0xa1, 0x96, 0x4c, 0x9d,
-	0x38, 0x51, 0x3b, 0x23, 0xe2, 0x5c, 0xf4, 0x29, 0xde, 0x4a, 0xe2, 0xee, 0x87, 0x7d, 0xeb, 0xcb,
-	0x8e, 0xbc, 0x87, 0xb3, 0x97, 0x2d, 0x96, 0x1f, 0x77, 0xc2, 0x43, 0xc5, 0x15, 0xa0, 0xbc, 0x62,
-	0x1e, 0x5e, 0x6a, 0x1e, 0xa6, 0x0a, 0xda, 0xfe, 0x21, 0xc6, 0x9e, 0x2b, 0xc0, 0x99, 0xce, 0xb9,
-	0x91, 0xcc, 0x9c, 0xd4, 0xa8, 0x9d, 0xf1, 0xe2, 0xdd, 0xa5, 0xcf, 0x69, 0x9c, 0x56, 0x86, 0xe4,
-	0x0b, 0xc2, 0x99, 0x09, 0x65, 0xa4, 0x30, 0x8f, 0xa4, 0x99, 0x98, 0x1a, 0xd6, 0x55, 0xe5, 0x51,
-	0x58, 0xba, 0xfb, 0xe1, 0xc7, 0x9f, 0x4f, 0x2b, 0x77, 0x09, 0x65, 0xf3, 0xef, 0x87, 0x06, 0x93,
-	0x7c, 0x47, 0x78, 0xfd, 0x3c, 0x41, 0xa4, 0xf4, 0xcf, 0x76, 0x33, 0xa9, 0x35, 0xca, 0x4b, 0xed,
-	0xd1, 0x39, 0xcb, 0x2a, 0x67, 0x81, 0xdc, 0x9f, 0x97, 0x33, 0x41, 0xc9, 0xf1, 0xfa, 0xd1, 0xfb,
-	0x25, 0x5f, 0x11, 0x5e, 0x9b, 0x02, 0x80, 0xb0, 0xc5, 0x9d, 0xcf, 0xd1, 0x66, 0x3c, 0xb8, 0xfa,
-	0x06, 0x9d, 0xf3, 0x91, 0xca, 0xc9, 0x48, 0x61, 0x5e, 0x4e, 0x95, 0xcc, 0xd1, 0x9c, 0xb1, 0x77,
-	0xea, 0xf1, 0xbd, 0x3a, 0xf3, 0x09, 0x49, 0x0b, 0xce, 0xfc, 0x22, 0x8a, 0x0b, 0xce, 0xfc, 0x12,
-	0xa0, 0x8b, 0xcf, 0x3c, 0x41, 0xb4, 0xf2, 0xe2, 0x74, 0x68, 0xa2, 0xb3, 0xa1, 0x89, 0x7e, 0x0f,
-	0x4d, 0xf4, 0x71, 0x64, 0xa6, 0xce, 0x46, 0x66, 0xea, 0xe7, 0xc8, 0x4c, 0x1d, 0x94, 0xa6, 0x2e,
-	0xbb, 0xf6, 0x29, 0xb4, 0x5c, 0x4f, 0x4c, 0x4c, 0x8f, 0x8b, 0x8f, 0xd9, 0x49, 0x6c, 0xad, 0x2e,
-	0xbf, 0xb7, 0xaa, 0x3e, 0xb2, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x83, 0x48, 0x4b,
-	0x1d, 0x06, 0x00, 0x00,
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// FeeTokens returns a list of all the whitelisted fee tokens and their
-	// corresponding pools. It does not include the BaseDenom, which has its own
-	// query endpoint
-	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
-	// DenomSpotPrice returns all spot prices by each registered token denom.
-	DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error)
-	// Returns the poolID for a specified denom input.
-	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
-	// Returns a list of all base denom tokens and their corresponding pools.
-	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+type QueryBaseFeeResponse struct {
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+type QueryMempoolFiltersRequest struct {
 }
 
-func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
-	out := new(QueryFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/FeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMempoolFiltersRequest) Reset()         { *m = QueryMempoolFiltersRequest{} }
+func (m *QueryMempoolFiltersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolFiltersRequest) ProtoMessage()    {}
+func (*QueryMempoolFiltersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *QueryMempoolFiltersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolFiltersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolFiltersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMempoolFiltersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolFiltersRequest.Merge(m, src)
+}
+func (m *QueryMempoolFiltersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolFiltersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolFiltersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error) {
-	out := new(QueryDenomSpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomSpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryMempoolFiltersRequest proto.InternalMessageInfo
+
+type QueryMempoolFiltersResponse struct {
+	Filters []MempoolFilterStats `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters" yaml:"filters"`
 }
 
-func (c *queryClient) DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error) {
-	out := new(QueryDenomPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMempoolFiltersResponse) Reset()         { *m = QueryMempoolFiltersResponse{} }
+func (m *QueryMempoolFiltersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMempoolFiltersResponse) ProtoMessage()    {}
+func (*QueryMempoolFiltersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QueryMempoolFiltersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMempoolFiltersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMempoolFiltersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMempoolFiltersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMempoolFiltersResponse.Merge(m, src)
+}
+func (m *QueryMempoolFiltersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMempoolFiltersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMempoolFiltersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error) {
-	out := new(QueryBaseDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/BaseDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMempoolFiltersResponse proto.InternalMessageInfo
+
+func (m *QueryMempoolFiltersResponse) GetFilters() []MempoolFilterStats {
+	if m != nil {
+		return m.Filters
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// FeeTokens returns a list of all the whitelisted fee tokens and their
-	// corresponding pools. It does not include the BaseDenom, which has its own
-	// query endpoint
-	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
-	// DenomSpotPrice returns all spot prices by each registered token denom.
-	DenomSpotPrice(context.Context, *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error)
-	// Returns the poolID for a specified denom input.
-	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
-	// Returns a list of all base denom tokens and their corresponding pools.
-	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+// MempoolFilterStats is a mempool filter with the number of delivered txs it
+// matched.
+type MempoolFilterStats struct {
+	Filter         MempoolFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter" yaml:"filter"`
+	MatchedTxCount uint64        `protobuf:"varint,2,opt,name=matched_tx_count,json=matchedTxCount,proto3" json:"matched_tx_count,omitempty" yaml:"matched_tx_count"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *MempoolFilterStats) Reset()         { *m = MempoolFilterStats{} }
+func (m *MempoolFilterStats) String() string { return proto.CompactTextString(m) }
+func (*MempoolFilterStats) ProtoMessage()    {}
+func (*MempoolFilterStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{14}
 }
-
-func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+func (m *MempoolFilterStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DenomSpotPrice(ctx context.Context, req *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomSpotPrice not implemented")
+func (m *MempoolFilterStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolFilterStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomPoolId not implemented")
+func (m *MempoolFilterStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolFilterStats.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
+func (m *MempoolFilterStats) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *MempoolFilterStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolFilterStats.DiscardUnknown(m)
 }
 
-func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/FeeTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+var xxx_messageInfo_MempoolFilterStats proto.InternalMessageInfo
+
+func (m *MempoolFilterStats) GetFilter() MempoolFilter {
+	if m != nil {
+		return m.Filter
 	}
-	return interceptor(ctx, in, info, handler)
+	return MempoolFilter{}
 }
 
-func _Query_DenomSpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomSpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MempoolFilterStats) GetMatchedTxCount() uint64 {
+	if m != nil {
+		return m.MatchedTxCount
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomSpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomSpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomSpotPrice(ctx, req.(*QueryDenomSpotPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DenomPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomPoolIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomPoolId(ctx, req.(*QueryDenomPoolIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRecommendedGasPricesRequest struct {
 }
 
-func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BaseDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.txfees.v1beta1.Query/BaseDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseDenom(ctx, req.(*QueryBaseDenomRequest))
+func (m *QueryRecommendedGasPricesRequest) Reset()         { *m = QueryRecommendedGasPricesRequest{} }
+func (m *QueryRecommendedGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPricesRequest) ProtoMessage()    {}
+func (*QueryRecommendedGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{15}
+}
+func (m *QueryRecommendedGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRecommendedGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPricesRequest.Merge(m, src)
+}
+func (m *QueryRecommendedGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPricesRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.txfees.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FeeTokens",
-			Handler:    _Query_FeeTokens_Handler,
-		},
-		{
-			MethodName: "DenomSpotPrice",
-			Handler:    _Query_DenomSpotPrice_Handler,
-		},
-		{
-			MethodName: "DenomPoolId",
-			Handler:    _Query_DenomPoolId_Handler,
-		},
-		{
-			MethodName: "BaseDenom",
-			Handler:    _Query_BaseDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/txfees/v1beta1/query.proto",
+var xxx_messageInfo_QueryRecommendedGasPricesRequest proto.InternalMessageInfo
+
+type QueryRecommendedGasPricesResponse struct {
+	// gas_prices are the recommended gas prices in the base denom, followed by
+	// those in every fee token.
+	GasPrices []RecommendedGasPrice `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices" yaml:"gas_prices"`
 }
 
-func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRecommendedGasPricesResponse) Reset()         { *m = QueryRecommendedGasPricesResponse{} }
+func (m *QueryRecommendedGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPricesResponse) ProtoMessage()    {}
+func (*QueryRecommendedGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{16}
+}
+func (m *QueryRecommendedGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecommendedGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPricesResponse.Merge(m, src)
 }
-
-func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRecommendedGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPricesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecommendedGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryRecommendedGasPricesResponse) GetGasPrices() []RecommendedGasPrice {
+	if m != nil {
+		return m.GasPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecommendedGasPrice is the recommended gas prices to pay in a denom.
+// low is the base fee, which the next block requires, medium is enough for
+// the block after a block using the average gas of recent blocks, and high for
+// the block after a block using the most gas of recent blocks.
+type RecommendedGasPrice struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Low    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low" yaml:"low"`
+	Medium github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=medium,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"medium" yaml:"medium"`
+	High   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high" yaml:"high"`
 }
 
-func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeTokens) > 0 {
-		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RecommendedGasPrice) Reset()         { *m = RecommendedGasPrice{} }
+func (m *RecommendedGasPrice) String() string { return proto.CompactTextString(m) }
+func (*RecommendedGasPrice) ProtoMessage()    {}
+func (*RecommendedGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{17}
+}
+func (m *RecommendedGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecommendedGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecommendedGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RecommendedGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecommendedGasPrice.Merge(m, src)
+}
+func (m *RecommendedGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecommendedGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecommendedGasPrice.DiscardUnknown(m)
 }
 
-func (m *QueryDenomSpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecommendedGasPrice proto.InternalMessageInfo
+
+func (m *RecommendedGasPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDenomSpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFeeSponsorsRequest struct {
 }
 
-func (m *QueryDenomSpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeeSponsorsRequest) Reset()         { *m = QueryFeeSponsorsRequest{} }
+func (m *QueryFeeSponsorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorsRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{18}
 }
-
-func (m *QueryDenomSpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeSponsorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorsRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomSpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeSponsorsRequest proto.InternalMessageInfo
+
+type QueryFeeSponsorsResponse struct {
+	FeeSponsors []FeeSponsor `protobuf:"bytes,1,rep,name=fee_sponsors,json=feeSponsors,proto3" json:"fee_sponsors" yaml:"fee_sponsors"`
 }
 
-func (m *QueryDenomSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SpotPrice.Size()
-		i -= size
-		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryFeeSponsorsResponse) Reset()         { *m = QueryFeeSponsorsResponse{} }
+func (m *QueryFeeSponsorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorsResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{19}
+}
+func (m *QueryFeeSponsorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.PoolID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeSponsorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorsResponse.Merge(m, src)
+}
+func (m *QueryFeeSponsorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomPoolIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorsResponse) GetFeeSponsors() []FeeSponsor {
+	if m != nil {
+		return m.FeeSponsors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParamsRequest struct {
 }
 
-func (m *QueryDenomPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{20}
 }
-
-func (m *QueryDenomPoolIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryDenomPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{21}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBaseDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryBaseDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryDenomSpotPriceRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceRequest")
+	proto.RegisterType((*QueryDenomSpotPriceResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomSpotPriceResponse")
+	proto.RegisterType((*QueryDenomConversionRateRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomConversionRateRequest")
+	proto.RegisterType((*QueryDenomConversionRateResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomConversionRateResponse")
+	proto.RegisterType((*QueryDenomPoolIdRequest)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdRequest")
+	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
+	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
+	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryMempoolFiltersRequest)(nil), "osmosis.txfees.v1beta1.QueryMempoolFiltersRequest")
+	proto.RegisterType((*QueryMempoolFiltersResponse)(nil), "osmosis.txfees.v1beta1.QueryMempoolFiltersResponse")
+	proto.RegisterType((*MempoolFilterStats)(nil), "osmosis.txfees.v1beta1.MempoolFilterStats")
+	proto.RegisterType((*QueryRecommendedGasPricesRequest)(nil), "osmosis.txfees.v1beta1.QueryRecommendedGasPricesRequest")
+	proto.RegisterType((*QueryRecommendedGasPricesResponse)(nil), "osmosis.txfees.v1beta1.QueryRecommendedGasPricesResponse")
+	proto.RegisterType((*RecommendedGasPrice)(nil), "osmosis.txfees.v1beta1.RecommendedGasPrice")
+	proto.RegisterType((*QueryFeeSponsorsRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeSponsorsRequest")
+	proto.RegisterType((*QueryFeeSponsorsResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeSponsorsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.txfees.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.txfees.v1beta1.QueryParamsResponse")
 }
 
-func (m *QueryBaseDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("osmosis/txfees/v1beta1/query.proto", fileDescriptor_6cbc1b48c44dfdd6)
 }
 
-func (m *QueryBaseDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
+	// 1261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x6d, 0xea, 0xd4, 0xcf, 0x6d, 0x5a, 0x26, 0x3f, 0xea, 0x6e, 0x2a, 0xdb, 0x8c,
+	0xd2, 0x10, 0x92, 0xc6, 0x9b, 0x38, 0x50, 0x0a, 0x2a, 0x42, 0x75, 0x42, 0xa0, 0x12, 0x54, 0xe9,
+	0x26, 0x12, 0x52, 0x85, 0xb4, 0x5a, 0xdb, 0x63, 0x67, 0x55, 0xaf, 0xc7, 0xf1, 0xac, 0xf3, 0x43,
+	0x15, 0x1c, 0xb8, 0xd1, 0x03, 0x42, 0x42, 0xe2, 0xc8, 0x15, 0x09, 0x09, 0x24, 0x8e, 0x5c, 0xe1,
+	0xd2, 0x63, 0x25, 0x2e, 0x88, 0x83, 0x85, 0x12, 0xce, 0x1c, 0xf2, 0x17, 0xa0, 0x9d, 0x7d, 0xeb,
+	0xb5, 0x1d, 0xaf, 0xed, 0xed, 0xc9, 0xf6, 0xcc, 0x7b, 0xdf, 0xf7, 0x99, 0x1f, 0x6f, 0xde, 0x33,
+	0x50, 0x2e, 0x6c, 0x2e, 0x2c, 0xa1, 0x39, 0x47, 0x65, 0xc6, 0x84, 0x76, 0xb0, 0x56, 0x60, 0x8e,
+	0xb9, 0xa6, 0xed, 0x37, 0x59, 0xe3, 0x38, 0x5b, 0x6f, 0x70, 0x87, 0x93, 0x59, 0xb4, 0xc9, 0x7a,
+	0x36, 0x59, 0xb4, 0x51, 0xa7, 0x2b, 0xbc, 0xc2, 0xa5, 0x89, 0xe6, 0x7e, 0xf3, 0xac, 0xd5, 0x5b,
+	0x15, 0xce, 0x2b, 0x55, 0xa6, 0x99, 0x75, 0x4b, 0x33, 0x6b, 0x35, 0xee, 0x98, 0x8e, 0xc5, 0x6b,
+	0x02, 0x67, 0x53, 0x38, 0x2b, 0x7f, 0x15, 0x9a, 0x65, 0xad, 0xd4, 0x6c, 0x48, 0x03, 0x9c, 0xbf,
+	0x1d, 0xc2, 0x53, 0x66, 0xcc, 0xe1, 0x4f, 0x99, 0x6f, 0xb6, 0x18, 0x6e, 0x66, 0x88, 0x3a, 0xaf,
+	0x09, 0xde, 0x40, 0xcb, 0xf9, 0x10, 0xcb, 0x0a, 0xab, 0x31, 0x77, 0x4d, 0xd2, 0x8a, 0xde, 0x80,
+	0x99, 0xc7, 0xee, 0x8a, 0xb7, 0x18, 0xdb, 0x75, 0xc3, 0x08, 0x9d, 0xed, 0x37, 0x99, 0x70, 0xa8,
+	0x03, 0xb3, 0xbd, 0x13, 0x52, 0x9f, 0x91, 0x27, 0x00, 0x6e, 0x34, 0x49, 0x25, 0x92, 0x4a, 0xe6,
+	0xe2, 0x62, 0x22, 0x97, 0xc9, 0xf6, 0xdf, 0xaa, 0xac, 0xef, 0x9e, 0xbf, 0xf9, 0xa2, 0x95, 0x1e,
+	0x3b, 0x6b, 0xa5, 0x5f, 0x3b, 0x36, 0xed, 0xea, 0x7b, 0x34, 0x50, 0xa0, 0x7a, 0xbc, 0xec, 0xc7,
+	0xa0, 0x9b, 0xa0, 0xca, 0xa8, 0x9b, 0xac, 0xc6, 0xed, 0x9d, 0x3a, 0x77, 0xb6, 0x1b, 0x56, 0x91,
+	0x21, 0x13, 0x59, 0x80, 0x4b, 0x25, 0x77, 0x22, 0xa9, 0x64, 0x94, 0xc5, 0x78, 0xfe, 0xfa, 0x59,
+	0x2b, 0x7d, 0xc5, 0x93, 0x93, 0xc3, 0x54, 0xf7, 0xa6, 0xe9, 0xcf, 0x0a, 0xcc, 0xf5, 0x95, 0xc1,
+	0x15, 0x2c, 0x41, 0xac, 0xce, 0x79, 0xf5, 0xe1, 0xa6, 0x14, 0x1a, 0xcf, 0x93, 0xb3, 0x56, 0x7a,
+	0xd2, 0x13, 0x72, 0xc7, 0x0d, 0xab, 0x44, 0x75, 0xb4, 0x20, 0x05, 0x00, 0x51, 0xe7, 0x8e, 0x51,
+	0x77, 0x15, 0x92, 0x17, 0x64, 0xe0, 0x0d, 0x77, 0x2d, 0x7f, 0xb7, 0xd2, 0x0b, 0x15, 0xcb, 0xd9,
+	0x6b, 0x16, 0xb2, 0x45, 0x6e, 0x6b, 0x45, 0xb9, 0x01, 0xf8, 0xb1, 0x22, 0x4a, 0x4f, 0x35, 0xe7,
+	0xb8, 0xce, 0x44, 0x76, 0x93, 0x15, 0x83, 0x55, 0x07, 0x4a, 0x54, 0x8f, 0x0b, 0x9f, 0x8b, 0x3e,
+	0x84, 0x74, 0x80, 0xbb, 0xc1, 0x6b, 0x07, 0xac, 0x21, 0x2c, 0x5e, 0xd3, 0x4d, 0x27, 0xf2, 0xd2,
+	0xff, 0x53, 0x20, 0x13, 0xae, 0xf5, 0x0a, 0xeb, 0xdf, 0x87, 0x6b, 0xc5, 0xb6, 0x8a, 0xd1, 0x30,
+	0x1d, 0x7f, 0x13, 0x3e, 0x8e, 0xbc, 0x09, 0xb3, 0x5e, 0x88, 0x1e, 0x39, 0xaa, 0x4f, 0x16, 0xbb,
+	0x30, 0xc9, 0x32, 0x4c, 0x58, 0xc2, 0x70, 0x0e, 0xcd, 0x7a, 0xf2, 0x62, 0x46, 0x59, 0xbc, 0xdc,
+	0xc9, 0x87, 0x13, 0x54, 0x8f, 0x59, 0x62, 0xd7, 0xfd, 0xf2, 0x00, 0x6e, 0x04, 0xeb, 0xdd, 0x76,
+	0x99, 0x4b, 0x51, 0xf7, 0x6c, 0x0b, 0x92, 0xe7, 0x25, 0xa2, 0x6f, 0x55, 0x3b, 0x97, 0xf2, 0xa6,
+	0x60, 0x52, 0xcb, 0xcf, 0xa5, 0x47, 0x30, 0xdb, 0x3b, 0x81, 0xf2, 0x6f, 0x01, 0x14, 0x4c, 0xc1,
+	0x8c, 0x4e, 0xce, 0x99, 0xe0, 0xbe, 0x04, 0x73, 0x54, 0x8f, 0x17, 0x7c, 0x6f, 0x3a, 0x03, 0x53,
+	0x6d, 0xbd, 0x2d, 0xc6, 0x82, 0x94, 0x9d, 0xee, 0x1e, 0xc6, 0x20, 0x9f, 0xc3, 0x65, 0x29, 0x54,
+	0x66, 0x0c, 0x43, 0x3c, 0x88, 0x7c, 0x76, 0xd7, 0x3a, 0x80, 0xca, 0x8c, 0x51, 0x7d, 0xa2, 0xe0,
+	0x45, 0xa1, 0xb7, 0x30, 0x65, 0x3f, 0x65, 0xb6, 0xbb, 0x0f, 0x5b, 0x56, 0xd5, 0x61, 0x8d, 0xf6,
+	0x33, 0xf2, 0x0c, 0xe6, 0xfa, 0xce, 0xb6, 0xd1, 0x26, 0xca, 0xde, 0x10, 0x3e, 0x24, 0x4b, 0x61,
+	0x0f, 0x49, 0x97, 0xc0, 0x8e, 0x63, 0x3a, 0x22, 0x3f, 0x8b, 0x4f, 0x0a, 0x9e, 0x07, 0x0a, 0x51,
+	0xdd, 0x97, 0xa4, 0xbf, 0x2a, 0x40, 0xce, 0xfb, 0x91, 0x5d, 0x88, 0x79, 0x16, 0x72, 0x37, 0x12,
+	0xb9, 0xdb, 0x23, 0xc5, 0xcc, 0xcf, 0x60, 0xb8, 0xab, 0x9d, 0xe1, 0xa8, 0x8e, 0x5a, 0xe4, 0x43,
+	0xb8, 0x6e, 0x9b, 0x4e, 0x71, 0x8f, 0x95, 0x0c, 0xe7, 0xc8, 0x28, 0xf2, 0x66, 0xcd, 0x91, 0x99,
+	0x32, 0x9e, 0x9f, 0x3b, 0x6b, 0xa5, 0x6f, 0x78, 0x4e, 0xbd, 0x16, 0x54, 0x9f, 0xc4, 0xa1, 0xdd,
+	0xa3, 0x0d, 0x39, 0x40, 0x31, 0x7f, 0x75, 0x56, 0xe4, 0xb6, 0xcd, 0x6a, 0x25, 0x56, 0xfa, 0xc8,
+	0x14, 0xf2, 0x9d, 0x68, 0x6f, 0xea, 0x73, 0x05, 0x5e, 0x1f, 0x60, 0x84, 0x7b, 0xcb, 0x00, 0x2a,
+	0xa6, 0xf0, 0x9e, 0x1b, 0x7f, 0x7b, 0x97, 0xc3, 0x96, 0xda, 0x47, 0xa9, 0xf7, 0xc9, 0x0e, 0xc4,
+	0xa8, 0x1e, 0xaf, 0xf8, 0xe1, 0xe8, 0x6f, 0x17, 0x60, 0xaa, 0x8f, 0xf7, 0xa8, 0xd9, 0x47, 0x1e,
+	0xc1, 0xc5, 0x2a, 0x3f, 0xc4, 0x47, 0xe5, 0x7e, 0xe4, 0x8b, 0x09, 0x9e, 0x66, 0x95, 0x1f, 0x52,
+	0xdd, 0x15, 0x22, 0x9f, 0x41, 0xcc, 0x66, 0x25, 0xab, 0x69, 0xcb, 0xc7, 0x23, 0x9e, 0xff, 0x20,
+	0xb2, 0x24, 0x1e, 0xb0, 0xa7, 0x42, 0x75, 0x94, 0x23, 0x8f, 0x61, 0x7c, 0xcf, 0xaa, 0xec, 0x25,
+	0xc7, 0xa5, 0xec, 0xfb, 0x91, 0x65, 0x13, 0x9e, 0xac, 0xab, 0x41, 0x75, 0x29, 0x45, 0x6f, 0xe2,
+	0xe3, 0xb5, 0xc5, 0xd8, 0x8e, 0x57, 0xbc, 0xdb, 0x67, 0xfc, 0x25, 0x24, 0xcf, 0x4f, 0xe1, 0xc9,
+	0x16, 0xe0, 0x4a, 0x47, 0xbd, 0xf7, 0xcf, 0x96, 0x0e, 0xa8, 0xc1, 0x28, 0x91, 0x9f, 0xc3, 0x23,
+	0x9d, 0x0a, 0xaa, 0xb0, 0xaf, 0x42, 0xf5, 0x44, 0x39, 0x88, 0x45, 0xa7, 0x81, 0xc8, 0xf8, 0xdb,
+	0x66, 0xc3, 0xb4, 0xdb, 0x54, 0x3b, 0x30, 0xd5, 0x35, 0x8a, 0x40, 0xf7, 0x21, 0x56, 0x97, 0x23,
+	0x98, 0x51, 0xa9, 0x30, 0x14, 0xcf, 0x2f, 0x3f, 0xee, 0x62, 0xe8, 0xe8, 0x93, 0x7b, 0x7e, 0x15,
+	0x2e, 0x49, 0x55, 0xf2, 0xbd, 0x02, 0xf1, 0x76, 0xc3, 0x41, 0x56, 0xc2, 0x54, 0xfa, 0x76, 0x2c,
+	0x6a, 0x76, 0x54, 0x73, 0x0f, 0x9a, 0x2e, 0x7d, 0xf5, 0xe7, 0xbf, 0xdf, 0x5d, 0x98, 0x27, 0x54,
+	0x1b, 0xd0, 0x53, 0x79, 0x3d, 0x0a, 0xf9, 0x45, 0x81, 0xc9, 0xee, 0x66, 0x82, 0xe4, 0x06, 0x86,
+	0xeb, 0xdb, 0xc0, 0xa8, 0xeb, 0x91, 0x7c, 0x90, 0x73, 0x5d, 0x72, 0xae, 0x90, 0xe5, 0x30, 0xce,
+	0xa0, 0xab, 0x30, 0x0a, 0xc7, 0x5e, 0xb9, 0x20, 0xbf, 0x2b, 0x30, 0xd5, 0xa7, 0x05, 0x20, 0xef,
+	0x0c, 0x27, 0xe8, 0xdb, 0x80, 0xa8, 0xf7, 0xa2, 0x3b, 0x22, 0xff, 0x3d, 0xc9, 0x9f, 0x23, 0xab,
+	0x61, 0xfc, 0x3d, 0x0d, 0x41, 0xb0, 0x88, 0x1f, 0x15, 0x48, 0x74, 0x14, 0x65, 0xa2, 0x0d, 0x67,
+	0xe8, 0xea, 0x00, 0xd4, 0xd5, 0xd1, 0x1d, 0x10, 0xf6, 0x6d, 0x09, 0xab, 0x91, 0x95, 0x30, 0x58,
+	0x49, 0x66, 0x60, 0xed, 0xd7, 0x9e, 0xc9, 0x9f, 0x5f, 0xc8, 0x8b, 0xdb, 0xae, 0xee, 0x43, 0x2e,
+	0x6e, 0x6f, 0x7b, 0xa0, 0x66, 0x47, 0x35, 0x1f, 0xf5, 0xe2, 0x06, 0x6d, 0x03, 0xf9, 0x46, 0x81,
+	0x09, 0xec, 0x07, 0xc8, 0xf2, 0xd0, 0x38, 0x41, 0x33, 0xa1, 0xde, 0x19, 0xcd, 0x18, 0x91, 0x16,
+	0x25, 0x12, 0x25, 0x99, 0x81, 0x48, 0x65, 0xc6, 0xc8, 0x4f, 0x0a, 0x4c, 0x76, 0x37, 0x03, 0x43,
+	0x32, 0xa9, 0x6f, 0x5f, 0xa1, 0xae, 0x47, 0xf2, 0x41, 0x4a, 0x4d, 0x52, 0xbe, 0x49, 0xde, 0x08,
+	0xa3, 0xb4, 0x3d, 0x3f, 0x03, 0x1b, 0x08, 0xf2, 0x87, 0x02, 0xd3, 0xfd, 0x6a, 0x2c, 0x19, 0x9c,
+	0x0d, 0x03, 0x6a, 0xb7, 0xfa, 0xee, 0x2b, 0x78, 0x22, 0xfe, 0x5d, 0x89, 0xbf, 0x4a, 0xb2, 0x61,
+	0xf8, 0x8d, 0xc0, 0xdb, 0x08, 0xaa, 0x35, 0xf9, 0x41, 0x81, 0x44, 0x47, 0x19, 0x19, 0x92, 0x46,
+	0xe7, 0x6b, 0x91, 0xba, 0x3a, 0xba, 0x03, 0xa2, 0xde, 0x91, 0xa8, 0x0b, 0x64, 0x5e, 0x1b, 0xfe,
+	0x7f, 0x55, 0x90, 0xaf, 0x15, 0x88, 0x79, 0x95, 0x81, 0x2c, 0x0d, 0x0c, 0xd5, 0x55, 0x8c, 0xd4,
+	0xe5, 0x91, 0x6c, 0x91, 0x68, 0x41, 0x12, 0x65, 0x48, 0x2a, 0x8c, 0xc8, 0x2b, 0x46, 0xf9, 0x4f,
+	0x5e, 0x9c, 0xa4, 0x94, 0x97, 0x27, 0x29, 0xe5, 0x9f, 0x93, 0x94, 0xf2, 0xed, 0x69, 0x6a, 0xec,
+	0xe5, 0x69, 0x6a, 0xec, 0xaf, 0xd3, 0xd4, 0xd8, 0x93, 0x5c, 0x47, 0xa5, 0x47, 0x8d, 0x95, 0xaa,
+	0x59, 0x10, 0x6d, 0xc1, 0x83, 0xb5, 0xbb, 0xda, 0x91, 0x2f, 0x2b, 0x2b, 0x7f, 0x21, 0x26, 0xff,
+	0x65, 0xaf, 0xff, 0x3f, 0x00, 0x98, 0x7c, 0x71, 0x40, 0x6e, 0x10, 0x00, 0x00,
 }
 
-func (m *QueryBaseDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// FeeTokens returns a list of all the whitelisted fee tokens and their
+	// corresponding pools. It does not include the BaseDenom, which has its own
+	// query endpoint
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// DenomSpotPrice returns all spot prices by each registered token denom.
+	DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error)
+	// DenomConversionRate returns the rate at which fees paid in the given fee
+	// token are converted to the base denom, and whether it is a TWAP or a spot
+	// price.
+	DenomConversionRate(ctx context.Context, in *QueryDenomConversionRateRequest, opts ...grpc.CallOption) (*QueryDenomConversionRateResponse, error)
+	// Returns the poolID for a specified denom input.
+	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
+	// Returns a list of all base denom tokens and their corresponding pools.
+	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+	// Returns the current consensus base fee, the minimum gas price in base
+	// denom that transactions must pay.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// MempoolFilters returns the mempool filters, with the number of delivered
+	// txs each matched.
+	MempoolFilters(ctx context.Context, in *QueryMempoolFiltersRequest, opts ...grpc.CallOption) (*QueryMempoolFiltersResponse, error)
+	// RecommendedGasPrices returns low, medium and high gas prices to pay in the
+	// base denom and in every fee token, from the base fee and the gas used by
+	// recent blocks.
+	RecommendedGasPrices(ctx context.Context, in *QueryRecommendedGasPricesRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPricesResponse, error)
+	// FeeSponsors returns the contracts registered as fee sponsors.
+	FeeSponsors(ctx context.Context, in *QueryFeeSponsorsRequest, opts ...grpc.CallOption) (*QueryFeeSponsorsResponse, error)
+	// Params returns the txfees module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) DenomSpotPrice(ctx context.Context, in *QueryDenomSpotPriceRequest, opts ...grpc.CallOption) (*QueryDenomSpotPriceResponse, error) {
+	out := new(QueryDenomSpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomSpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DenomConversionRate(ctx context.Context, in *QueryDenomConversionRateRequest, opts ...grpc.CallOption) (*QueryDenomConversionRateResponse, error) {
+	out := new(QueryDenomConversionRateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomConversionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
+func (c *queryClient) DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error) {
+	out := new(QueryDenomPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/DenomPoolId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error) {
+	out := new(QueryBaseDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/BaseDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBaseDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MempoolFilters(ctx context.Context, in *QueryMempoolFiltersRequest, opts ...grpc.CallOption) (*QueryMempoolFiltersResponse, error) {
+	out := new(QueryMempoolFiltersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/MempoolFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryBaseDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RecommendedGasPrices(ctx context.Context, in *QueryRecommendedGasPricesRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPricesResponse, error) {
+	out := new(QueryRecommendedGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/RecommendedGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsors(ctx context.Context, in *QueryFeeSponsorsRequest, opts ...grpc.CallOption) (*QueryFeeSponsorsResponse, error) {
+	out := new(QueryFeeSponsorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/FeeSponsors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// FeeTokens returns a list of all the whitelisted fee tokens and their
+	// corresponding pools. It does not include the BaseDenom, which has its own
+	// query endpoint
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// DenomSpotPrice returns all spot prices by each registered token denom.
+	DenomSpotPrice(context.Context, *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error)
+	// DenomConversionRate returns the rate at which fees paid in the given fee
+	// token are converted to the base denom, and whether it is a TWAP or a spot
+	// price.
+	DenomConversionRate(context.Context, *QueryDenomConversionRateRequest) (*QueryDenomConversionRateResponse, error)
+	// Returns the poolID for a specified denom input.
+	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
+	// Returns a list of all base denom tokens and their corresponding pools.
+	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+	// Returns the current consensus base fee, the minimum gas price in base
+	// denom that transactions must pay.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// MempoolFilters returns the mempool filters, with the number of delivered
+	// txs each matched.
+	MempoolFilters(context.Context, *QueryMempoolFiltersRequest) (*QueryMempoolFiltersResponse, error)
+	// RecommendedGasPrices returns low, medium and high gas prices to pay in the
+	// base denom and in every fee token, from the base fee and the gas used by
+	// recent blocks.
+	RecommendedGasPrices(context.Context, *QueryRecommendedGasPricesRequest) (*QueryRecommendedGasPricesResponse, error)
+	// FeeSponsors returns the contracts registered as fee sponsors.
+	FeeSponsors(context.Context, *QueryFeeSponsorsRequest) (*QueryFeeSponsorsResponse, error)
+	// Params returns the txfees module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) DenomSpotPrice(ctx context.Context, req *QueryDenomSpotPriceRequest) (*QueryDenomSpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) DenomConversionRate(ctx context.Context, req *QueryDenomConversionRateRequest) (*QueryDenomConversionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomConversionRate not implemented")
+}
+func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPoolId not implemented")
+}
+func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) MempoolFilters(ctx context.Context, req *QueryMempoolFiltersRequest) (*QueryMempoolFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MempoolFilters not implemented")
+}
+func (*UnimplementedQueryServer) RecommendedGasPrices(ctx context.Context, req *QueryRecommendedGasPricesRequest) (*QueryRecommendedGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendedGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) FeeSponsors(ctx context.Context, req *QueryFeeSponsorsRequest) (*QueryFeeSponsorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsors not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomSpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSpotPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomSpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSpotPrice(ctx, req.(*QueryDenomSpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomConversionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomConversionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomConversionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomConversionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomConversionRate(ctx, req.(*QueryDenomConversionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPoolIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/DenomPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPoolId(ctx, req.(*QueryDenomPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/BaseDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseDenom(ctx, req.(*QueryBaseDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MempoolFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMempoolFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MempoolFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/MempoolFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MempoolFilters(ctx, req.(*QueryMempoolFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecommendedGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommendedGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommendedGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/RecommendedGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommendedGasPrices(ctx, req.(*QueryRecommendedGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/FeeSponsors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsors(ctx, req.(*QueryFeeSponsorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.txfees.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "DenomSpotPrice",
+			Handler:    _Query_DenomSpotPrice_Handler,
+		},
+		{
+			MethodName: "DenomConversionRate",
+			Handler:    _Query_DenomConversionRate_Handler,
+		},
+		{
+			MethodName: "DenomPoolId",
+			Handler:    _Query_DenomPoolId_Handler,
+		},
+		{
+			MethodName: "BaseDenom",
+			Handler:    _Query_BaseDenom_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "MempoolFilters",
+			Handler:    _Query_MempoolFilters_Handler,
+		},
+		{
+			MethodName: "RecommendedGasPrices",
+			Handler:    _Query_RecommendedGasPrices_Handler,
+		},
+		{
+			MethodName: "FeeSponsors",
+			Handler:    _Query_FeeSponsors_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/txfees/v1beta1/query.proto",
+}
+
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomConversionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomConversionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomConversionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomConversionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomConversionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomConversionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsTwap {
+		i--
+		if m.IsTwap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolFiltersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolFiltersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolFiltersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMempoolFiltersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMempoolFiltersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMempoolFiltersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolFilterStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolFilterStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolFilterStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchedTxCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchedTxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecommendedGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecommendedGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecommendedGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Medium.Size()
+		i -= size
+		if _, err := m.Medium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSponsors) > 0 {
+		for iNdEx := len(m.FeeSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomConversionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomConversionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsTwap {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *QueryBaseDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMempoolFiltersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMempoolFiltersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MempoolFilterStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Filter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MatchedTxCount != 0 {
+		n += 1 + sovQuery(uint64(m.MatchedTxCount))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecommendedGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecommendedGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Low.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Medium.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeSponsorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSponsorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeSponsors) > 0 {
+		for _, e := range m.FeeSponsors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomConversionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomConversionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomConversionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomConversionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomConversionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomConversionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTwap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTwap = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMempoolFiltersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,12 +3490,96 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMempoolFiltersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMempoolFiltersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMempoolFiltersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMempoolFiltersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMempoolFiltersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, MempoolFilterStats{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1034,7 +3601,7 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MempoolFilterStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,15 +3624,15 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MempoolFilterStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MempoolFilterStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1092,11 +3659,29 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeTokens = append(m.FeeTokens, FeeToken{})
-			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedTxCount", wireType)
+			}
+			m.MatchedTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchedTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1118,7 +3703,7 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedGasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,17 +3726,67 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedGasPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommendedGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommendedGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommendedGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1161,23 +3796,25 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.GasPrices = append(m.GasPrices, RecommendedGasPrice{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1200,7 +3837,7 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *RecommendedGasPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +3860,17 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecommendedGasPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecommendedGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.PoolID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,14 +3880,27 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,7 +3928,75 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Medium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1303,7 +4021,7 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,44 +4044,12 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1385,7 +4071,7 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeSponsorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1408,17 +4094,17 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeSponsorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeSponsorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsors", wireType)
 			}
-			m.PoolID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1428,11 +4114,26 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsors = append(m.FeeSponsors, FeeSponsor{})
+			if err := m.FeeSponsors[len(m.FeeSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1454,7 +4155,7 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1477,10 +4178,10 @@ func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1504,7 +4205,7 @@ func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1527,17 +4228,17 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1547,23 +4248,24 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex